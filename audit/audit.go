@@ -0,0 +1,173 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"strings"
+	"time"
+
+	"etcd/pkg/keyutil"
+
+	"github.com/coreos/pkg/capnslog"
+)
+
+var (
+	plog = capnslog.NewPackageLogger("github.com/coreos/etcd", "audit")
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Record is the audit record of a request.
+type Record struct {
+	Time time.Time `json:"time"`
+	// Member is the ID of the member serving the request.
+	Member string `json:"member"`
+	// User is the auth user issuing the request, if any.
+	User string `json:"user,omitempty"`
+	// Source is the address of the client.
+	Source string `json:"source,omitempty"`
+	// Method is the full name of the RPC.
+	Method string `json:"method"`
+
+	// Ranges are the keys and the ranges the request touches.
+	Ranges []Range `json:"ranges,omitempty"`
+	// Lease is the lease the request touches.
+	Lease int64 `json:"lease,omitempty"`
+	// TargetMember is the member the request touches.
+	TargetMember string `json:"target_member,omitempty"`
+	// Name is the user, the role, the rate limited RPC, the peer URLs or
+	// the alarm the request touches.
+	Name string `json:"name,omitempty"`
+	// Role is the role granted to or revoked from a user.
+	Role string `json:"role,omitempty"`
+	// Permission is the range of the permission granted to or revoked
+	// from a role. Unlike Ranges, it is not filtered by the rules.
+	Permission *Range `json:"permission,omitempty"`
+
+	// Revision is the revision of the store after the request.
+	Revision int64  `json:"revision,omitempty"`
+	Outcome  string `json:"outcome"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Range is a key when RangeEnd is empty, the range [Key, RangeEnd)
+// otherwise. A RangeEnd of "\x00" is the end of the keyspace.
+type Range struct {
+	Key      string `json:"key"`
+	RangeEnd string `json:"range_end,omitempty"`
+}
+
+// Sink stores audit records. It must be safe for concurrent use.
+type Sink interface {
+	Write(r *Record) error
+	Close() error
+}
+
+// Rules select the records of the requests on keys by key prefix. A
+// request touching keys is recorded if one of its ranges overlaps an
+// included prefix, or Include is empty, and does not fall entirely under
+// an excluded prefix. The requests touching no keys are always recorded.
+type Rules struct {
+	Include []string
+	Exclude []string
+}
+
+// ParsePrefixes parses a comma separated list of key prefixes.
+func ParsePrefixes(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// Logger filters audit records with its rules and writes them to its sink.
+type Logger struct {
+	sink  Sink
+	rules Rules
+}
+
+func NewLogger(sink Sink, rules Rules) *Logger {
+	return &Logger{sink: sink, rules: rules}
+}
+
+// Log writes r to the sink if the rules select it. Failures to write are
+// logged; they do not fail the request.
+func (l *Logger) Log(r *Record) {
+	if !l.rules.selects(r.Ranges) {
+		return
+	}
+	if err := l.sink.Write(r); err != nil {
+		plog.Errorf("failed to write audit record of %s (%v)", r.Method, err)
+	}
+}
+
+func (rs Rules) selects(ranges []Range) bool {
+	if len(ranges) == 0 {
+		return true
+	}
+	for _, r := range ranges {
+		if rs.selectsRange(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rs Rules) selectsRange(r Range) bool {
+	key, end := r.Key, r.RangeEnd
+	if end == "" {
+		end = key + "\x00"
+	}
+	for _, p := range rs.Exclude {
+		if covers(p, key, end) {
+			return false
+		}
+	}
+	if len(rs.Include) == 0 {
+		return true
+	}
+	for _, p := range rs.Include {
+		if overlaps(p, key, end) {
+			return true
+		}
+	}
+	return false
+}
+
+// covers returns true if the prefix p covers the range [key, end).
+func covers(p, key, end string) bool {
+	pend := prefixEnd(p)
+	return key >= p && (pend == "\x00" || !after(end, pend))
+}
+
+// overlaps returns true if the prefix p overlaps the range [key, end).
+func overlaps(p, key, end string) bool {
+	pend := prefixEnd(p)
+	return after(end, p) && after(pend, key)
+}
+
+// after returns true if the range end a is after the key k; "\x00" is
+// after every key.
+func after(a, k string) bool {
+	return a == "\x00" || a > k
+}
+
+// prefixEnd is the end of the range of the keys with the prefix p.
+func prefixEnd(p string) string {
+	return string(keyutil.PrefixEnd([]byte(p)))
+}
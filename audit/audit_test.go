@@ -0,0 +1,74 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"sync"
+	"testing"
+)
+
+type recorderSink struct {
+	mu   sync.Mutex
+	recs []*Record
+}
+
+func (s *recorderSink) Write(r *Record) error {
+	s.mu.Lock()
+	s.recs = append(s.recs, r)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *recorderSink) Close() error { return nil }
+
+func TestLoggerRules(t *testing.T) {
+	rules := Rules{Include: []string{"/app/"}, Exclude: []string{"/app/cache/"}}
+	tests := []struct {
+		ranges []Range
+		logged bool
+	}{
+		{nil, true},
+		{[]Range{{Key: "/app/a"}}, true},
+		{[]Range{{Key: "/other"}}, false},
+		{[]Range{{Key: "/app/cache/a"}}, false},
+		{[]Range{{Key: "/app/cache/", RangeEnd: "/app/cache0"}}, false},
+		// ranges overlapping an included prefix
+		{[]Range{{Key: "/", RangeEnd: "/b"}}, true},
+		{[]Range{{Key: "\x00", RangeEnd: "\x00"}}, true},
+		// ranges leaving an excluded prefix
+		{[]Range{{Key: "/app/cache/", RangeEnd: "/app/d"}}, true},
+		{[]Range{{Key: "/other"}, {Key: "/app/a"}}, true},
+		{[]Range{{Key: "/other"}, {Key: "/app/cache/a"}}, false},
+	}
+	for i, tt := range tests {
+		s := &recorderSink{}
+		NewLogger(s, rules).Log(&Record{Method: "/etcdserverpb.KV/Put", Ranges: tt.ranges})
+		if logged := len(s.recs) == 1; logged != tt.logged {
+			t.Errorf("#%d: logged = %v, want %v", i, logged, tt.logged)
+		}
+	}
+}
+
+func TestLoggerRulesEmptyPrefix(t *testing.T) {
+	s := &recorderSink{}
+	l := NewLogger(s, Rules{Exclude: ParsePrefixes("")})
+	l.Log(&Record{Ranges: []Range{{Key: "foo"}}})
+
+	l = NewLogger(s, Rules{Exclude: []string{""}})
+	l.Log(&Record{Ranges: []Range{{Key: "foo", RangeEnd: "\x00"}}})
+	if len(s.recs) != 1 {
+		t.Fatalf("len(records) = %d, want 1", len(s.recs))
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"etcd/pkg/fileutil"
+)
+
+var errSinkClosed = errors.New("audit: sink is closed")
+
+// fileSink writes records as JSON lines to a local file. When the file
+// would exceed maxSize bytes, it is renamed to path.1, path.1 to path.2
+// and so on, keeping maxBackups old files.
+type fileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+func NewFileSink(path string, maxSize int64, maxBackups int) (Sink, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("audit: invalid max size %d", maxSize)
+	}
+	s := &fileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *fileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f, s.size = f, st.Size()
+	return nil
+}
+
+func (s *fileSink) Write(r *Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return errSinkClosed
+	}
+	var rerr error
+	if s.size > 0 && s.size+int64(len(b)) > s.maxSize {
+		if rerr = s.rotate(); s.f == nil {
+			return rerr
+		}
+	}
+	n, err := s.f.Write(b)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return rerr
+}
+
+func (s *fileSink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	s.f = nil
+	err := s.shift()
+	// keep writing to the current file if it could not be moved away
+	if oerr := s.open(); oerr != nil {
+		return oerr
+	}
+	return err
+}
+
+// shift moves the file to path.1, dropping the oldest backup.
+func (s *fileSink) shift() error {
+	if s.maxBackups == 0 {
+		return os.Remove(s.path)
+	}
+	for i := s.maxBackups - 1; i > 0; i-- {
+		old := fmt.Sprintf("%s.%d", s.path, i)
+		if err := os.Rename(old, fmt.Sprintf("%s.%d", s.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(s.path, s.path+".1")
+}
+
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
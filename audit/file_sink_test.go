@@ -0,0 +1,93 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSinkRotate(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rec := func(name string) *Record {
+		return &Record{Method: "/etcdserverpb.Auth/UserAdd", Name: name, Outcome: OutcomeSuccess}
+	}
+	b, err := json.Marshal(rec("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// each file holds two records
+	p := filepath.Join(dir, "audit.log")
+	s, err := NewFileSink(p, int64(2*(len(b)+1)), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		if err = s.Write(rec(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for f, want := range map[string][]string{p: {"g"}, p + ".1": {"e", "f"}, p + ".2": {"c", "d"}} {
+		if got := readNames(t, f); !equalNames(got, want) {
+			t.Errorf("%s: names = %v, want %v", f, got, want)
+		}
+	}
+	if _, err = os.Stat(p + ".3"); !os.IsNotExist(err) {
+		t.Fatalf("expected no third backup, got %v", err)
+	}
+}
+
+func readNames(t *testing.T, p string) (names []string) {
+	f, err := os.Open(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var r Record
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
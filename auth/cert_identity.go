@@ -0,0 +1,123 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// GatewayAddr is the remote address of the connections the grpc-gateway
+// dials in-process. The gateway forwards requests with the certificate of
+// the server, which must not stand for its clients.
+type GatewayAddr struct{}
+
+func (GatewayAddr) Network() string { return "gateway" }
+func (GatewayAddr) String() string  { return "gateway" }
+
+// CertIdentity is the rule mapping the verified client certificate of a
+// gRPC connection to a user, so that clients authenticated by mTLS need
+// no password.
+type CertIdentity struct {
+	// URI takes the user name from a URI SAN instead of the CommonName.
+	URI bool
+	// Prefix is the prefix a URI SAN must have; it is stripped from the
+	// user name.
+	Prefix string
+}
+
+// ParseCertIdentity parses a client certificate identity rule: "cn" for
+// the CommonName, "uri" for the first URI SAN, or "uri:<prefix>" for the
+// first URI SAN with the prefix.
+func ParseCertIdentity(s string) (*CertIdentity, error) {
+	switch {
+	case s == "":
+		return nil, nil
+	case s == "cn":
+		return &CertIdentity{}, nil
+	case s == "uri":
+		return &CertIdentity{URI: true}, nil
+	case strings.HasPrefix(s, "uri:"):
+		return &CertIdentity{URI: true, Prefix: s[len("uri:"):]}, nil
+	}
+	return nil, fmt.Errorf("invalid client certificate identity %q (expected cn, uri or uri:<prefix>)", s)
+}
+
+func (ci *CertIdentity) String() string {
+	switch {
+	case !ci.URI:
+		return "cn"
+	case ci.Prefix == "":
+		return "uri"
+	}
+	return "uri:" + ci.Prefix
+}
+
+// Username gets the user name of cert, if any.
+func (ci *CertIdentity) Username(cert *x509.Certificate) (string, bool) {
+	if !ci.URI {
+		return cert.Subject.CommonName, cert.Subject.CommonName != ""
+	}
+	for _, uri := range cert.URIs {
+		u := uri.String()
+		if strings.HasPrefix(u, ci.Prefix) && len(u) > len(ci.Prefix) {
+			return u[len(ci.Prefix):], true
+		}
+	}
+	return "", false
+}
+
+func (as *authStore) SetCertIdentity(ci *CertIdentity) {
+	as.certIdentityMu.Lock()
+	as.certIdentity = ci
+	as.certIdentityMu.Unlock()
+}
+
+// authInfoFromTLS gets AuthInfo from the verified client certificate of
+// the gRPC connection of ctx.
+func (as *authStore) authInfoFromTLS(ctx context.Context) *AuthInfo {
+	as.certIdentityMu.RLock()
+	ci := as.certIdentity
+	as.certIdentityMu.RUnlock()
+	if ci == nil {
+		return nil
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil
+	}
+	if _, ok := p.Addr.(GatewayAddr); ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	for _, chain := range tlsInfo.State.VerifiedChains {
+		if len(chain) == 0 {
+			continue
+		}
+		if name, ok := ci.Username(chain[0]); ok {
+			return &AuthInfo{Username: name, Revision: as.Revision()}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/url"
+	"os"
+	"testing"
+
+	"etcd/mvcc/backend"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestCertIdentityUsername(t *testing.T) {
+	u1, _ := url.Parse("https://example.com/svc")
+	u2, _ := url.Parse("spiffe://example.org/user/alice")
+	cert := &x509.Certificate{
+		Subject: pkix.Name{CommonName: "bob"},
+		URIs:    []*url.URL{u1, u2},
+	}
+
+	tests := []struct {
+		rule string
+
+		wname string
+		wok   bool
+	}{
+		{"cn", "bob", true},
+		{"uri", "https://example.com/svc", true},
+		{"uri:spiffe://example.org/user/", "alice", true},
+		{"uri:spiffe://example.org/admin/", "", false},
+	}
+	for i, tt := range tests {
+		ci, err := ParseCertIdentity(tt.rule)
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		if ci.String() != tt.rule {
+			t.Errorf("#%d: rule = %q, want %q", i, ci.String(), tt.rule)
+		}
+		name, ok := ci.Username(cert)
+		if name != tt.wname || ok != tt.wok {
+			t.Errorf("#%d: username = %q, %v, want %q, %v", i, name, ok, tt.wname, tt.wok)
+		}
+	}
+
+	if ci, err := ParseCertIdentity(""); ci != nil || err != nil {
+		t.Errorf("empty rule = %v, %v, want nil, nil", ci, err)
+	}
+	if _, err := ParseCertIdentity("dns"); err == nil {
+		t.Errorf("expected error on unknown rule")
+	}
+}
+
+// TestAuthInfoFromTLSGateway ensures the certificate of the connections of
+// the grpc-gateway does not authenticate its requests.
+func TestAuthInfoFromTLSGateway(t *testing.T) {
+	b, tPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tPath)
+	as := NewAuthStore(b, dummyIndexWaiter)
+	defer as.Close()
+	as.SetCertIdentity(&CertIdentity{})
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "bob"}}
+	tlsInfo := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+
+	ctx := peer.NewContext(context.TODO(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}, AuthInfo: tlsInfo})
+	if ai := as.authInfoFromTLS(ctx); ai == nil || ai.Username != "bob" {
+		t.Errorf("auth info = %+v, want user bob", ai)
+	}
+	ctx = peer.NewContext(context.TODO(), &peer.Peer{Addr: GatewayAddr{}, AuthInfo: tlsInfo})
+	if ai := as.authInfoFromTLS(ctx); ai != nil {
+		t.Errorf("auth info = %+v, want nil for the gateway", ai)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+
+	"etcd/auth/authpb"
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc/backend"
+	"etcd/pkg/keyutil"
+)
+
+// adminScope is the set of key prefixes a delegated admin manages.
+type adminScope struct {
+	tx       backend.BatchTx
+	prefixes [][]byte
+}
+
+func getAdminScope(tx backend.BatchTx, roles []string) *adminScope {
+	s := &adminScope{tx: tx}
+	for _, roleName := range roles {
+		role := getRole(tx, roleName)
+		if role == nil || role.Options == nil || !role.Options.Admin {
+			continue
+		}
+		s.prefixes = append(s.prefixes, role.Options.AdminPrefix)
+	}
+	return s
+}
+
+// coversRange returns true if a prefix of the scope covers the key or the
+// range [key, rangeEnd).
+func (s *adminScope) coversRange(key, rangeEnd []byte) bool {
+	for _, p := range s.prefixes {
+		if !bytes.HasPrefix(key, p) {
+			continue
+		}
+		if len(rangeEnd) == 0 {
+			return true
+		}
+		pend := keyutil.PrefixEnd(p)
+		if keyutil.IsFromKey(pend) {
+			// the prefix covers the whole keyspace
+			return true
+		}
+		if !keyutil.IsFromKey(rangeEnd) && bytes.Compare(rangeEnd, pend) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// coversRole returns true if the scope covers all permissions of a role;
+// only the permissions of delegated admin roles within the scope are
+// covered. The root role is never covered.
+func (s *adminScope) coversRole(name string) bool {
+	if name == rootRole {
+		return false
+	}
+	role := getRole(s.tx, name)
+	if role == nil {
+		// let the request fail on the missing role
+		return true
+	}
+	for _, perm := range role.KeyPermission {
+		if !s.coversRange(perm.Key, perm.RangeEnd) {
+			return false
+		}
+	}
+	return s.coversOptions(role.Options)
+}
+
+func (s *adminScope) coversOptions(opts *authpb.RoleAddOptions) bool {
+	if opts == nil || !opts.Admin {
+		return true
+	}
+	return s.coversRange(opts.AdminPrefix, keyutil.PrefixEnd(opts.AdminPrefix))
+}
+
+// coversUser returns true if the user has roles and the scope covers all
+// of them. Users that do not exist or have no roles are outside every
+// scope, so they are left to the root.
+func (s *adminScope) coversUser(name string) bool {
+	u := getUser(s.tx, name)
+	if u == nil || len(u.Roles) == 0 {
+		return false
+	}
+	for _, role := range u.Roles {
+		if getRole(s.tx, role) == nil || !s.coversRole(role) {
+			return false
+		}
+	}
+	return true
+}
+
+// coversNewUser returns true if the scope may grant a first role to the
+// user, which is how the users added by a delegated admin enter its scope.
+func (s *adminScope) coversNewUser(name string) bool {
+	u := getUser(s.tx, name)
+	return u != nil && len(u.Roles) == 0
+}
+
+// IsDelegatedAdminPermitted checks if the user of authInfo may issue the
+// user or role management request r with its delegated admin roles.
+func (as *authStore) IsDelegatedAdminPermitted(authInfo *AuthInfo, r *pb.InternalRaftRequest) error {
+	if !as.isAuthEnabled() {
+		return nil
+	}
+	if authInfo == nil {
+		return ErrUserEmpty
+	}
+
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	roles, found := userRoles(tx, authInfo)
+	if !found {
+		return ErrUserNotFound
+	}
+	s := getAdminScope(tx, roles)
+	if len(s.prefixes) == 0 {
+		return ErrPermissionDenied
+	}
+
+	var ok bool
+	switch {
+	case r.AuthUserAdd != nil, r.AuthUserGet != nil, r.AuthUserList != nil, r.AuthRoleGet != nil, r.AuthRoleList != nil:
+		ok = true
+	case r.AuthUserDelete != nil:
+		ok = s.coversUser(r.AuthUserDelete.Name)
+	case r.AuthUserChangePassword != nil:
+		ok = s.coversUser(r.AuthUserChangePassword.Name)
+	case r.AuthUserGrantRole != nil:
+		gr := r.AuthUserGrantRole
+		ok = (s.coversUser(gr.User) || s.coversNewUser(gr.User)) && s.coversRole(gr.Role)
+	case r.AuthUserRevokeRole != nil:
+		ok = s.coversUser(r.AuthUserRevokeRole.Name) && s.coversRole(r.AuthUserRevokeRole.Role)
+	case r.AuthRoleAdd != nil:
+		ok = s.coversOptions(r.AuthRoleAdd.Options)
+	case r.AuthRoleDelete != nil:
+		ok = s.coversRole(r.AuthRoleDelete.Role)
+	case r.AuthRoleGrantPermission != nil:
+		perm := r.AuthRoleGrantPermission.Perm
+		ok = s.coversRole(r.AuthRoleGrantPermission.Name) && perm != nil && s.coversRange(perm.Key, perm.RangeEnd)
+	case r.AuthRoleRevokePermission != nil:
+		rr := r.AuthRoleRevokePermission
+		ok = s.coversRole(rr.Role) && s.coversRange([]byte(rr.Key), []byte(rr.RangeEnd))
+	}
+	if !ok {
+		return ErrPermissionDenied
+	}
+	return nil
+}
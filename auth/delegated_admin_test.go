@@ -0,0 +1,125 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"etcd/auth/authpb"
+	pb "etcd/etcdserver/etcdserverpb"
+)
+
+func TestIsDelegatedAdminPermitted(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	// "foo" administers the tenant "/team-a/"
+	opts := &authpb.RoleAddOptions{Admin: true, AdminPrefix: []byte("/team-a/")}
+	if _, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "team-a-admin", Options: opts}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "team-a-admin"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "team-a-app"}); err != nil {
+		t.Fatal(err)
+	}
+	perm := &authpb.Permission{PermType: authpb.READWRITE, Key: []byte("/team-a/app/"), RangeEnd: []byte("/team-a/app0")}
+	if _, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "team-a-app", Perm: perm}); err != nil {
+		t.Fatal(err)
+	}
+	// "role-test" holds keys outside the tenant
+	perm = &authpb.Permission{PermType: authpb.READ, Key: []byte("/team-b/")}
+	if _, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-test", Perm: perm}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := as.UserAdd(&pb.AuthUserAddRequest{Name: "app", Password: "app"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		r  *pb.InternalRaftRequest
+		ok bool
+	}{
+		{&pb.InternalRaftRequest{AuthUserAdd: &pb.AuthUserAddRequest{Name: "new"}}, true},
+		{&pb.InternalRaftRequest{AuthRoleList: &pb.AuthRoleListRequest{}}, true},
+		{&pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "app", Role: "team-a-app"}}, true},
+		{&pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "app", Role: "role-test"}}, false},
+		{&pb.InternalRaftRequest{AuthUserGrantRole: &pb.AuthUserGrantRoleRequest{User: "app", Role: "root"}}, false},
+		{&pb.InternalRaftRequest{AuthUserChangePassword: &pb.AuthUserChangePasswordRequest{Name: "root"}}, false},
+		// "app" has no roles yet
+		{&pb.InternalRaftRequest{AuthUserChangePassword: &pb.AuthUserChangePasswordRequest{Name: "app"}}, false},
+		{&pb.InternalRaftRequest{AuthUserDelete: &pb.AuthUserDeleteRequest{Name: "app"}}, false},
+		{&pb.InternalRaftRequest{AuthUserDelete: &pb.AuthUserDeleteRequest{Name: "nonexistent"}}, false},
+		{&pb.InternalRaftRequest{AuthUserRevokeRole: &pb.AuthUserRevokeRoleRequest{Name: "nonexistent", Role: "team-a-app"}}, false},
+		{&pb.InternalRaftRequest{AuthRoleDelete: &pb.AuthRoleDeleteRequest{Role: "role-test"}}, false},
+		{
+			&pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{
+				Name: "team-a-app",
+				Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("/team-a/"), RangeEnd: []byte("/team-a0")},
+			}},
+			true,
+		},
+		{
+			&pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{
+				Name: "team-a-app",
+				Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("/team-a/"), RangeEnd: []byte{0}},
+			}},
+			false,
+		},
+		{
+			&pb.InternalRaftRequest{AuthRoleGrantPermission: &pb.AuthRoleGrantPermissionRequest{
+				Name: "team-a-app",
+				Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("/team-b/")},
+			}},
+			false,
+		},
+		{&pb.InternalRaftRequest{AuthRoleRevokePermission: &pb.AuthRoleRevokePermissionRequest{Role: "team-a-app", Key: "/team-a/app/", RangeEnd: "/team-a/app0"}}, true},
+		{&pb.InternalRaftRequest{AuthRoleAdd: &pb.AuthRoleAddRequest{Name: "sub", Options: &authpb.RoleAddOptions{Admin: true, AdminPrefix: []byte("/team-a/sub/")}}}, true},
+		{&pb.InternalRaftRequest{AuthRoleAdd: &pb.AuthRoleAddRequest{Name: "all", Options: &authpb.RoleAddOptions{Admin: true}}}, false},
+		{&pb.InternalRaftRequest{AuthEnable: &pb.AuthEnableRequest{}}, false},
+	}
+	for i, tt := range tests {
+		err := as.IsDelegatedAdminPermitted(&AuthInfo{Username: "foo"}, tt.r)
+		if tt.ok && err != nil {
+			t.Errorf("#%d: unexpected error %v", i, err)
+		}
+		if !tt.ok && err != ErrPermissionDenied {
+			t.Errorf("#%d: expected %v, got %v", i, ErrPermissionDenied, err)
+		}
+	}
+
+	// once granted a role within the scope, "app" is in the scope
+	if _, err := as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "app", Role: "team-a-app"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []*pb.InternalRaftRequest{
+		{AuthUserChangePassword: &pb.AuthUserChangePasswordRequest{Name: "app"}},
+		{AuthUserDelete: &pb.AuthUserDeleteRequest{Name: "app"}},
+	} {
+		if err := as.IsDelegatedAdminPermitted(&AuthInfo{Username: "foo"}, r); err != nil {
+			t.Errorf("%v: unexpected error %v", r, err)
+		}
+	}
+
+	// users without delegated admin roles are denied
+	if _, err := as.UserAdd(&pb.AuthUserAddRequest{Name: "bar", Password: "bar"}); err != nil {
+		t.Fatal(err)
+	}
+	r := &pb.InternalRaftRequest{AuthUserList: &pb.AuthUserListRequest{}}
+	if err := as.IsDelegatedAdminPermitted(&AuthInfo{Username: "bar"}, r); err != ErrPermissionDenied {
+		t.Errorf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+}
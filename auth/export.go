@@ -0,0 +1,293 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"sort"
+
+	"etcd/auth/authpb"
+	pb "etcd/etcdserver/etcdserverpb"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (as *authStore) AuthExport(r *pb.AuthExportRequest) (*pb.AuthExportResponse, error) {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	resp := &pb.AuthExportResponse{Users: getAllUsers(tx), Roles: getAllRoles(tx)}
+	if !r.WithPasswords {
+		for _, u := range resp.Users {
+			u.Password = nil
+		}
+	}
+	return resp, nil
+}
+
+func (as *authStore) AuthImport(r *pb.AuthImportRequest) (*pb.AuthImportResponse, error) {
+	if err := checkAuthImport(r); err != nil {
+		return nil, err
+	}
+	sort.Sort(userSliceByName(r.Users))
+	sort.Sort(roleSliceByName(r.Roles))
+
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	imported := make(map[string]bool, len(r.Roles))
+	for _, role := range r.Roles {
+		imported[string(role.Name)] = true
+	}
+	for _, u := range r.Users {
+		for _, role := range u.Roles {
+			if role != rootRole && !imported[role] && getRole(tx, role) == nil {
+				plog.Warningf("role %s of imported user %s does not exist", role, u.Name)
+				return nil, ErrRoleNotFound
+			}
+		}
+		// the import must not lock the root user out
+		if string(u.Name) == rootUser && as.isAuthEnabled() && !hasRootRole(u.Roles) {
+			return nil, ErrRootRoleNotExist
+		}
+	}
+
+	resp := &pb.AuthImportResponse{}
+	var users []*authpb.User
+	var roles []*authpb.Role
+	var passwordChanged []string
+
+	for _, u := range r.Users {
+		cur := getUser(tx, string(u.Name))
+		nu, fields := importUser(cur, u)
+		if cur != nil && len(fields) == 0 {
+			continue
+		}
+		c := &pb.AuthImportChange{Kind: pb.AuthImportChange_USER, Name: string(u.Name)}
+		if cur != nil {
+			c.Action, c.Fields = pb.AuthImportChange_UPDATE, fields
+		}
+		resp.Changes = append(resp.Changes, c)
+		users = append(users, nu)
+		if cur != nil && !bytes.Equal(nu.Password, cur.Password) {
+			passwordChanged = append(passwordChanged, string(u.Name))
+		}
+	}
+
+	for _, role := range r.Roles {
+		cur := getRole(tx, string(role.Name))
+		nr, fields := importRole(cur, role)
+		if cur != nil && len(fields) == 0 {
+			continue
+		}
+		c := &pb.AuthImportChange{Kind: pb.AuthImportChange_ROLE, Name: string(role.Name)}
+		if cur != nil {
+			c.Action, c.Fields = pb.AuthImportChange_UPDATE, fields
+		}
+		resp.Changes = append(resp.Changes, c)
+		roles = append(roles, nr)
+	}
+
+	if r.DryRun || len(resp.Changes) == 0 {
+		return resp, nil
+	}
+
+	for _, u := range users {
+		putUser(tx, u)
+	}
+	for _, role := range roles {
+		putRole(tx, role)
+	}
+
+	as.clearCachedPerm()
+	for _, name := range passwordChanged {
+		as.invalidateUser(name)
+	}
+
+	as.commitRevision(tx)
+
+	plog.Noticef("imported %d users and %d roles", len(users), len(roles))
+	return resp, nil
+}
+
+// checkAuthImport checks that the users and the roles of an import are
+// named, unique and that the password hashes are bcrypt hashes.
+func checkAuthImport(r *pb.AuthImportRequest) error {
+	users := make(map[string]bool, len(r.Users))
+	for _, u := range r.Users {
+		if len(u.Name) == 0 {
+			return ErrUserEmpty
+		}
+		if users[string(u.Name)] {
+			plog.Warningf("user %s is imported twice", u.Name)
+			return ErrInvalidAuthImport
+		}
+		users[string(u.Name)] = true
+
+		if len(u.Password) == 0 {
+			continue
+		}
+		if _, err := bcrypt.Cost(u.Password); err != nil {
+			plog.Warningf("password of imported user %s is not a bcrypt hash (%v)", u.Name, err)
+			return ErrInvalidAuthImport
+		}
+	}
+
+	roles := make(map[string]bool, len(r.Roles))
+	for _, role := range r.Roles {
+		if len(role.Name) == 0 {
+			plog.Warningf("imported role has no name")
+			return ErrInvalidAuthImport
+		}
+		if roles[string(role.Name)] {
+			plog.Warningf("role %s is imported twice", role.Name)
+			return ErrInvalidAuthImport
+		}
+		roles[string(role.Name)] = true
+
+		for _, perm := range role.KeyPermission {
+			if perm == nil {
+				plog.Warningf("imported role %s has an empty permission", role.Name)
+				return ErrInvalidAuthImport
+			}
+		}
+	}
+	return nil
+}
+
+// importUser gets the user u updates cur to, and the updated fields. A nil
+// cur is a new user.
+func importUser(cur, u *authpb.User) (*authpb.User, []string) {
+	nu := &authpb.User{Name: u.Name}
+	if cur != nil {
+		*nu = *cur
+	}
+
+	var fields []string
+	if isNoPassword(u) {
+		u.Password = nil
+		if len(nu.Password) != 0 {
+			nu.Password = nil
+			nu.PasswordChanged = 0
+			fields = append(fields, "password")
+		}
+	}
+	if len(u.Password) != 0 && !bytes.Equal(u.Password, nu.Password) {
+		// a new password lifts the lockout
+		nu.Password = u.Password
+		nu.PasswordChanged = u.PasswordChanged
+		nu.FailedAttempts = 0
+		nu.LockedUntil = 0
+		fields = append(fields, "password")
+	}
+
+	roles := uniqueSortedRoles(u.Roles)
+	if !equalRoles(roles, nu.Roles) {
+		nu.Roles = roles
+		fields = append(fields, "roles")
+	}
+
+	if isNoPassword(u) != isNoPassword(nu) {
+		nu.Options = u.Options
+		fields = append(fields, "options")
+	}
+	return nu, fields
+}
+
+// importRole gets the role r updates cur to, and the updated fields. A nil
+// cur is a new role.
+func importRole(cur, r *authpb.Role) (*authpb.Role, []string) {
+	nr := &authpb.Role{Name: r.Name}
+	if cur != nil {
+		*nr = *cur
+	}
+
+	var fields []string
+	perms := append([]*authpb.Permission(nil), r.KeyPermission...)
+	sort.Sort(permSlice(perms))
+	if !equalPerms(perms, nr.KeyPermission) {
+		nr.KeyPermission = perms
+		fields = append(fields, "permissions")
+	}
+
+	if !equalRoleOptions(r.Options, nr.Options) {
+		nr.Options = r.Options
+		fields = append(fields, "options")
+	}
+	return nr, fields
+}
+
+func isNoPassword(u *authpb.User) bool {
+	return u.Options != nil && u.Options.NoPassword
+}
+
+func equalRoleOptions(a, b *authpb.RoleAddOptions) bool {
+	if a == nil || b == nil {
+		return (a == nil || !a.Admin && len(a.AdminPrefix) == 0) &&
+			(b == nil || !b.Admin && len(b.AdminPrefix) == 0)
+	}
+	return a.Admin == b.Admin && bytes.Equal(a.AdminPrefix, b.AdminPrefix)
+}
+
+func uniqueSortedRoles(roles []string) []string {
+	var ret []string
+	sorted := append([]string(nil), roles...)
+	sort.Strings(sorted)
+	for i, role := range sorted {
+		if i == 0 || role != sorted[i-1] {
+			ret = append(ret, role)
+		}
+	}
+	return ret
+}
+
+func equalRoles(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalPerms(a, b []*authpb.Permission) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].PermType != b[i].PermType || a[i].Deny != b[i].Deny ||
+			!bytes.Equal(a[i].Key, b[i].Key) || !bytes.Equal(a[i].RangeEnd, b[i].RangeEnd) {
+			return false
+		}
+	}
+	return true
+}
+
+type userSliceByName []*authpb.User
+
+func (s userSliceByName) Len() int           { return len(s) }
+func (s userSliceByName) Less(i, j int) bool { return bytes.Compare(s[i].Name, s[j].Name) < 0 }
+func (s userSliceByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type roleSliceByName []*authpb.Role
+
+func (s roleSliceByName) Len() int           { return len(s) }
+func (s roleSliceByName) Less(i, j int) bool { return bytes.Compare(s[i].Name, s[j].Name) < 0 }
+func (s roleSliceByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,169 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"reflect"
+	"testing"
+
+	"etcd/auth/authpb"
+	pb "etcd/etcdserver/etcdserverpb"
+)
+
+func TestAuthExport(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	resp, err := as.AuthExport(&pb.AuthExportRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Users) != 2 || string(resp.Users[0].Name) != "foo" || string(resp.Users[1].Name) != "root" {
+		t.Fatalf("users = %+v, want foo and root", resp.Users)
+	}
+	if len(resp.Roles) != 2 || string(resp.Roles[0].Name) != "role-test" || string(resp.Roles[1].Name) != "root" {
+		t.Fatalf("roles = %+v, want role-test and root", resp.Roles)
+	}
+	for _, u := range resp.Users {
+		if len(u.Password) != 0 {
+			t.Fatalf("password of %s is exported", u.Name)
+		}
+	}
+
+	resp, err = as.AuthExport(&pb.AuthExportRequest{WithPasswords: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, u := range resp.Users {
+		if len(u.Password) == 0 {
+			t.Fatalf("password of %s is not exported", u.Name)
+		}
+	}
+}
+
+func TestAuthImport(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	exported, err := as.AuthExport(&pb.AuthExportRequest{WithPasswords: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := exported.Users[0].Password
+
+	newRequest := func(dryRun bool) *pb.AuthImportRequest {
+		return &pb.AuthImportRequest{
+			Users: []*authpb.User{
+				{Name: []byte("foo"), Roles: []string{"role-new", "role-test"}},
+				{Name: []byte("bob"), Password: hash},
+			},
+			Roles: []*authpb.Role{
+				{Name: []byte("role-test"), KeyPermission: []*authpb.Permission{{PermType: authpb.READ, Key: []byte("t")}}},
+				{Name: []byte("role-new")},
+			},
+			DryRun: dryRun,
+		}
+	}
+	wchanges := []*pb.AuthImportChange{
+		{Kind: pb.AuthImportChange_USER, Action: pb.AuthImportChange_ADD, Name: "bob"},
+		{Kind: pb.AuthImportChange_USER, Action: pb.AuthImportChange_UPDATE, Name: "foo", Fields: []string{"roles"}},
+		{Kind: pb.AuthImportChange_ROLE, Action: pb.AuthImportChange_ADD, Name: "role-new"},
+		{Kind: pb.AuthImportChange_ROLE, Action: pb.AuthImportChange_UPDATE, Name: "role-test", Fields: []string{"permissions"}},
+	}
+
+	resp, err := as.AuthImport(newRequest(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.Changes, wchanges) {
+		t.Fatalf("changes = %+v, want %+v", resp.Changes, wchanges)
+	}
+	if _, err = as.RoleGet(&pb.AuthRoleGetRequest{Role: "role-new"}); err != ErrRoleNotFound {
+		t.Fatalf("err = %v, want %v after a dry run", err, ErrRoleNotFound)
+	}
+
+	rev := as.Revision()
+	if resp, err = as.AuthImport(newRequest(false)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.Changes, wchanges) {
+		t.Fatalf("changes = %+v, want %+v", resp.Changes, wchanges)
+	}
+	if as.Revision() != rev+1 {
+		t.Fatalf("revision = %d, want %d", as.Revision(), rev+1)
+	}
+	if _, err = as.CheckPassword("bob", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	// the password of foo is kept
+	if _, err = as.CheckPassword("foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	ur, err := as.UserGet(&pb.AuthUserGetRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ur.Roles, []string{"role-new", "role-test"}) {
+		t.Fatalf("roles of foo = %v, want role-new and role-test", ur.Roles)
+	}
+
+	// importing again changes nothing
+	if resp, err = as.AuthImport(newRequest(false)); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Changes) != 0 {
+		t.Fatalf("changes = %+v, want none", resp.Changes)
+	}
+}
+
+func TestAuthImportInvalid(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	tests := []struct {
+		r   *pb.AuthImportRequest
+		err error
+	}{
+		{
+			&pb.AuthImportRequest{Users: []*authpb.User{{Name: []byte("bob"), Roles: []string{"role-none"}}}},
+			ErrRoleNotFound,
+		},
+		{
+			&pb.AuthImportRequest{Users: []*authpb.User{{Name: []byte("root"), Roles: []string{"role-test"}}}},
+			ErrRootRoleNotExist,
+		},
+		{
+			&pb.AuthImportRequest{Users: []*authpb.User{{Name: []byte("bob"), Password: []byte("bar")}}},
+			ErrInvalidAuthImport,
+		},
+		{
+			&pb.AuthImportRequest{Users: []*authpb.User{{Name: []byte("bob")}, {Name: []byte("bob")}}},
+			ErrInvalidAuthImport,
+		},
+		{
+			&pb.AuthImportRequest{Roles: []*authpb.Role{{}}},
+			ErrInvalidAuthImport,
+		},
+		{
+			&pb.AuthImportRequest{Users: []*authpb.User{{}}},
+			ErrUserEmpty,
+		},
+	}
+	for i, tt := range tests {
+		if _, err := as.AuthImport(tt.r); err != tt.err {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,400 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // registers SHA-256 for crypto.SHA256
+	_ "crypto/sha512" // registers SHA-384 and SHA-512
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// OIDC verifies the OIDC ID tokens, or other JWTs, issued by an identity
+// provider, so that its users need not be created in etcd. The keys of
+// the provider are a static JSON Web Key Set; no network access is needed.
+type OIDC struct {
+	// Issuer is the "iss" claim the tokens must have.
+	Issuer string
+	// Audience is the "aud" claim the tokens must have, if not empty.
+	Audience string
+	// UsernameClaim is the claim with the user name; "sub" if empty.
+	UsernameClaim string
+	// UsernamePrefix is prepended to the user names, so that the users of
+	// the provider cannot pass for the users of etcd, like root.
+	UsernamePrefix string
+	// GroupsClaim is the claim with the groups of the user, which are
+	// roles of etcd; the tokens grant no roles if it is empty.
+	GroupsClaim string
+	// GroupsPrefix is prepended to the groups to get the role names.
+	GroupsPrefix string
+	// AllowRootGroup lets a group grant the root role; such groups are
+	// ignored otherwise, so that the provider cannot grant root by mistake.
+	AllowRootGroup bool
+	// Keys are the keys verifying the signatures of the tokens by key ID.
+	Keys map[string]crypto.PublicKey
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// ParseJWKS parses the signature keys of a JSON Web Key Set by key ID.
+// RSA keys and EC keys on P-256, P-384 and P-521 are supported; the other
+// keys are skipped.
+func ParseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %v", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var (
+			key crypto.PublicKey
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			key, err = k.rsaKey()
+		case "EC":
+			key, err = k.ecKey()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWKS key %q: %v", k.Kid, err)
+		}
+		if _, ok := keys[k.Kid]; ok {
+			return nil, fmt.Errorf("invalid JWKS: duplicate key %q", k.Kid)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("invalid JWKS: no signature keys")
+	}
+	return keys, nil
+}
+
+func (k *jsonWebKey) rsaKey() (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeBigInt(k.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() < 2 || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid RSA exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (k *jsonWebKey) ecKey() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+	x, err := decodeBigInt(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBigInt(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, errors.New("point not on curve")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty integer")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// isJWT returns true if token is in the JWS compact form of a JWT; the
+// simple tokens of etcd have a single dot.
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// Verify verifies the signature and the claims of token at the time now,
+// and gets the user name and the roles it asserts.
+func (o *OIDC) Verify(token string, now time.Time) (string, []string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", nil, errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return "", nil, fmt.Errorf("malformed header: %v", err)
+	}
+	key, err := o.key(header.Kid)
+	if err != nil {
+		return "", nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, fmt.Errorf("malformed signature: %v", err)
+	}
+	if err = verifySignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return "", nil, err
+	}
+
+	var claims map[string]interface{}
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return "", nil, fmt.Errorf("malformed claims: %v", err)
+	}
+	return o.verifyClaims(claims, now)
+}
+
+func (o *OIDC) key(kid string) (crypto.PublicKey, error) {
+	if key, ok := o.Keys[kid]; ok {
+		return key, nil
+	}
+	if kid == "" && len(o.Keys) == 1 {
+		for _, key := range o.Keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+func decodeSegment(s string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// ecAlgCurveBits is the size of the curve each ECDSA algorithm signs with.
+var ecAlgCurveBits = map[string]int{"ES256": 256, "ES384": 384, "ES512": 521}
+
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	var h crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		h = crypto.SHA256
+	case "RS384", "ES384":
+		h = crypto.SHA384
+	case "RS512", "ES512":
+		h = crypto.SHA512
+	default:
+		// notably, "none" and the HMAC algorithms are never accepted
+		return fmt.Errorf("unsupported signature algorithm %q", alg)
+	}
+	hasher := h.New()
+	hasher.Write(signed)
+	digest := hasher.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if alg[0] != 'R' {
+			break
+		}
+		if rsa.VerifyPKCS1v15(k, h, digest, sig) != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if k.Curve.Params().BitSize != ecAlgCurveBits[alg] {
+			break
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid signature")
+		}
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("signature algorithm %q does not match the key", alg)
+}
+
+func (o *OIDC) verifyClaims(claims map[string]interface{}, now time.Time) (string, []string, error) {
+	if iss, _ := claims["iss"].(string); iss != o.Issuer {
+		return "", nil, fmt.Errorf("unexpected issuer %q", iss)
+	}
+	if o.Audience != "" && !hasAudience(claims["aud"], o.Audience) {
+		return "", nil, fmt.Errorf("audience %q not in the token", o.Audience)
+	}
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return "", nil, errors.New("no expiry")
+	}
+	if !now.Before(exp) {
+		return "", nil, errors.New("expired token")
+	}
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Before(nbf) {
+		return "", nil, errors.New("token not valid yet")
+	}
+
+	usernameClaim := o.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = "sub"
+	}
+	name, _ := claims[usernameClaim].(string)
+	if name == "" {
+		return "", nil, fmt.Errorf("no %q claim", usernameClaim)
+	}
+	if usernameClaim == "email" {
+		// an unverified email is anyone's to claim
+		if verified, ok := claims["email_verified"].(bool); ok && !verified {
+			return "", nil, errors.New("unverified email")
+		}
+	}
+
+	var roles []string
+	if o.GroupsClaim != "" {
+		groups, ok := stringList(claims[o.GroupsClaim])
+		if !ok {
+			return "", nil, fmt.Errorf("invalid %q claim", o.GroupsClaim)
+		}
+		for _, g := range groups {
+			r := o.GroupsPrefix + g
+			if r == rootRole && !o.AllowRootGroup {
+				continue
+			}
+			roles = append(roles, r)
+		}
+	}
+	return o.UsernamePrefix + name, roles, nil
+}
+
+func hasAudience(aud interface{}, audience string) bool {
+	auds, _ := stringList(aud)
+	for _, a := range auds {
+		if a == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// stringList gets a claim that is a string or a list of strings.
+func stringList(v interface{}) ([]string, bool) {
+	switch vv := v.(type) {
+	case nil:
+		return nil, true
+	case string:
+		return []string{vv}, true
+	case []interface{}:
+		l := make([]string, 0, len(vv))
+		for _, e := range vv {
+			s, ok := e.(string)
+			if !ok {
+				return nil, false
+			}
+			l = append(l, s)
+		}
+		return l, true
+	}
+	return nil, false
+}
+
+func numericDate(v interface{}) (time.Time, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(f), 0), true
+}
+
+func (as *authStore) SetOIDC(o *OIDC) {
+	as.oidcMu.Lock()
+	as.oidc = o
+	as.oidcMu.Unlock()
+}
+
+// bearerToken gets the token of the "authorization" metadata, which the
+// grpc-gateway forwards from the Authorization header.
+func bearerToken(md metadata.MD) (string, bool) {
+	for _, v := range md["authorization"] {
+		if len(v) > len("bearer ") && strings.EqualFold(v[:len("bearer ")], "bearer ") {
+			return v[len("bearer "):], true
+		}
+	}
+	return "", false
+}
+
+// authInfoFromOIDC gets AuthInfo from an OIDC token. It returns false if
+// OIDC is disabled or token is not a JWT.
+func (as *authStore) authInfoFromOIDC(token string) (*AuthInfo, bool, error) {
+	as.oidcMu.RLock()
+	o := as.oidc
+	as.oidcMu.RUnlock()
+	if o == nil || !isJWT(token) {
+		return nil, false, nil
+	}
+
+	name, roles, err := o.Verify(token, time.Now())
+	if err != nil {
+		plog.Warningf("invalid OIDC token: %v", err)
+		return nil, true, ErrInvalidAuthToken
+	}
+	return &AuthInfo{Username: name, Revision: as.Revision(), Roles: roles}, true, nil
+}
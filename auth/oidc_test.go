@@ -0,0 +1,318 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"etcd/auth/authpb"
+	pb "etcd/etcdserver/etcdserverpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+func testJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) []byte {
+	size := (ecKey.Curve.Params().BitSize + 7) / 8
+	pad := func(n *big.Int) []byte {
+		b := n.Bytes()
+		return append(make([]byte, size-len(b)), b...)
+	}
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(pad(ecKey.X)), "y": b64(pad(ecKey.Y))},
+			{"kty": "RSA", "kid": "enc", "use": "enc", "n": b64(rsaKey.N.Bytes()), "e": "AQAB"},
+			{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"},
+		},
+	}
+	b, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func signToken(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	h, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := b64(h) + "." + b64(c)
+
+	hasher := crypto.SHA256.New()
+	hasher.Write([]byte(signed))
+	digest := hasher.Sum(nil)
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest); err != nil {
+			t.Fatal(err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	}
+	return signed + "." + b64(sig)
+}
+
+func newTestOIDC(t *testing.T) (*OIDC, *rsa.PrivateKey, *ecdsa.PrivateKey) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ParseJWKS(testJWKS(t, rsaKey, ecKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("len(keys) = %d, want 2", len(keys))
+	}
+	o := &OIDC{
+		Issuer:         "https://sso.example.com",
+		Audience:       "etcd",
+		UsernamePrefix: "sso:",
+		GroupsClaim:    "groups",
+		Keys:           keys,
+	}
+	return o, rsaKey, ecKey
+}
+
+func TestParseJWKS(t *testing.T) {
+	tests := []string{
+		`{`,
+		`{"keys": []}`,
+		`{"keys": [{"kty": "oct", "k": "c2VjcmV0"}]}`,
+		`{"keys": [{"kty": "RSA", "n": "", "e": "AQAB"}]}`,
+		`{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`,
+		`{"keys": [{"kty": "EC", "crv": "secp256k1", "x": "AQ", "y": "AQ"}]}`,
+		`{"keys": [{"kty": "RSA", "n": "AQAB", "e": "AQAB"}, {"kty": "RSA", "n": "AQAB", "e": "AQAB"}]}`,
+	}
+	for i, tt := range tests {
+		if _, err := ParseJWKS([]byte(tt)); err == nil {
+			t.Errorf("#%d: expected error on %s", i, tt)
+		}
+	}
+}
+
+func TestOIDCVerify(t *testing.T) {
+	o, rsaKey, ecKey := newTestOIDC(t)
+	now := time.Now()
+	claims := func(kv ...interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":    o.Issuer,
+			"aud":    []string{"other", "etcd"},
+			"sub":    "alice",
+			"exp":    now.Add(time.Hour).Unix(),
+			"groups": []string{"readers", "writers"},
+		}
+		for i := 0; i < len(kv); i += 2 {
+			if kv[i+1] == nil {
+				delete(c, kv[i].(string))
+			} else {
+				c[kv[i].(string)] = kv[i+1]
+			}
+		}
+		return c
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		token string
+
+		wname  string
+		wroles []string
+		werr   bool
+	}{
+		{signToken(t, "RS256", "rsa", rsaKey, claims()), "sso:alice", []string{"readers", "writers"}, false},
+		{signToken(t, "ES256", "ec", ecKey, claims("aud", "etcd", "groups", "readers")), "sso:alice", []string{"readers"}, false},
+		{signToken(t, "RS256", "rsa", rsaKey, claims("groups", nil, "nbf", now.Add(-time.Minute).Unix())), "sso:alice", nil, false},
+
+		// wrong keys or algorithms
+		{signToken(t, "RS256", "rsa", otherKey, claims()), "", nil, true},
+		{signToken(t, "RS256", "unknown", rsaKey, claims()), "", nil, true},
+		{signToken(t, "RS256", "", rsaKey, claims()), "", nil, true},
+		{signToken(t, "RS256", "enc", rsaKey, claims()), "", nil, true},
+		{signToken(t, "ES256", "rsa", ecKey, claims()), "", nil, true},
+		{signToken(t, "RS256", "ec", rsaKey, claims()), "", nil, true},
+		{signToken(t, "HS256", "rsa", rsaKey, claims()), "", nil, true},
+		{signToken(t, "none", "rsa", rsaKey, claims()), "", nil, true},
+
+		// wrong claims
+		{signToken(t, "RS256", "rsa", rsaKey, claims("iss", "https://evil.example.com")), "", nil, true},
+		{signToken(t, "RS256", "rsa", rsaKey, claims("aud", "other")), "", nil, true},
+		{signToken(t, "RS256", "rsa", rsaKey, claims("exp", nil)), "", nil, true},
+		{signToken(t, "RS256", "rsa", rsaKey, claims("exp", now.Add(-time.Minute).Unix())), "", nil, true},
+		{signToken(t, "RS256", "rsa", rsaKey, claims("nbf", now.Add(time.Minute).Unix())), "", nil, true},
+		{signToken(t, "RS256", "rsa", rsaKey, claims("sub", nil)), "", nil, true},
+		{signToken(t, "RS256", "rsa", rsaKey, claims("groups", 1)), "", nil, true},
+
+		{"a.b", "", nil, true},
+		{"a.b.c", "", nil, true},
+	}
+	for i, tt := range tests {
+		name, roles, err := o.Verify(tt.token, now)
+		if (err != nil) != tt.werr {
+			t.Errorf("#%d: err = %v, want error %v", i, err, tt.werr)
+			continue
+		}
+		if name != tt.wname || !reflect.DeepEqual(roles, tt.wroles) {
+			t.Errorf("#%d: got %q, %v, want %q, %v", i, name, roles, tt.wname, tt.wroles)
+		}
+	}
+
+	// a single key needs no key ID
+	single := *o
+	single.Keys = map[string]crypto.PublicKey{"rsa": o.Keys["rsa"]}
+	if _, _, err := single.Verify(signToken(t, "RS256", "", rsaKey, claims()), now); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// groups grant the root role only if allowed
+	rootClaims := claims("groups", []string{"readers", "root"})
+	if _, roles, err := o.Verify(signToken(t, "RS256", "rsa", rsaKey, rootClaims), now); err != nil || !reflect.DeepEqual(roles, []string{"readers"}) {
+		t.Errorf("got %v, %v, want %v", roles, err, []string{"readers"})
+	}
+	allowRoot := *o
+	allowRoot.AllowRootGroup = true
+	if _, roles, err := allowRoot.Verify(signToken(t, "RS256", "rsa", rsaKey, rootClaims), now); err != nil || !reflect.DeepEqual(roles, []string{"readers", "root"}) {
+		t.Errorf("got %v, %v, want %v", roles, err, []string{"readers", "root"})
+	}
+
+	email := *o
+	email.UsernameClaim, email.UsernamePrefix = "email", ""
+	if name, _, err := email.Verify(signToken(t, "RS256", "rsa", rsaKey, claims("email", "alice@example.com")), now); err != nil || name != "alice@example.com" {
+		t.Errorf("got %q, %v, want %q", name, err, "alice@example.com")
+	}
+	if _, _, err := email.Verify(signToken(t, "RS256", "rsa", rsaKey, claims("email", "alice@example.com", "email_verified", false)), now); err == nil {
+		t.Errorf("expected error on unverified email")
+	}
+}
+
+func TestOIDCPermission(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	o, rsaKey, _ := newTestOIDC(t)
+	o.GroupsPrefix = "sso-"
+	as.SetOIDC(o)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "sso-readers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name: "sso-readers",
+		Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("foo")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenCtx := func(groups ...string) context.Context {
+		token := signToken(t, "RS256", "rsa", rsaKey, map[string]interface{}{
+			"iss":    o.Issuer,
+			"aud":    "etcd",
+			"sub":    "alice",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"groups": groups,
+		})
+		return metadata.NewContext(context.Background(), metadata.New(map[string]string{"token": token}))
+	}
+
+	ai, err := as.AuthInfoFromCtx(tokenCtx("readers"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ai.Username != "sso:alice" || !reflect.DeepEqual(ai.Roles, []string{"sso-readers"}) {
+		t.Fatalf("unexpected auth info %+v", ai)
+	}
+	if err = as.IsRangePermitted(ai, []byte("foo"), nil); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err = as.IsPutPermitted(ai, []byte("foo")); err != ErrPermissionDenied {
+		t.Errorf("err = %v, want %v", err, ErrPermissionDenied)
+	}
+	if err = as.IsAdminPermitted(ai); err != ErrPermissionDenied {
+		t.Errorf("err = %v, want %v", err, ErrPermissionDenied)
+	}
+
+	// the same user without the group is cached apart
+	ai, err = as.AuthInfoFromCtx(tokenCtx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = as.IsRangePermitted(ai, []byte("foo"), nil); err != ErrPermissionDenied {
+		t.Errorf("err = %v, want %v", err, ErrPermissionDenied)
+	}
+
+	// the roles of the user of the same name are merged
+	_, err = as.UserAdd(&pb.AuthUserAddRequest{Name: "sso:alice", Options: &authpb.UserAddOptions{NoPassword: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "sso:alice", Role: "root"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ai, err = as.AuthInfoFromCtx(tokenCtx("readers"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = as.IsPutPermitted(ai, []byte("foo")); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err = as.IsAdminPermitted(ai); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	bearer := metadata.NewContext(context.Background(), metadata.New(map[string]string{"authorization": "Bearer a.b.c"}))
+	if _, err = as.AuthInfoFromCtx(bearer); err != ErrInvalidAuthToken {
+		t.Errorf("err = %v, want %v", err, ErrInvalidAuthToken)
+	}
+
+	as.SetOIDC(nil)
+	if _, err = as.AuthInfoFromCtx(tokenCtx("readers")); err != ErrInvalidAuthToken {
+		t.Errorf("err = %v, want %v", err, ErrInvalidAuthToken)
+	}
+}
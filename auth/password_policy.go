@@ -0,0 +1,83 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"time"
+
+	pb "etcd/etcdserver/etcdserverpb"
+)
+
+// PasswordPolicy is the policy of the passwords of the users. It is
+// enforced by the member receiving a request; failed authentications are
+// recorded with the policy of that member.
+type PasswordPolicy struct {
+	// BcryptCost is the cost of hashing passwords. 0 means BcryptCost.
+	BcryptCost int
+	// MinLength is the minimum length of new passwords.
+	MinLength int
+	// MaxFailedAttempts is the number of consecutive failed authentications
+	// locking a user out. 0 disables the lockout.
+	MaxFailedAttempts int
+	// LockoutDuration is how long a locked out user cannot authenticate
+	// with its password.
+	LockoutDuration time.Duration
+}
+
+func (as *authStore) SetPasswordPolicy(p PasswordPolicy) {
+	as.passwordPolicyMu.Lock()
+	as.passwordPolicy = p
+	as.passwordPolicyMu.Unlock()
+}
+
+func (as *authStore) PasswordPolicy() PasswordPolicy {
+	as.passwordPolicyMu.RLock()
+	defer as.passwordPolicyMu.RUnlock()
+	return as.passwordPolicy
+}
+
+func (as *authStore) CheckPasswordPolicy(password string) error {
+	if len(password) < as.PasswordPolicy().MinLength {
+		return ErrPasswordTooShort
+	}
+	return nil
+}
+
+func (as *authStore) bcryptCost() int {
+	if c := as.PasswordPolicy().BcryptCost; c != 0 {
+		return c
+	}
+	return BcryptCost
+}
+
+func (as *authStore) AuthenticateFailed(r *pb.InternalAuthenticateFailedRequest) error {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	user := getUser(tx, r.Name)
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	user.FailedAttempts++
+	if r.MaxFailedAttempts > 0 && user.FailedAttempts >= r.MaxFailedAttempts {
+		user.FailedAttempts = 0
+		user.LockedUntil = r.Timestamp + r.LockoutSeconds
+		plog.Warningf("user %s is locked out for %ds after %d failed authentications", r.Name, r.LockoutSeconds, r.MaxFailedAttempts)
+	}
+	putUser(tx, user)
+	return nil
+}
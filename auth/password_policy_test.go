@@ -0,0 +1,142 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"etcd/auth/authpb"
+	pb "etcd/etcdserver/etcdserverpb"
+)
+
+func TestUserAddNoPassword(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	ua := &pb.AuthUserAddRequest{Name: "nopass", Options: &authpb.UserAddOptions{NoPassword: true}}
+	if _, err := as.UserAdd(ua); err != nil {
+		t.Fatal(err)
+	}
+
+	// no password authenticates the user
+	if _, err := as.CheckPassword("nopass", ""); err != ErrAuthFailed {
+		t.Fatalf("expected %v, got %v", ErrAuthFailed, err)
+	}
+	_, err := as.UserChangePassword(&pb.AuthUserChangePasswordRequest{Name: "nopass", Password: "bar"})
+	if err != ErrNoPasswordUser {
+		t.Fatalf("expected %v, got %v", ErrNoPasswordUser, err)
+	}
+
+	resp, err := as.UserGet(&pb.AuthUserGetRequest{Name: "nopass"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Options == nil || !resp.Options.NoPassword {
+		t.Fatalf("expected no password option, got %+v", resp.Options)
+	}
+	if resp.PasswordChanged != 0 {
+		t.Fatalf("expected no password change time, got %d", resp.PasswordChanged)
+	}
+}
+
+// TestPasswordChangedTimestamp ensures the password change time is the
+// timestamp of the request, so all members apply the same time.
+func TestPasswordChangedTimestamp(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	if _, err := as.UserAdd(&pb.AuthUserAddRequest{Name: "bar", Password: "bar", Timestamp: 100}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := as.UserGet(&pb.AuthUserGetRequest{Name: "bar"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.PasswordChanged != 100 {
+		t.Fatalf("expected password change time 100, got %d", resp.PasswordChanged)
+	}
+
+	if _, err = as.UserChangePassword(&pb.AuthUserChangePasswordRequest{Name: "bar", Password: "baz", Timestamp: 200}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = as.UserGet(&pb.AuthUserGetRequest{Name: "bar"}); err != nil {
+		t.Fatal(err)
+	}
+	if resp.PasswordChanged != 200 {
+		t.Fatalf("expected password change time 200, got %d", resp.PasswordChanged)
+	}
+}
+
+func TestCheckPasswordPolicy(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	as.SetPasswordPolicy(PasswordPolicy{MinLength: 4})
+	if err := as.CheckPasswordPolicy("bar"); err != ErrPasswordTooShort {
+		t.Fatalf("expected %v, got %v", ErrPasswordTooShort, err)
+	}
+	if err := as.CheckPasswordPolicy("barr"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAuthenticateFailedLockout(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	now := time.Now().Unix()
+	fr := &pb.InternalAuthenticateFailedRequest{Name: "foo", Timestamp: now, MaxFailedAttempts: 2, LockoutSeconds: 60}
+	if err := as.AuthenticateFailed(fr); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := as.UserGet(&pb.AuthUserGetRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FailedAttempts != 1 || resp.LockedUntil != 0 {
+		t.Fatalf("expected 1 failed attempt and no lockout, got %d and %d", resp.FailedAttempts, resp.LockedUntil)
+	}
+	if _, err = as.CheckPassword("foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = as.AuthenticateFailed(fr); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = as.UserGet(&pb.AuthUserGetRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FailedAttempts != 0 || resp.LockedUntil != now+60 {
+		t.Fatalf("expected lockout until %d, got %d failed attempts and lockout until %d", now+60, resp.FailedAttempts, resp.LockedUntil)
+	}
+	if _, err = as.CheckPassword("foo", "bar"); err != ErrUserLockedOut {
+		t.Fatalf("expected %v, got %v", ErrUserLockedOut, err)
+	}
+
+	// a new password lifts the lockout
+	if _, err = as.UserChangePassword(&pb.AuthUserChangePasswordRequest{Name: "foo", Password: "baz"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = as.CheckPassword("foo", "baz"); err != nil {
+		t.Fatal(err)
+	}
+
+	fr.Name = "foo-test"
+	if err = as.AuthenticateFailed(fr); err != ErrUserNotFound {
+		t.Fatalf("expected %v, got %v", ErrUserNotFound, err)
+	}
+}
@@ -17,6 +17,7 @@ package auth
 import (
 	"bytes"
 	"sort"
+	"strings"
 
 	"etcd/auth/authpb"
 	"etcd/mvcc/backend"
@@ -43,6 +44,24 @@ func isSubset(a, b *rangePerm) bool {
 	}
 }
 
+// isOverlapping returns true if a and b have a key in common. A range end
+// of "\x00" is the end of the keyspace.
+func isOverlapping(a, b *rangePerm) bool {
+	return beginsBefore(a.begin, b) && beginsBefore(b.begin, a)
+}
+
+// beginsBefore returns true if key is before the end of p.
+func beginsBefore(key []byte, p *rangePerm) bool {
+	switch {
+	case len(p.end) == 0:
+		return bytes.Compare(key, p.begin) <= 0
+	case len(p.end) == 1 && p.end[0] == 0:
+		return true
+	default:
+		return bytes.Compare(key, p.end) < 0
+	}
+}
+
 func isRangeEqual(a, b *rangePerm) bool {
 	return bytes.Equal(a.begin, b.begin) && bytes.Equal(a.end, b.end)
 }
@@ -102,16 +121,11 @@ func mergeRangePerms(perms []*rangePerm) []*rangePerm {
 	return merged
 }
 
-func getMergedPerms(tx backend.BatchTx, userName string) *unifiedRangePermissions {
-	user := getUser(tx, userName)
-	if user == nil {
-		plog.Errorf("invalid user name %s", userName)
-		return nil
-	}
-
-	var readPerms, writePerms []*rangePerm
+func getMergedPerms(tx backend.BatchTx, roles []string) *unifiedRangePermissions {
+	var readPerms, writePerms, watchPerms []*rangePerm
+	var denyReadPerms, denyWritePerms, denyWatchPerms []*rangePerm
 
-	for _, roleName := range user.Roles {
+	for _, roleName := range roles {
 		role := getRole(tx, roleName)
 		if role == nil {
 			continue
@@ -120,40 +134,80 @@ func getMergedPerms(tx backend.BatchTx, userName string) *unifiedRangePermission
 		for _, perm := range role.KeyPermission {
 			rp := &rangePerm{begin: perm.Key, end: perm.RangeEnd}
 
+			// reading a range implies watching it, and denying the reads
+			// of a range denies watching it
+			if perm.Deny {
+				switch perm.PermType {
+				case authpb.READWRITE:
+					denyReadPerms = append(denyReadPerms, rp)
+					denyWritePerms = append(denyWritePerms, rp)
+					denyWatchPerms = append(denyWatchPerms, rp)
+
+				case authpb.READ:
+					denyReadPerms = append(denyReadPerms, rp)
+					denyWatchPerms = append(denyWatchPerms, rp)
+
+				case authpb.WRITE:
+					denyWritePerms = append(denyWritePerms, rp)
+
+				case authpb.WATCH:
+					denyWatchPerms = append(denyWatchPerms, rp)
+				}
+				continue
+			}
+
 			switch perm.PermType {
 			case authpb.READWRITE:
 				readPerms = append(readPerms, rp)
 				writePerms = append(writePerms, rp)
+				watchPerms = append(watchPerms, rp)
 
 			case authpb.READ:
 				readPerms = append(readPerms, rp)
+				watchPerms = append(watchPerms, rp)
 
 			case authpb.WRITE:
 				writePerms = append(writePerms, rp)
+
+			case authpb.WATCH:
+				watchPerms = append(watchPerms, rp)
 			}
 		}
 	}
 
 	return &unifiedRangePermissions{
-		readPerms:  mergeRangePerms(readPerms),
-		writePerms: mergeRangePerms(writePerms),
+		readPerms:      mergeRangePerms(readPerms),
+		writePerms:     mergeRangePerms(writePerms),
+		watchPerms:     mergeRangePerms(watchPerms),
+		denyReadPerms:  denyReadPerms,
+		denyWritePerms: denyWritePerms,
+		denyWatchPerms: denyWatchPerms,
 	}
 }
 
 func checkKeyPerm(cachedPerms *unifiedRangePermissions, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
-	var tocheck []*rangePerm
+	var tocheck, denied []*rangePerm
 
 	switch permtyp {
 	case authpb.READ:
-		tocheck = cachedPerms.readPerms
+		tocheck, denied = cachedPerms.readPerms, cachedPerms.denyReadPerms
 	case authpb.WRITE:
-		tocheck = cachedPerms.writePerms
+		tocheck, denied = cachedPerms.writePerms, cachedPerms.denyWritePerms
+	case authpb.WATCH:
+		tocheck, denied = cachedPerms.watchPerms, cachedPerms.denyWatchPerms
 	default:
 		plog.Panicf("unknown auth type: %v", permtyp)
 	}
 
 	requiredPerm := &rangePerm{begin: key, end: rangeEnd}
 
+	// a denial of any key of the range denies the whole range
+	for _, perm := range denied {
+		if isOverlapping(requiredPerm, perm) {
+			return false
+		}
+	}
+
 	for _, perm := range tocheck {
 		if isSubset(requiredPerm, perm) {
 			return true
@@ -163,19 +217,26 @@ func checkKeyPerm(cachedPerms *unifiedRangePermissions, key, rangeEnd []byte, pe
 	return false
 }
 
-func (as *authStore) isRangeOpPermitted(tx backend.BatchTx, userName string, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
+func (as *authStore) isRangeOpPermitted(tx backend.BatchTx, authInfo *AuthInfo, roles []string, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
 	// assumption: tx is Lock()ed
-	_, ok := as.rangePermCache[userName]
+	k := permCacheKey(authInfo)
+	perms, ok := as.rangePermCache[k]
 	if !ok {
-		perms := getMergedPerms(tx, userName)
-		if perms == nil {
-			plog.Errorf("failed to create a unified permission of user %s", userName)
-			return false
-		}
-		as.rangePermCache[userName] = perms
+		perms = getMergedPerms(tx, roles)
+		as.rangePermCache[k] = perms
 	}
 
-	return checkKeyPerm(as.rangePermCache[userName], key, rangeEnd, permtyp)
+	return checkKeyPerm(perms, key, rangeEnd, permtyp)
+}
+
+// permCacheKey gets the key of the cached permissions of the user of
+// authInfo; users holding roles by their external identity are cached
+// per set of those roles.
+func permCacheKey(authInfo *AuthInfo) string {
+	if len(authInfo.Roles) == 0 {
+		return authInfo.Username
+	}
+	return authInfo.Username + "\x00" + strings.Join(authInfo.Roles, "\x00")
 }
 
 func (as *authStore) clearCachedPerm() {
@@ -184,6 +245,11 @@ func (as *authStore) clearCachedPerm() {
 
 func (as *authStore) invalidateCachedPerm(userName string) {
 	delete(as.rangePermCache, userName)
+	for k := range as.rangePermCache {
+		if strings.HasPrefix(k, userName+"\x00") {
+			delete(as.rangePermCache, k)
+		}
+	}
 }
 
 type unifiedRangePermissions struct {
@@ -191,6 +257,12 @@ type unifiedRangePermissions struct {
 	readPerms []*rangePerm
 	// writePerms[i] and writePerms[j] (i != j) don't overlap, too
 	writePerms []*rangePerm
+	// watchPerms holds the ranges granted with READ or WATCH
+	watchPerms []*rangePerm
+	// the deny perms take precedence over the grants
+	denyReadPerms  []*rangePerm
+	denyWritePerms []*rangePerm
+	denyWatchPerms []*rangePerm
 }
 
 type rangePerm struct {
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+
+	"etcd/auth/authpb"
 )
 
 func isPermsEqual(a, b []*rangePerm) bool {
@@ -177,3 +179,35 @@ func printPerms(rs []*rangePerm) (txt string) {
 	}
 	return
 }
+
+func TestCheckKeyPermDeny(t *testing.T) {
+	perms := &unifiedRangePermissions{
+		readPerms:      []*rangePerm{{[]byte("a"), []byte("z")}},
+		writePerms:     []*rangePerm{{[]byte("a"), []byte("z")}},
+		denyReadPerms:  []*rangePerm{{[]byte("c"), []byte("")}},
+		denyWritePerms: []*rangePerm{{[]byte("m"), []byte("p")}, {[]byte("x"), []byte{0}}},
+	}
+	tests := []struct {
+		key, rangeEnd []byte
+		permType      authpb.Permission_Type
+		want          bool
+	}{
+		{[]byte("b"), nil, authpb.READ, true},
+		{[]byte("c"), nil, authpb.READ, false},
+		{[]byte("c"), nil, authpb.WRITE, true},
+		{[]byte("a"), []byte("d"), authpb.READ, false},
+		{[]byte("a"), []byte("c"), authpb.READ, true},
+		{[]byte("n"), nil, authpb.WRITE, false},
+		{[]byte("a"), []byte("m"), authpb.WRITE, true},
+		{[]byte("a"), []byte("n"), authpb.WRITE, false},
+		{[]byte("p"), []byte("q"), authpb.WRITE, true},
+		// a denial up to the end of the keyspace
+		{[]byte("y"), nil, authpb.WRITE, false},
+		{[]byte("q"), []byte{0}, authpb.WRITE, false},
+	}
+	for i, tt := range tests {
+		if got := checkKeyPerm(perms, tt.key, tt.rangeEnd, tt.permType); got != tt.want {
+			t.Errorf("#%d: checkKeyPerm(%q, %q, %v) = %v, want %v", i, tt.key, tt.rangeEnd, tt.permType, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"strings"
+
+	"etcd/auth/authpb"
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc/backend"
+)
+
+// AllMethods is the method of the rate limit applying to the RPCs without
+// their own rate limit.
+const AllMethods = "*"
+
+func (as *authStore) RateLimitSet(r *pb.AuthRateLimitSetRequest) (*pb.AuthRateLimitSetResponse, error) {
+	l := r.Limit
+	if l == nil || len(l.Method) == 0 {
+		return nil, ErrRateLimitNoMethod
+	}
+	if l.Key == authpb.TAG && len(l.Tag) == 0 {
+		return nil, ErrRateLimitNoTag
+	}
+	// gRPC metadata keys are lower case
+	l.Tag = strings.ToLower(l.Tag)
+
+	tx := as.be.BatchTx()
+	tx.Lock()
+	if l.Rate <= 0 {
+		delRateLimit(tx, l.Method)
+		plog.Noticef("removed the rate limit of %s", l.Method)
+	} else {
+		if l.Burst <= 0 {
+			l.Burst = l.Rate
+		}
+		putRateLimit(tx, l)
+		plog.Noticef("set the rate limit of %s to %d requests per second", l.Method, l.Rate)
+	}
+	rateLimits := getAllRateLimits(tx)
+	tx.Unlock()
+
+	as.setRateLimits(rateLimits)
+	return &pb.AuthRateLimitSetResponse{}, nil
+}
+
+func (as *authStore) RateLimitList(r *pb.AuthRateLimitListRequest) (*pb.AuthRateLimitListResponse, error) {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+	return &pb.AuthRateLimitListResponse{Limits: getAllRateLimits(tx)}, nil
+}
+
+func (as *authStore) RateLimit(method string) *authpb.RateLimit {
+	as.rateLimitsMu.RLock()
+	defer as.rateLimitsMu.RUnlock()
+	if l, ok := as.rateLimits[method]; ok {
+		return l
+	}
+	return as.rateLimits[AllMethods]
+}
+
+func (as *authStore) setRateLimits(limits []*authpb.RateLimit) {
+	m := make(map[string]*authpb.RateLimit, len(limits))
+	for _, l := range limits {
+		m[l.Method] = l
+	}
+	as.rateLimitsMu.Lock()
+	as.rateLimits = m
+	as.rateLimitsMu.Unlock()
+}
+
+func getAllRateLimits(tx backend.BatchTx) []*authpb.RateLimit {
+	_, vs := tx.UnsafeRange(authRateLimitsBucketName, []byte{0}, []byte{0xff}, -1)
+	if len(vs) == 0 {
+		return nil
+	}
+
+	limits := make([]*authpb.RateLimit, 0, len(vs))
+	for _, v := range vs {
+		l := &authpb.RateLimit{}
+		if err := l.Unmarshal(v); err != nil {
+			plog.Panicf("failed to unmarshal rate limit struct: %s", err)
+		}
+		limits = append(limits, l)
+	}
+	return limits
+}
+
+func putRateLimit(tx backend.BatchTx, l *authpb.RateLimit) {
+	b, err := l.Marshal()
+	if err != nil {
+		plog.Panicf("failed to marshal rate limit struct (method: %s): %s", l.Method, err)
+	}
+	tx.UnsafePut(authRateLimitsBucketName, []byte(l.Method), b)
+}
+
+func delRateLimit(tx backend.BatchTx, method string) {
+	tx.UnsafeDelete(authRateLimitsBucketName, []byte(method))
+}
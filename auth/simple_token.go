@@ -20,9 +20,12 @@ package auth
 import (
 	"crypto/rand"
 	"math/big"
+	"sort"
 	"strings"
 	"sync"
 	"time"
+
+	pb "etcd/etcdserver/etcdserverpb"
 )
 
 const (
@@ -36,6 +39,19 @@ var (
 	simpleTokenTTLResolution = 1 * time.Second
 )
 
+// simpleTokenInfo is what a member knows of a simple token.
+type simpleTokenInfo struct {
+	// id is the raft index of the authentication issuing the token.
+	id       uint64
+	username string
+	// memberID is the ID of the member the user authenticated to.
+	memberID uint64
+	created  time.Time
+	// lastUsed is when the member last authenticated a request with the
+	// token.
+	lastUsed time.Time
+}
+
 type simpleTokenTTLKeeper struct {
 	tokens          map[string]time.Time
 	donec           chan struct{}
@@ -92,8 +108,8 @@ func (tm *simpleTokenTTLKeeper) run() {
 
 func (as *authStore) enable() {
 	delf := func(tk string) {
-		if username, ok := as.simpleTokens[tk]; ok {
-			plog.Infof("deleting token %s for user %s", tk, username)
+		if info, ok := as.simpleTokens[tk]; ok {
+			plog.Infof("deleting token %s for user %s", tk, info.username)
 			delete(as.simpleTokens, tk)
 		}
 	}
@@ -122,14 +138,14 @@ func (as *authStore) GenSimpleToken() (string, error) {
 	return string(ret), nil
 }
 
-func (as *authStore) assignSimpleTokenToUser(username, token string) {
+func (as *authStore) assignSimpleTokenToUser(token string, info *simpleTokenInfo) {
 	as.simpleTokensMu.Lock()
 	_, ok := as.simpleTokens[token]
 	if ok {
 		plog.Panicf("token %s is alredy used", token)
 	}
 
-	as.simpleTokens[token] = username
+	as.simpleTokens[token] = info
 	as.simpleTokenKeeper.addSimpleToken(token)
 	as.simpleTokensMu.Unlock()
 }
@@ -139,11 +155,56 @@ func (as *authStore) invalidateUser(username string) {
 		return
 	}
 	as.simpleTokensMu.Lock()
-	for token, name := range as.simpleTokens {
-		if strings.Compare(name, username) == 0 {
+	for token, info := range as.simpleTokens {
+		if strings.Compare(info.username, username) == 0 {
 			delete(as.simpleTokens, token)
 			as.simpleTokenKeeper.deleteSimpleToken(token)
 		}
 	}
 	as.simpleTokensMu.Unlock()
 }
+
+func (as *authStore) TokenList(r *pb.AuthTokenListRequest) (*pb.AuthTokenListResponse, error) {
+	as.simpleTokensMu.Lock()
+	defer as.simpleTokensMu.Unlock()
+
+	tokens := make([]*pb.AuthToken, 0, len(as.simpleTokens))
+	for _, info := range as.simpleTokens {
+		t := &pb.AuthToken{
+			ID:       info.id,
+			User:     info.username,
+			MemberId: info.memberID,
+			Created:  info.created.Unix(),
+		}
+		if !info.lastUsed.IsZero() {
+			t.LastUsed = info.lastUsed.Unix()
+		}
+		tokens = append(tokens, t)
+	}
+	sort.Sort(authTokenSlice(tokens))
+	return &pb.AuthTokenListResponse{Tokens: tokens}, nil
+}
+
+func (as *authStore) TokenRevoke(r *pb.AuthTokenRevokeRequest) (*pb.AuthTokenRevokeResponse, error) {
+	as.simpleTokensMu.Lock()
+	defer as.simpleTokensMu.Unlock()
+
+	for token, info := range as.simpleTokens {
+		if info.id != r.ID {
+			continue
+		}
+		delete(as.simpleTokens, token)
+		if as.simpleTokenKeeper != nil {
+			as.simpleTokenKeeper.deleteSimpleToken(token)
+		}
+		plog.Noticef("revoked token %d of user %s", info.id, info.username)
+		return &pb.AuthTokenRevokeResponse{}, nil
+	}
+	return nil, ErrTokenNotFound
+}
+
+type authTokenSlice []*pb.AuthToken
+
+func (ts authTokenSlice) Len() int           { return len(ts) }
+func (ts authTokenSlice) Less(i, j int) bool { return ts[i].ID < ts[j].ID }
+func (ts authTokenSlice) Swap(i, j int)      { ts[i], ts[j] = ts[j], ts[i] }
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"etcd/auth/authpb"
 	pb "etcd/etcdserver/etcdserverpb"
@@ -44,6 +45,8 @@ var (
 	authUsersBucketName = []byte("authUsers")
 	authRolesBucketName = []byte("authRoles")
 
+	authRateLimitsBucketName = []byte("authRateLimits")
+
 	plog = capnslog.NewPackageLogger("github.com/coreos/etcd", "auth")
 
 	ErrRootUserNotExist     = errors.New("auth: root user does not exist")
@@ -60,6 +63,13 @@ var (
 	ErrAuthNotEnabled       = errors.New("auth: authentication is not enabled")
 	ErrAuthOldRevision      = errors.New("auth: revision in header is old")
 	ErrInvalidAuthToken     = errors.New("auth: invalid auth token")
+	ErrRateLimitNoMethod    = errors.New("auth: rate limit method is empty")
+	ErrRateLimitNoTag       = errors.New("auth: rate limit tag is empty")
+	ErrNoPasswordUser       = errors.New("auth: user has no password")
+	ErrPasswordTooShort     = errors.New("auth: password is too short")
+	ErrUserLockedOut        = errors.New("auth: user is locked out after too many failed authentications")
+	ErrTokenNotFound        = errors.New("auth: token not found")
+	ErrInvalidAuthImport    = errors.New("auth: invalid auth import")
 
 	// BcryptCost is the algorithm cost / strength for hashing auth passwords
 	BcryptCost = bcrypt.DefaultCost
@@ -75,6 +85,9 @@ const (
 type AuthInfo struct {
 	Username string
 	Revision uint64
+	// Roles are the roles the user holds by its external identity, like
+	// the groups of an OIDC token, in addition to its roles in etcd.
+	Roles []string
 }
 
 type AuthStore interface {
@@ -129,6 +142,27 @@ type AuthStore interface {
 	// RoleList gets a list of all roles
 	RoleList(r *pb.AuthRoleListRequest) (*pb.AuthRoleListResponse, error)
 
+	// RateLimitSet sets the rate limit of an RPC, or removes it if it has no rate
+	RateLimitSet(r *pb.AuthRateLimitSetRequest) (*pb.AuthRateLimitSetResponse, error)
+
+	// RateLimitList gets a list of all rate limits
+	RateLimitList(r *pb.AuthRateLimitListRequest) (*pb.AuthRateLimitListResponse, error)
+
+	// TokenList gets a list of all auth tokens
+	TokenList(r *pb.AuthTokenListRequest) (*pb.AuthTokenListResponse, error)
+
+	// TokenRevoke revokes an auth token
+	TokenRevoke(r *pb.AuthTokenRevokeRequest) (*pb.AuthTokenRevokeResponse, error)
+
+	// AuthExport gets all users and roles
+	AuthExport(r *pb.AuthExportRequest) (*pb.AuthExportResponse, error)
+
+	// AuthImport adds or updates users and roles at once
+	AuthImport(r *pb.AuthImportRequest) (*pb.AuthImportResponse, error)
+
+	// RateLimit gets the rate limit applying to an RPC, or nil if it is not limited
+	RateLimit(method string) *authpb.RateLimit
+
 	// AuthInfoFromToken gets a username from the given Token and current revision number
 	// (The revision number is used for preventing the TOCTOU problem)
 	AuthInfoFromToken(token string) (*AuthInfo, bool)
@@ -142,9 +176,16 @@ type AuthStore interface {
 	// IsDeleteRangePermitted checks delete-range permission of the user
 	IsDeleteRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error
 
+	// IsWatchPermitted checks watch permission of the user
+	IsWatchPermitted(authInfo *AuthInfo, key, rangeEnd []byte) error
+
 	// IsAdminPermitted checks admin permission of the user
 	IsAdminPermitted(authInfo *AuthInfo) error
 
+	// IsDelegatedAdminPermitted checks if the user may issue the user or
+	// role management request with its delegated admin roles
+	IsDelegatedAdminPermitted(authInfo *AuthInfo, r *pb.InternalRaftRequest) error
+
 	// GenSimpleToken produces a simple random string
 	GenSimpleToken() (string, error)
 
@@ -159,6 +200,26 @@ type AuthStore interface {
 
 	// AuthInfoFromCtx gets AuthInfo from gRPC's context
 	AuthInfoFromCtx(ctx context.Context) (*AuthInfo, error)
+
+	// SetCertIdentity sets the rule mapping verified client certificates
+	// to users; nil disables it
+	SetCertIdentity(ci *CertIdentity)
+
+	// SetOIDC sets the verifier of the OIDC tokens of the users; nil
+	// disables it
+	SetOIDC(o *OIDC)
+
+	// SetPasswordPolicy sets the policy of the passwords of the users
+	SetPasswordPolicy(p PasswordPolicy)
+
+	// PasswordPolicy gets the policy of the passwords of the users
+	PasswordPolicy() PasswordPolicy
+
+	// CheckPasswordPolicy checks a new password against the password policy
+	CheckPasswordPolicy(password string) error
+
+	// AuthenticateFailed records a failed password authentication of a user
+	AuthenticateFailed(r *pb.InternalAuthenticateFailedRequest) error
 }
 
 type authStore struct {
@@ -168,21 +229,33 @@ type authStore struct {
 
 	rangePermCache map[string]*unifiedRangePermissions // username -> unifiedRangePermissions
 
+	rateLimitsMu sync.RWMutex
+	rateLimits   map[string]*authpb.RateLimit // method -> rate limit
+
 	revision uint64
 
 	// tokenSimple in v3.2+
 	indexWaiter       func(uint64) <-chan struct{}
 	simpleTokenKeeper *simpleTokenTTLKeeper
 	simpleTokensMu    sync.Mutex
-	simpleTokens      map[string]string // token -> username
+	simpleTokens      map[string]*simpleTokenInfo // token -> info
+
+	certIdentityMu sync.RWMutex
+	certIdentity   *CertIdentity
+
+	oidcMu sync.RWMutex
+	oidc   *OIDC
+
+	passwordPolicyMu sync.RWMutex
+	passwordPolicy   PasswordPolicy
 }
 
 func newDeleterFunc(as *authStore) func(string) {
 	return func(t string) {
 		as.simpleTokensMu.Lock()
 		defer as.simpleTokensMu.Unlock()
-		if username, ok := as.simpleTokens[t]; ok {
-			plog.Infof("deleting token %s for user %s", t, username)
+		if info, ok := as.simpleTokens[t]; ok {
+			plog.Infof("deleting token %s for user %s", t, info.username)
 			delete(as.simpleTokens, t)
 		}
 	}
@@ -208,7 +281,7 @@ func (as *authStore) AuthEnable() error {
 		return ErrRootUserNotExist
 	}
 
-	if !hasRootRole(u) {
+	if !hasRootRole(u.Roles) {
 		return ErrRootRoleNotExist
 	}
 
@@ -245,7 +318,7 @@ func (as *authStore) AuthDisable() {
 	as.simpleTokensMu.Lock()
 	tk := as.simpleTokenKeeper
 	as.simpleTokenKeeper = nil
-	as.simpleTokens = make(map[string]string) // invalidate all tokens
+	as.simpleTokens = make(map[string]*simpleTokenInfo) // invalidate all tokens
 	as.simpleTokensMu.Unlock()
 	if tk != nil {
 		tk.stop()
@@ -284,9 +357,21 @@ func (as *authStore) Authenticate(ctx context.Context, username, password string
 	if user == nil {
 		return nil, ErrAuthFailed
 	}
+	if user.FailedAttempts != 0 {
+		user.FailedAttempts = 0
+		putUser(tx, user)
+	}
 
 	token := fmt.Sprintf("%s.%d", simpleToken, index)
-	as.assignSimpleTokenToUser(username, token)
+	info := &simpleTokenInfo{id: index, username: username}
+	info.memberID, _ = ctx.Value("memberID").(uint64)
+	if ts, ok := ctx.Value("timestamp").(int64); ok && ts != 0 {
+		info.created = time.Unix(ts, 0)
+	} else {
+		// proposed by a member not recording the time of authentications
+		info.created = time.Now()
+	}
+	as.assignSimpleTokenToUser(token, info)
 
 	plog.Infof("authorized %s, token is %s", username, token)
 	return &pb.AuthenticateResponse{Token: token}, nil
@@ -301,6 +386,13 @@ func (as *authStore) CheckPassword(username, password string) (uint64, error) {
 	if user == nil {
 		return 0, ErrAuthFailed
 	}
+	if user.Options != nil && user.Options.NoPassword {
+		plog.Noticef("authentication failed, user %s has no password", username)
+		return 0, ErrAuthFailed
+	}
+	if user.LockedUntil > time.Now().Unix() {
+		return 0, ErrUserLockedOut
+	}
 
 	if bcrypt.CompareHashAndPassword(user.Password, []byte(password)) != nil {
 		plog.Noticef("authentication failed, invalid password for user %s", username)
@@ -323,9 +415,14 @@ func (as *authStore) Recover(be backend.Backend) {
 	}
 
 	as.revision = getRevision(tx)
+	// snapshots of older members have no rate limits
+	tx.UnsafeCreateBucket(authRateLimitsBucketName)
+	rateLimits := getAllRateLimits(tx)
 
 	tx.Unlock()
 
+	as.setRateLimits(rateLimits)
+
 	as.enabledMu.Lock()
 	as.enabled = enabled
 	as.enabledMu.Unlock()
@@ -336,10 +433,14 @@ func (as *authStore) UserAdd(r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse,
 		return nil, ErrUserEmpty
 	}
 
-	hashed, err := bcrypt.GenerateFromPassword([]byte(r.Password), BcryptCost)
-	if err != nil {
-		plog.Errorf("failed to hash password: %s", err)
-		return nil, err
+	var hashed []byte
+	if r.Options == nil || !r.Options.NoPassword {
+		var err error
+		hashed, err = bcrypt.GenerateFromPassword([]byte(r.Password), as.bcryptCost())
+		if err != nil {
+			plog.Errorf("failed to hash password: %s", err)
+			return nil, err
+		}
 	}
 
 	tx := as.be.BatchTx()
@@ -354,6 +455,10 @@ func (as *authStore) UserAdd(r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse,
 	newUser := &authpb.User{
 		Name:     []byte(r.Name),
 		Password: hashed,
+		Options:  r.Options,
+	}
+	if hashed != nil {
+		newUser.PasswordChanged = r.Timestamp
 	}
 
 	putUser(tx, newUser)
@@ -390,7 +495,7 @@ func (as *authStore) UserDelete(r *pb.AuthUserDeleteRequest) (*pb.AuthUserDelete
 func (as *authStore) UserChangePassword(r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
 	// TODO(mitake): measure the cost of bcrypt.GenerateFromPassword()
 	// If the cost is too high, we should move the encryption to outside of the raft
-	hashed, err := bcrypt.GenerateFromPassword([]byte(r.Password), BcryptCost)
+	hashed, err := bcrypt.GenerateFromPassword([]byte(r.Password), as.bcryptCost())
 	if err != nil {
 		plog.Errorf("failed to hash password: %s", err)
 		return nil, err
@@ -404,14 +509,17 @@ func (as *authStore) UserChangePassword(r *pb.AuthUserChangePasswordRequest) (*p
 	if user == nil {
 		return nil, ErrUserNotFound
 	}
-
-	updatedUser := &authpb.User{
-		Name:     []byte(r.Name),
-		Roles:    user.Roles,
-		Password: hashed,
+	if user.Options != nil && user.Options.NoPassword {
+		return nil, ErrNoPasswordUser
 	}
 
-	putUser(tx, updatedUser)
+	// a new password lifts the lockout
+	user.Password = hashed
+	user.PasswordChanged = r.Timestamp
+	user.FailedAttempts = 0
+	user.LockedUntil = 0
+
+	putUser(tx, user)
 
 	as.commitRevision(tx)
 
@@ -471,6 +579,10 @@ func (as *authStore) UserGet(r *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse,
 		return nil, ErrUserNotFound
 	}
 	resp.Roles = append(resp.Roles, user.Roles...)
+	resp.Options = user.Options
+	resp.PasswordChanged = user.PasswordChanged
+	resp.FailedAttempts = user.FailedAttempts
+	resp.LockedUntil = user.LockedUntil
 	return &resp, nil
 }
 
@@ -500,22 +612,19 @@ func (as *authStore) UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUs
 		return nil, ErrUserNotFound
 	}
 
-	updatedUser := &authpb.User{
-		Name:     user.Name,
-		Password: user.Password,
-	}
-
+	var roles []string
 	for _, role := range user.Roles {
 		if strings.Compare(role, r.Role) != 0 {
-			updatedUser.Roles = append(updatedUser.Roles, role)
+			roles = append(roles, role)
 		}
 	}
 
-	if len(updatedUser.Roles) == len(user.Roles) {
+	if len(roles) == len(user.Roles) {
 		return nil, ErrRoleNotGranted
 	}
 
-	putUser(tx, updatedUser)
+	user.Roles = roles
+	putUser(tx, user)
 
 	as.invalidateCachedPerm(r.Name)
 
@@ -537,6 +646,7 @@ func (as *authStore) RoleGet(r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse,
 		return nil, ErrRoleNotFound
 	}
 	resp.Perm = append(resp.Perm, role.KeyPermission...)
+	resp.Options = role.Options
 	return &resp, nil
 }
 
@@ -566,21 +676,19 @@ func (as *authStore) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest)
 		return nil, ErrRoleNotFound
 	}
 
-	updatedRole := &authpb.Role{
-		Name: role.Name,
-	}
-
+	var perms []*authpb.Permission
 	for _, perm := range role.KeyPermission {
 		if !bytes.Equal(perm.Key, []byte(r.Key)) || !bytes.Equal(perm.RangeEnd, []byte(r.RangeEnd)) {
-			updatedRole.KeyPermission = append(updatedRole.KeyPermission, perm)
+			perms = append(perms, perm)
 		}
 	}
 
-	if len(role.KeyPermission) == len(updatedRole.KeyPermission) {
+	if len(role.KeyPermission) == len(perms) {
 		return nil, ErrPermissionNotGranted
 	}
 
-	putRole(tx, updatedRole)
+	role.KeyPermission = perms
+	putRole(tx, role)
 
 	// TODO(mitake): currently single role update invalidates every cache
 	// It should be optimized.
@@ -616,6 +724,10 @@ func (as *authStore) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDelete
 
 	delRole(tx, r.Role)
 
+	// the role may still be held by users, and by the external identities
+	// of users, so their cached permissions are stale
+	as.clearCachedPerm()
+
 	as.commitRevision(tx)
 
 	plog.Noticef("deleted role %s", r.Role)
@@ -633,7 +745,8 @@ func (as *authStore) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse,
 	}
 
 	newRole := &authpb.Role{
-		Name: []byte(r.Name),
+		Name:    []byte(r.Name),
+		Options: r.Options,
 	}
 
 	putRole(tx, newRole)
@@ -648,10 +761,15 @@ func (as *authStore) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse,
 func (as *authStore) AuthInfoFromToken(token string) (*AuthInfo, bool) {
 	// same as '(t *tokenSimple) info' in v3.2+
 	as.simpleTokensMu.Lock()
-	username, ok := as.simpleTokens[token]
+	info, ok := as.simpleTokens[token]
 	if ok && as.simpleTokenKeeper != nil {
 		as.simpleTokenKeeper.resetSimpleToken(token)
 	}
+	var username string
+	if ok {
+		username = info.username
+		info.lastUsed = time.Now()
+	}
 	as.simpleTokensMu.Unlock()
 	return &AuthInfo{Username: username, Revision: as.revision}, ok
 }
@@ -687,12 +805,14 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 	if idx < len(role.KeyPermission) && bytes.Equal(role.KeyPermission[idx].Key, r.Perm.Key) && bytes.Equal(role.KeyPermission[idx].RangeEnd, r.Perm.RangeEnd) {
 		// update existing permission
 		role.KeyPermission[idx].PermType = r.Perm.PermType
+		role.KeyPermission[idx].Deny = r.Perm.Deny
 	} else {
 		// append new permission to the role
 		newPerm := &authpb.Permission{
 			Key:      []byte(r.Perm.Key),
 			RangeEnd: []byte(r.Perm.RangeEnd),
 			PermType: r.Perm.PermType,
+			Deny:     r.Perm.Deny,
 		}
 
 		role.KeyPermission = append(role.KeyPermission, newPerm)
@@ -707,23 +827,27 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 
 	as.commitRevision(tx)
 
-	plog.Noticef("role %s's permission of key %s is updated as %s", r.Name, r.Perm.Key, authpb.Permission_Type_name[int32(r.Perm.PermType)])
+	if r.Perm.Deny {
+		plog.Noticef("role %s's permission of key %s is updated as deny %s", r.Name, r.Perm.Key, authpb.Permission_Type_name[int32(r.Perm.PermType)])
+	} else {
+		plog.Noticef("role %s's permission of key %s is updated as %s", r.Name, r.Perm.Key, authpb.Permission_Type_name[int32(r.Perm.PermType)])
+	}
 
 	return &pb.AuthRoleGrantPermissionResponse{}, nil
 }
 
-func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeEnd []byte, permTyp authpb.Permission_Type) error {
+func (as *authStore) isOpPermitted(authInfo *AuthInfo, key, rangeEnd []byte, permTyp authpb.Permission_Type) error {
 	// TODO(mitake): this function would be costly so we need a caching mechanism
 	if !as.isAuthEnabled() {
 		return nil
 	}
 
 	// only gets rev == 0 when passed AuthInfo{}; no user given
-	if revision == 0 {
+	if authInfo.Revision == 0 {
 		return ErrUserEmpty
 	}
 
-	if revision < as.revision {
+	if authInfo.Revision < as.revision {
 		return ErrAuthOldRevision
 	}
 
@@ -731,18 +855,18 @@ func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeE
 	tx.Lock()
 	defer tx.Unlock()
 
-	user := getUser(tx, userName)
-	if user == nil {
-		plog.Errorf("invalid user name %s for permission checking", userName)
+	roles, ok := userRoles(tx, authInfo)
+	if !ok {
+		plog.Errorf("invalid user name %s for permission checking", authInfo.Username)
 		return ErrPermissionDenied
 	}
 
 	// root role should have permission on all ranges
-	if hasRootRole(user) {
+	if hasRootRole(roles) {
 		return nil
 	}
 
-	if as.isRangeOpPermitted(tx, userName, key, rangeEnd, permTyp) {
+	if as.isRangeOpPermitted(tx, authInfo, roles, key, rangeEnd, permTyp) {
 		return nil
 	}
 
@@ -750,15 +874,19 @@ func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeE
 }
 
 func (as *authStore) IsPutPermitted(authInfo *AuthInfo, key []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, nil, authpb.WRITE)
+	return as.isOpPermitted(authInfo, key, nil, authpb.WRITE)
 }
 
 func (as *authStore) IsRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.READ)
+	return as.isOpPermitted(authInfo, key, rangeEnd, authpb.READ)
 }
 
 func (as *authStore) IsDeleteRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.WRITE)
+	return as.isOpPermitted(authInfo, key, rangeEnd, authpb.WRITE)
+}
+
+func (as *authStore) IsWatchPermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
+	return as.isOpPermitted(authInfo, key, rangeEnd, authpb.WATCH)
 }
 
 func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
@@ -773,12 +901,12 @@ func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
 	tx.Lock()
 	defer tx.Unlock()
 
-	u := getUser(tx, authInfo.Username)
-	if u == nil {
+	roles, ok := userRoles(tx, authInfo)
+	if !ok {
 		return ErrUserNotFound
 	}
 
-	if !hasRootRole(u) {
+	if !hasRootRole(roles) {
 		return ErrPermissionDenied
 	}
 
@@ -893,6 +1021,7 @@ func NewAuthStore(be backend.Backend, indexWaiter func(uint64) <-chan struct{})
 	tx.UnsafeCreateBucket(authBucketName)
 	tx.UnsafeCreateBucket(authUsersBucketName)
 	tx.UnsafeCreateBucket(authRolesBucketName)
+	tx.UnsafeCreateBucket(authRateLimitsBucketName)
 
 	enabled := false
 	_, vs := tx.UnsafeRange(authBucketName, enableFlagKey, nil, 0)
@@ -904,7 +1033,7 @@ func NewAuthStore(be backend.Backend, indexWaiter func(uint64) <-chan struct{})
 
 	as := &authStore{
 		be:             be,
-		simpleTokens:   make(map[string]string),
+		simpleTokens:   make(map[string]*simpleTokenInfo),
 		revision:       getRevision(tx),
 		indexWaiter:    indexWaiter,
 		enabled:        enabled,
@@ -919,14 +1048,32 @@ func NewAuthStore(be backend.Backend, indexWaiter func(uint64) <-chan struct{})
 		as.commitRevision(tx)
 	}
 
+	as.setRateLimits(getAllRateLimits(tx))
+
 	tx.Unlock()
 	be.ForceCommit()
 
 	return as
 }
 
-func hasRootRole(u *authpb.User) bool {
-	for _, r := range u.Roles {
+// userRoles gets the roles of the user of authInfo: its roles in etcd and
+// the roles of its external identity. It returns false if the user is
+// neither stored in etcd nor holds any role by its external identity.
+func userRoles(tx backend.BatchTx, authInfo *AuthInfo) ([]string, bool) {
+	u := getUser(tx, authInfo.Username)
+	if u == nil {
+		return authInfo.Roles, len(authInfo.Roles) != 0
+	}
+	if len(authInfo.Roles) == 0 {
+		return u.Roles, true
+	}
+	roles := make([]string, 0, len(u.Roles)+len(authInfo.Roles))
+	roles = append(roles, u.Roles...)
+	return append(roles, authInfo.Roles...), true
+}
+
+func hasRootRole(roles []string) bool {
+	for _, r := range roles {
 		if r == rootRole {
 			return true
 		}
@@ -977,15 +1124,22 @@ func (as *authStore) isValidSimpleToken(token string, ctx context.Context) bool
 func (as *authStore) AuthInfoFromCtx(ctx context.Context) (*AuthInfo, error) {
 	md, ok := metadata.FromContext(ctx)
 	if !ok {
-		return nil, nil
+		return as.authInfoFromTLS(ctx), nil
 	}
 
 	ts, tok := md["token"]
 	if !tok {
-		return nil, nil
+		bt, bok := bearerToken(md)
+		if !bok {
+			return as.authInfoFromTLS(ctx), nil
+		}
+		ts = []string{bt}
 	}
 
 	token := ts[0]
+	if authInfo, ok, err := as.authInfoFromOIDC(token); ok {
+		return authInfo, err
+	}
 	if !as.isValidSimpleToken(token, ctx) {
 		return nil, ErrInvalidAuthToken
 	}
@@ -16,8 +16,10 @@ package auth
 
 import (
 	"os"
+	"reflect"
 	"testing"
 
+	"etcd/auth/authpb"
 	pb "etcd/etcdserver/etcdserverpb"
 	"etcd/mvcc/backend"
 	"golang.org/x/crypto/bcrypt"
@@ -283,6 +285,48 @@ func TestUserGrant(t *testing.T) {
 	}
 }
 
+func TestIsWatchPermitted(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	perms := []*authpb.Permission{
+		{PermType: authpb.WATCH, Key: []byte("w"), RangeEnd: []byte("x")},
+		{PermType: authpb.READ, Key: []byte("r"), RangeEnd: []byte("s")},
+		{PermType: authpb.READ, Key: []byte("ra"), Deny: true},
+	}
+	for _, perm := range perms {
+		_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-test", Perm: perm})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "role-test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ai := &AuthInfo{Username: "foo", Revision: as.Revision()}
+	tests := []struct {
+		key, rangeEnd []byte
+		watch, read   bool
+	}{
+		{[]byte("wa"), nil, true, false},
+		{[]byte("w"), []byte("x"), true, false},
+		{[]byte("rb"), nil, true, true},
+		// denying the reads denies watching
+		{[]byte("ra"), nil, false, false},
+		{[]byte("r"), []byte("s"), false, false},
+		{[]byte("a"), nil, false, false},
+	}
+	for i, tt := range tests {
+		if err := as.IsWatchPermitted(ai, tt.key, tt.rangeEnd); (err == nil) != tt.watch {
+			t.Errorf("#%d: watch permitted = %v, want %v", i, err == nil, tt.watch)
+		}
+		if err := as.IsRangePermitted(ai, tt.key, tt.rangeEnd); (err == nil) != tt.read {
+			t.Errorf("#%d: range permitted = %v, want %v", i, err == nil, tt.read)
+		}
+	}
+}
+
 func TestRecoverFromSnapshot(t *testing.T) {
 	as, _ := setupAuthStore(t)
 
@@ -321,6 +365,91 @@ func TestRecoverFromSnapshot(t *testing.T) {
 	}
 }
 
+func TestRateLimitSet(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	tests := []struct {
+		l   *authpb.RateLimit
+		err error
+	}{
+		{&authpb.RateLimit{Rate: 10}, ErrRateLimitNoMethod},
+		{&authpb.RateLimit{Method: "Range", Key: authpb.TAG, Rate: 10}, ErrRateLimitNoTag},
+		{&authpb.RateLimit{Method: "Range", Rate: 10, Burst: 20}, nil},
+		{&authpb.RateLimit{Method: AllMethods, Key: authpb.TAG, Tag: "Tenant", Rate: 5}, nil},
+	}
+	for i, tt := range tests {
+		if _, err := as.RateLimitSet(&pb.AuthRateLimitSetRequest{Limit: tt.l}); err != tt.err {
+			t.Fatalf("#%d: err = %v, want %v", i, err, tt.err)
+		}
+	}
+
+	if l := as.RateLimit("Range"); l == nil || l.Burst != 20 {
+		t.Fatalf("limit of Range = %+v, want burst 20", l)
+	}
+	// the burst defaults to the rate, the tag is lower case
+	want := &authpb.RateLimit{Method: AllMethods, Key: authpb.TAG, Tag: "tenant", Rate: 5, Burst: 5}
+	if l := as.RateLimit("Put"); !reflect.DeepEqual(l, want) {
+		t.Fatalf("limit of Put = %+v, want %+v", l, want)
+	}
+
+	// the limits survive a restart
+	as2 := NewAuthStore(as.be, dummyIndexWaiter)
+	defer as2.Close()
+	resp, err := as2.RateLimitList(&pb.AuthRateLimitListRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Limits) != 2 || resp.Limits[0].Method != AllMethods || resp.Limits[1].Method != "Range" {
+		t.Fatalf("limits = %+v, want * and Range", resp.Limits)
+	}
+
+	// a limit without rate is removed
+	if _, err = as.RateLimitSet(&pb.AuthRateLimitSetRequest{Limit: &authpb.RateLimit{Method: "Range"}}); err != nil {
+		t.Fatal(err)
+	}
+	if l := as.RateLimit("Range"); !reflect.DeepEqual(l, want) {
+		t.Fatalf("limit of Range = %+v, want %+v", l, want)
+	}
+}
+
+func TestTokenListRevoke(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	ctx := context.WithValue(context.WithValue(context.TODO(), "index", uint64(10)), "simpleToken", "dummy")
+	ctx = context.WithValue(context.WithValue(ctx, "memberID", uint64(0xabcd)), "timestamp", int64(1000))
+	ar, err := as.Authenticate(ctx, "foo", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := as.AuthInfoFromToken(ar.Token); !ok {
+		t.Fatalf("token %q is not valid", ar.Token)
+	}
+
+	resp, err := as.TokenList(&pb.AuthTokenListRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tokens) != 1 {
+		t.Fatalf("len(tokens) = %d, want 1", len(resp.Tokens))
+	}
+	tk := resp.Tokens[0]
+	if tk.ID != 10 || tk.User != "foo" || tk.MemberId != 0xabcd || tk.Created != 1000 || tk.LastUsed == 0 {
+		t.Fatalf("token = %+v, want ID 10 of foo issued by abcd at 1000 and used", tk)
+	}
+
+	if _, err = as.TokenRevoke(&pb.AuthTokenRevokeRequest{ID: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := as.AuthInfoFromToken(ar.Token); ok {
+		t.Fatalf("revoked token %q is still valid", ar.Token)
+	}
+	if _, err = as.TokenRevoke(&pb.AuthTokenRevokeRequest{ID: 10}); err != ErrTokenNotFound {
+		t.Fatalf("err = %v, want %v", err, ErrTokenNotFound)
+	}
+}
+
 func contains(array []string, str string) bool {
 	for _, s := range array {
 		if s == str {
@@ -41,15 +41,33 @@ type (
 	AuthRoleDeleteResponse           pb.AuthRoleDeleteResponse
 	AuthUserListResponse             pb.AuthUserListResponse
 	AuthRoleListResponse             pb.AuthRoleListResponse
+	AuthRateLimitSetResponse         pb.AuthRateLimitSetResponse
+	AuthRateLimitListResponse        pb.AuthRateLimitListResponse
+	AuthTokenListResponse            pb.AuthTokenListResponse
+	AuthTokenRevokeResponse          pb.AuthTokenRevokeResponse
+	AuthExportResponse               pb.AuthExportResponse
+	AuthImportResponse               pb.AuthImportResponse
 
 	PermissionType authpb.Permission_Type
 	Permission     authpb.Permission
+
+	RateLimit authpb.RateLimit
+
+	UserAddOptions authpb.UserAddOptions
+	RoleAddOptions authpb.RoleAddOptions
 )
 
 const (
 	PermRead      = authpb.READ
 	PermWrite     = authpb.WRITE
 	PermReadWrite = authpb.READWRITE
+	PermWatch     = authpb.WATCH
+)
+
+const (
+	RateLimitByUser = authpb.USER
+	RateLimitByIP   = authpb.IP
+	RateLimitByTag  = authpb.TAG
 )
 
 type Auth interface {
@@ -62,6 +80,9 @@ type Auth interface {
 	// UserAdd adds a new user to an etcd cluster.
 	UserAdd(ctx context.Context, name string, password string) (*AuthUserAddResponse, error)
 
+	// UserAddWithOptions adds a new user with options to an etcd cluster.
+	UserAddWithOptions(ctx context.Context, name string, password string, opt *UserAddOptions) (*AuthUserAddResponse, error)
+
 	// UserDelete deletes a user from an etcd cluster.
 	UserDelete(ctx context.Context, name string) (*AuthUserDeleteResponse, error)
 
@@ -83,9 +104,16 @@ type Auth interface {
 	// RoleAdd adds a new role to an etcd cluster.
 	RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error)
 
+	// RoleAddWithOptions adds a new role with options to an etcd cluster.
+	RoleAddWithOptions(ctx context.Context, name string, opt *RoleAddOptions) (*AuthRoleAddResponse, error)
+
 	// RoleGrantPermission grants a permission to a role.
 	RoleGrantPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error)
 
+	// RoleDenyPermission denies a permission to a role; denied permissions
+	// take precedence over granted ones.
+	RoleDenyPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error)
+
 	// RoleGet gets a detailed information of a role.
 	RoleGet(ctx context.Context, role string) (*AuthRoleGetResponse, error)
 
@@ -97,6 +125,26 @@ type Auth interface {
 
 	// RoleDelete deletes a role.
 	RoleDelete(ctx context.Context, role string) (*AuthRoleDeleteResponse, error)
+
+	// RateLimitSet sets the rate limit of an RPC. A limit without rate is removed.
+	RateLimitSet(ctx context.Context, limit RateLimit) (*AuthRateLimitSetResponse, error)
+
+	// RateLimitList gets a list of all rate limits.
+	RateLimitList(ctx context.Context) (*AuthRateLimitListResponse, error)
+
+	// TokenList gets a list of all auth tokens.
+	TokenList(ctx context.Context) (*AuthTokenListResponse, error)
+
+	// TokenRevoke revokes an auth token by its ID.
+	TokenRevoke(ctx context.Context, id uint64) (*AuthTokenRevokeResponse, error)
+
+	// AuthExport gets all users and roles, with the password hashes of the
+	// users if withPasswords is set.
+	AuthExport(ctx context.Context, withPasswords bool) (*AuthExportResponse, error)
+
+	// AuthImport adds or updates the users and roles at once. A dry run
+	// only reports the changes of the import.
+	AuthImport(ctx context.Context, users []*authpb.User, roles []*authpb.Role, dryRun bool) (*AuthImportResponse, error)
 }
 
 type auth struct {
@@ -130,6 +178,11 @@ func (auth *auth) UserAdd(ctx context.Context, name string, password string) (*A
 	return (*AuthUserAddResponse)(resp), toErr(ctx, err)
 }
 
+func (auth *auth) UserAddWithOptions(ctx context.Context, name string, password string, opt *UserAddOptions) (*AuthUserAddResponse, error) {
+	resp, err := auth.remote.UserAdd(ctx, &pb.AuthUserAddRequest{Name: name, Password: password, Options: (*authpb.UserAddOptions)(opt)})
+	return (*AuthUserAddResponse)(resp), toErr(ctx, err)
+}
+
 func (auth *auth) UserDelete(ctx context.Context, name string) (*AuthUserDeleteResponse, error) {
 	resp, err := auth.remote.UserDelete(ctx, &pb.AuthUserDeleteRequest{Name: name})
 	return (*AuthUserDeleteResponse)(resp), toErr(ctx, err)
@@ -165,11 +218,25 @@ func (auth *auth) RoleAdd(ctx context.Context, name string) (*AuthRoleAddRespons
 	return (*AuthRoleAddResponse)(resp), toErr(ctx, err)
 }
 
+func (auth *auth) RoleAddWithOptions(ctx context.Context, name string, opt *RoleAddOptions) (*AuthRoleAddResponse, error) {
+	resp, err := auth.remote.RoleAdd(ctx, &pb.AuthRoleAddRequest{Name: name, Options: (*authpb.RoleAddOptions)(opt)})
+	return (*AuthRoleAddResponse)(resp), toErr(ctx, err)
+}
+
 func (auth *auth) RoleGrantPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error) {
+	return auth.roleGrantPermission(ctx, name, key, rangeEnd, permType, false)
+}
+
+func (auth *auth) RoleDenyPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantPermissionResponse, error) {
+	return auth.roleGrantPermission(ctx, name, key, rangeEnd, permType, true)
+}
+
+func (auth *auth) roleGrantPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType, deny bool) (*AuthRoleGrantPermissionResponse, error) {
 	perm := &authpb.Permission{
 		Key:      []byte(key),
 		RangeEnd: []byte(rangeEnd),
 		PermType: authpb.Permission_Type(permType),
+		Deny:     deny,
 	}
 	resp, err := auth.remote.RoleGrantPermission(ctx, &pb.AuthRoleGrantPermissionRequest{Name: name, Perm: perm})
 	return (*AuthRoleGrantPermissionResponse)(resp), toErr(ctx, err)
@@ -195,6 +262,37 @@ func (auth *auth) RoleDelete(ctx context.Context, role string) (*AuthRoleDeleteR
 	return (*AuthRoleDeleteResponse)(resp), toErr(ctx, err)
 }
 
+func (auth *auth) RateLimitSet(ctx context.Context, limit RateLimit) (*AuthRateLimitSetResponse, error) {
+	l := authpb.RateLimit(limit)
+	resp, err := auth.remote.RateLimitSet(ctx, &pb.AuthRateLimitSetRequest{Limit: &l})
+	return (*AuthRateLimitSetResponse)(resp), toErr(ctx, err)
+}
+
+func (auth *auth) RateLimitList(ctx context.Context) (*AuthRateLimitListResponse, error) {
+	resp, err := auth.remote.RateLimitList(ctx, &pb.AuthRateLimitListRequest{}, grpc.FailFast(false))
+	return (*AuthRateLimitListResponse)(resp), toErr(ctx, err)
+}
+
+func (auth *auth) TokenList(ctx context.Context) (*AuthTokenListResponse, error) {
+	resp, err := auth.remote.TokenList(ctx, &pb.AuthTokenListRequest{}, grpc.FailFast(false))
+	return (*AuthTokenListResponse)(resp), toErr(ctx, err)
+}
+
+func (auth *auth) TokenRevoke(ctx context.Context, id uint64) (*AuthTokenRevokeResponse, error) {
+	resp, err := auth.remote.TokenRevoke(ctx, &pb.AuthTokenRevokeRequest{ID: id})
+	return (*AuthTokenRevokeResponse)(resp), toErr(ctx, err)
+}
+
+func (auth *auth) AuthExport(ctx context.Context, withPasswords bool) (*AuthExportResponse, error) {
+	resp, err := auth.remote.AuthExport(ctx, &pb.AuthExportRequest{WithPasswords: withPasswords}, grpc.FailFast(false))
+	return (*AuthExportResponse)(resp), toErr(ctx, err)
+}
+
+func (auth *auth) AuthImport(ctx context.Context, users []*authpb.User, roles []*authpb.Role, dryRun bool) (*AuthImportResponse, error) {
+	resp, err := auth.remote.AuthImport(ctx, &pb.AuthImportRequest{Users: users, Roles: roles, DryRun: dryRun})
+	return (*AuthImportResponse)(resp), toErr(ctx, err)
+}
+
 func StrToPermissionType(s string) (PermissionType, error) {
 	val, ok := authpb.Permission_Type_value[strings.ToUpper(s)]
 	if ok {
@@ -203,6 +301,14 @@ func StrToPermissionType(s string) (PermissionType, error) {
 	return PermissionType(-1), fmt.Errorf("invalid permission type: %s", s)
 }
 
+func StrToRateLimitKey(s string) (authpb.RateLimit_Key, error) {
+	val, ok := authpb.RateLimit_Key_value[strings.ToUpper(s)]
+	if ok {
+		return authpb.RateLimit_Key(val), nil
+	}
+	return authpb.RateLimit_Key(-1), fmt.Errorf("invalid rate limit key: %s", s)
+}
+
 type authenticator struct {
 	conn   *grpc.ClientConn // conn in-use
 	remote pb.AuthClient
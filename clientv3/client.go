@@ -46,6 +46,7 @@ type Client struct {
 	Watcher
 	Auth
 	Maintenance
+	Quota
 
 	conn             *grpc.ClientConn
 	cfg              Config
@@ -295,6 +296,12 @@ func (c *Client) dial(endpoint string, dopts ...grpc.DialOption) (*grpc.ClientCo
 			return nil, err
 		}
 
+		opts = append(opts, grpc.WithPerRPCCredentials(c.tokenCred))
+	} else if c.cfg.Token != "" {
+		c.tokenCred = &authTokenCredential{
+			token:   c.cfg.Token,
+			tokenMu: &sync.RWMutex{},
+		}
 		opts = append(opts, grpc.WithPerRPCCredentials(c.tokenCred))
 	}
 
@@ -375,6 +382,7 @@ func newClient(cfg *Config) (*Client, error) {
 	client.Watcher = NewWatcher(client)
 	client.Auth = NewAuth(client)
 	client.Maintenance = NewMaintenance(client)
+	client.Quota = NewQuota(client)
 
 	go client.autoSync()
 	return client, nil
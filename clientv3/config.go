@@ -43,6 +43,10 @@ type Config struct {
 
 	// Password is a password for authentication
 	Password string
+
+	// Token is a token for authentication issued by an identity provider,
+	// like an OIDC ID token; it is ignored if Username is set
+	Token string
 }
 
 type yamlConfig struct {
@@ -16,6 +16,7 @@ package integration
 
 import (
 	"bytes"
+	"fmt"
 	"math/rand"
 	"os"
 	"reflect"
@@ -26,6 +27,7 @@ import (
 	"etcd/clientv3"
 	"etcd/etcdserver/api/v3rpc/rpctypes"
 	"etcd/integration"
+	"etcd/mvcc"
 	"etcd/mvcc/mvccpb"
 	"etcd/pkg/testutil"
 	"golang.org/x/net/context"
@@ -797,3 +799,89 @@ func TestKVGetResetLoneEndpoint(t *testing.T) {
 	case <-donec:
 	}
 }
+
+// TestKVGetValueIndex ensures that keys can be looked up by value
+// through a secondary value index on every member.
+func TestKVGetValueIndex(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	vis := []mvcc.ValueIndex{{Name: "node", Prefix: "/pods/"}}
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3, ValueIndexes: vis})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.Client(0))
+	ctx := context.TODO()
+	for _, p := range [][2]string{{"/pods/a", "n1"}, {"/pods/b", "n2"}, {"/pods/c", "n1"}, {"/pods/b", "n1"}} {
+		if _, err := kv.Put(ctx, p[0], p[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := kv.Delete(ctx, "/pods/c"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		resp, err := clus.Client(i).Get(ctx, "/pods/", clientv3.WithPrefix(), clientv3.WithValueIndex("node", "n1"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+		}
+		if !reflect.DeepEqual(keys, []string{"/pods/a", "/pods/b"}) {
+			t.Errorf("#%d: keys = %v, want [/pods/a /pods/b]", i, keys)
+		}
+	}
+
+	_, err := kv.Get(ctx, "/pods/", clientv3.WithPrefix(), clientv3.WithValueIndex("owner", "n1"))
+	if err != rpctypes.ErrValueIndexNotFound {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrValueIndexNotFound)
+	}
+}
+
+func TestKVHistory(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+	for _, p := range [][2]string{{"a", "1"}, {"b", "2"}, {"a", "3"}} {
+		if _, err := kv.Put(ctx, p[0], p[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := kv.Delete(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := kv.History(ctx, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wevs := []string{"PUT a 1 2", "PUT a 3 4", "DELETE a  5"}
+	var evs []string
+	for _, ev := range resp.Events {
+		evs = append(evs, fmt.Sprintf("%s %s %s %d", ev.Type, ev.Kv.Key, ev.Kv.Value, ev.Kv.ModRevision))
+	}
+	if !reflect.DeepEqual(evs, wevs) {
+		t.Errorf("events = %v, want %v", evs, wevs)
+	}
+
+	resp, err = kv.History(ctx, "\x00", clientv3.WithFromKey(), clientv3.WithMinModRev(3), clientv3.WithLimit(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Events) != 1 || string(resp.Events[0].Kv.Key) != "b" || !resp.More {
+		t.Errorf("events = %v (more %v), want b at revision 3 and more", resp.Events, resp.More)
+	}
+
+	if _, err = kv.Compact(ctx, 4); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.History(ctx, "a", clientv3.WithMinModRev(2)); err != rpctypes.ErrCompacted {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrCompacted)
+	}
+}
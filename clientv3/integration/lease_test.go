@@ -537,6 +537,93 @@ func TestLeaseTimeToLive(t *testing.T) {
 	}
 }
 
+// TestLeaseTimeToLives checks that leases can be queried in batch
+// from any member and that missing leases are reported as expired.
+func TestLeaseTimeToLives(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(1)
+	ids := make([]clientv3.LeaseID, 2)
+	for i, ttl := range []int64{10, 20} {
+		resp, err := cli.Grant(context.Background(), ttl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = resp.ID
+	}
+	if _, err := cli.Put(context.TODO(), "foo", "bar", clientv3.WithLease(ids[0])); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		lresp, err := clus.Client(i).TimeToLives(context.Background(), append(ids, 0x1234), clientv3.WithAttachedKeys())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(lresp.Leases) != 3 {
+			t.Fatalf("expected 3 leases, got %d", len(lresp.Leases))
+		}
+		for j, granted := range []int64{10, 20} {
+			l := lresp.Leases[j]
+			if l.ID != ids[j] || l.GrantedTTL != granted || l.TTL <= 0 || l.TTL > granted {
+				t.Fatalf("#%d: unexpected lease %+v", j, l)
+			}
+		}
+		if len(lresp.Leases[0].Keys) != 1 || string(lresp.Leases[0].Keys[0]) != "foo" {
+			t.Fatalf("unexpected keys %q", lresp.Leases[0].Keys)
+		}
+		if l := lresp.Leases[2]; l.ID != 0x1234 || l.TTL != -1 {
+			t.Fatalf("expected missing lease to have TTL -1, got %+v", l)
+		}
+	}
+}
+
+// TestKVGetLeaseTTL checks that a range returns the remaining
+// lease TTL of each key when requested.
+func TestKVGetLeaseTTL(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(1)
+	resp, err := cli.Grant(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(context.TODO(), "foo1", "bar", clientv3.WithLease(resp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(context.TODO(), "foo2", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	gresp, err := cli.Get(context.TODO(), "foo", clientv3.WithPrefix(), clientv3.WithLeaseTTL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gresp.LeaseTtls) != 2 {
+		t.Fatalf("expected 2 lease ttls, got %v", gresp.LeaseTtls)
+	}
+	if ttl := gresp.LeaseTtls[0]; ttl <= 0 || ttl > 10 {
+		t.Fatalf("unexpected lease ttl %d for foo1", ttl)
+	}
+	if ttl := gresp.LeaseTtls[1]; ttl != 0 {
+		t.Fatalf("expected no lease ttl for foo2, got %d", ttl)
+	}
+
+	gresp, err = cli.Get(context.TODO(), "foo", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gresp.LeaseTtls) != 0 {
+		t.Fatalf("unexpected lease ttls %v", gresp.LeaseTtls)
+	}
+}
+
 // TestLeaseRenewLostQuorum ensures keepalives work after losing quorum
 // for a while.
 func TestLeaseRenewLostQuorum(t *testing.T) {
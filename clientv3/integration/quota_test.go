@@ -0,0 +1,150 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"testing"
+
+	"etcd/clientv3"
+	"etcd/etcdserver/api/v3rpc/rpctypes"
+	"etcd/integration"
+	"etcd/pkg/testutil"
+	"golang.org/x/net/context"
+)
+
+// TestQuotaKeys ensures writes going over the key quota of a prefix are
+// rejected, and only those.
+func TestQuotaKeys(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := context.TODO()
+
+	if _, err := cli.QuotaSet(ctx, "/a/", 0, 2); err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"/a/1", "/a/2"} {
+		if _, err := cli.Put(ctx, k, "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := cli.Put(ctx, "/a/3", "v"); err != rpctypes.ErrQuotaExceeded {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrQuotaExceeded)
+	}
+	// other prefixes and overwrites are not limited
+	if _, err := cli.Put(ctx, "/b/3", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Put(ctx, "/a/1", "vv"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a txn over the quota is not applied at all
+	_, err := cli.Txn(ctx).Then(clientv3.OpDelete("/a/1"), clientv3.OpPut("/a/3", "v"), clientv3.OpPut("/a/4", "v")).Commit()
+	if err != rpctypes.ErrQuotaExceeded {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrQuotaExceeded)
+	}
+	if resp, gerr := cli.Get(ctx, "/a/1"); gerr != nil || len(resp.Kvs) != 1 {
+		t.Fatalf("/a/1 = %v (%v), want the key", resp, gerr)
+	}
+	if _, err = cli.Txn(ctx).Then(clientv3.OpDelete("/a/1"), clientv3.OpPut("/a/3", "v")).Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := cli.QuotaGet(ctx, "/a/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Usage.Keys != 2 || resp.Usage.Bytes != 2*int64(len("/a/1v")) {
+		t.Fatalf("usage = %+v, want 2 keys of %d bytes", resp.Usage, len("/a/1v"))
+	}
+
+	// deleting frees the quota
+	if _, err = cli.Delete(ctx, "/a/", clientv3.WithPrefix()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(ctx, "/a/5", "v"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestQuotaBytes ensures the byte quota of a prefix follows the keys
+// removed out of the apply path, by lease revocation.
+func TestQuotaBytes(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := context.TODO()
+
+	if _, err := cli.QuotaSet(ctx, "/b/", 20, 0); err != nil {
+		t.Fatal(err)
+	}
+	lresp, err := cli.Grant(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(ctx, "/b/x", "0123456789", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(ctx, "/b/y", "0123456789"); err != rpctypes.ErrQuotaExceeded {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrQuotaExceeded)
+	}
+	if _, err = cli.Revoke(ctx, lresp.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(ctx, "/b/y", "0123456789"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestQuotaSetList(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := context.TODO()
+
+	if _, err := cli.QuotaSet(ctx, "", 1, 1); err != rpctypes.ErrEmptyPrefix {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrEmptyPrefix)
+	}
+	for _, p := range []string{"/b/", "/a/"} {
+		if _, err := cli.QuotaSet(ctx, p, 100, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := cli.QuotaList(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Quotas) != 2 || string(resp.Quotas[0].Prefix) != "/a/" || string(resp.Quotas[1].Prefix) != "/b/" {
+		t.Fatalf("quotas = %v, want /a/ and /b/", resp.Quotas)
+	}
+
+	// no limits removes the quota
+	if _, err = cli.QuotaSet(ctx, "/a/", 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.QuotaGet(ctx, "/a/"); err != rpctypes.ErrQuotaNotFound {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrQuotaNotFound)
+	}
+}
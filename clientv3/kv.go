@@ -26,6 +26,7 @@ type (
 	GetResponse     pb.RangeResponse
 	DeleteResponse  pb.DeleteRangeResponse
 	TxnResponse     pb.TxnResponse
+	HistoryResponse pb.HistoryResponse
 )
 
 type KV interface {
@@ -45,6 +46,14 @@ type KV interface {
 	// When passed WithSort(), the keys will be sorted.
 	Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error)
 
+	// History retrieves the retained revisions of keys, including deletions,
+	// as events sorted by revision.
+	// By default, History will return the revisions of "key".
+	// It accepts the range options of Get; when passed WithMinModRev(rev) or
+	// WithMaxModRev(rev), only the revisions in the bounds are returned.
+	// If the minimum revision is compacted, the request will fail with ErrCompacted.
+	History(ctx context.Context, key string, opts ...OpOption) (*HistoryResponse, error)
+
 	// Delete deletes a key, or optionally using WithRange(end), [key, end).
 	Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error)
 
@@ -99,6 +108,19 @@ func (kv *kv) Get(ctx context.Context, key string, opts ...OpOption) (*GetRespon
 	return r.get, toErr(ctx, err)
 }
 
+func (kv *kv) History(ctx context.Context, key string, opts ...OpOption) (*HistoryResponse, error) {
+	r := OpGet(key, opts...).toHistoryRequest()
+	for {
+		resp, err := kv.remote.History(ctx, r, grpc.FailFast(false))
+		if err == nil {
+			return (*HistoryResponse)(resp), nil
+		}
+		if isHaltErr(ctx, err) {
+			return nil, toErr(ctx, err)
+		}
+	}
+}
+
 func (kv *kv) Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error) {
 	r, err := kv.Do(ctx, OpDelete(key, opts...))
 	return r.del, toErr(ctx, err)
@@ -59,6 +59,15 @@ type LeaseTimeToLiveResponse struct {
 	Keys [][]byte `json:"keys"`
 }
 
+// LeaseTimeToLivesResponse is used to convert the protobuf lease timetolives response.
+type LeaseTimeToLivesResponse struct {
+	*pb.ResponseHeader
+
+	// Leases holds the information of each requested lease, in request order.
+	// The TTL of a lease that does not exist is -1.
+	Leases []*LeaseTimeToLiveResponse `json:"leases"`
+}
+
 const (
 	// defaultTTL is the assumed lease TTL used for the first keepalive
 	// deadline before the actual TTL is known to the client.
@@ -94,6 +103,9 @@ type Lease interface {
 	// TimeToLive retrieves the lease information of the given lease ID.
 	TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption) (*LeaseTimeToLiveResponse, error)
 
+	// TimeToLives retrieves the lease information of several lease IDs at once.
+	TimeToLives(ctx context.Context, ids []LeaseID, opts ...LeaseOption) (*LeaseTimeToLivesResponse, error)
+
 	// KeepAlive keeps the given lease alive forever.
 	KeepAlive(ctx context.Context, id LeaseID) (<-chan *LeaseKeepAliveResponse, error)
 
@@ -222,6 +234,36 @@ func (l *lessor) TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption
 	}
 }
 
+func (l *lessor) TimeToLives(ctx context.Context, ids []LeaseID, opts ...LeaseOption) (*LeaseTimeToLivesResponse, error) {
+	cctx, cancel := context.WithCancel(ctx)
+	done := cancelWhenStop(cancel, l.stopCtx.Done())
+	defer close(done)
+
+	for {
+		r := toLeaseTimeToLivesRequest(ids, opts...)
+		resp, err := l.remote.LeaseTimeToLives(cctx, r, grpc.FailFast(false))
+		if err == nil {
+			gresp := &LeaseTimeToLivesResponse{
+				ResponseHeader: resp.GetHeader(),
+				Leases:         make([]*LeaseTimeToLiveResponse, len(resp.Leases)),
+			}
+			for i, lr := range resp.Leases {
+				gresp.Leases[i] = &LeaseTimeToLiveResponse{
+					ResponseHeader: resp.GetHeader(),
+					ID:             LeaseID(lr.ID),
+					TTL:            lr.TTL,
+					GrantedTTL:     lr.GrantedTTL,
+					Keys:           lr.Keys,
+				}
+			}
+			return gresp, nil
+		}
+		if isHaltErr(cctx, err) {
+			return nil, toErr(cctx, err)
+		}
+	}
+}
+
 func (l *lessor) KeepAlive(ctx context.Context, id LeaseID) (<-chan *LeaseKeepAliveResponse, error) {
 	ch := make(chan *LeaseKeepAliveResponse, leaseResponseChSize)
 
@@ -27,6 +27,8 @@ type (
 	AlarmResponse      pb.AlarmResponse
 	AlarmMember        pb.AlarmMember
 	StatusResponse     pb.StatusResponse
+
+	ValueKeyRotateResponse pb.ValueKeyRotateResponse
 )
 
 type Maintenance interface {
@@ -50,6 +52,10 @@ type Maintenance interface {
 
 	// Snapshot provides a reader for a snapshot of a backend.
 	Snapshot(ctx context.Context) (io.ReadCloser, error)
+
+	// ValueKeyRotate replaces the data key encrypting the values of an
+	// encrypted prefix. The values written before stay readable.
+	ValueKeyRotate(ctx context.Context, prefix string) (*ValueKeyRotateResponse, error)
 }
 
 type maintenance struct {
@@ -162,3 +168,11 @@ func (m *maintenance) Snapshot(ctx context.Context) (io.ReadCloser, error) {
 	}()
 	return pr, nil
 }
+
+func (m *maintenance) ValueKeyRotate(ctx context.Context, prefix string) (*ValueKeyRotateResponse, error) {
+	resp, err := m.remote.ValueKeyRotate(ctx, &pb.ValueKeyRotateRequest{Prefix: []byte(prefix)})
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*ValueKeyRotateResponse)(resp), nil
+}
@@ -14,7 +14,10 @@
 
 package clientv3
 
-import pb "etcd/etcdserver/etcdserverpb"
+import (
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/pkg/keyutil"
+)
 
 type opType int
 
@@ -25,10 +28,6 @@ const (
 	tDeleteRange
 )
 
-var (
-	noPrefixEnd = []byte{0}
-)
-
 // Op represents an Operation that kv can execute.
 type Op struct {
 	t   opType
@@ -45,6 +44,9 @@ type Op struct {
 	maxModRev    int64
 	minCreateRev int64
 	maxCreateRev int64
+	leaseTTL     bool
+	index        string
+	indexValue   []byte
 
 	// for range, watch
 	rev int64
@@ -81,6 +83,9 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 		MaxModRevision:    op.maxModRev,
 		MinCreateRevision: op.minCreateRev,
 		MaxCreateRevision: op.maxCreateRev,
+		LeaseTtl:          op.leaseTTL,
+		Index:             op.index,
+		IndexValue:        op.indexValue,
 	}
 	if op.sort != nil {
 		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
@@ -89,6 +94,22 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 	return r
 }
 
+// toHistoryRequest converts a Get op into a history request; the mod
+// revision bounds select the revisions of the keys to return.
+func (op Op) toHistoryRequest() *pb.HistoryRequest {
+	if op.t != tRange {
+		panic("op.t != tRange")
+	}
+	return &pb.HistoryRequest{
+		Key:          op.key,
+		RangeEnd:     op.end,
+		MinRevision:  op.minModRev,
+		MaxRevision:  op.maxModRev,
+		Limit:        op.limit,
+		Serializable: op.serializable,
+	}
+}
+
 func (op Op) toRequestOp() *pb.RequestOp {
 	switch op.t {
 	case tRange:
@@ -234,22 +255,7 @@ func WithSort(target SortTarget, order SortOrder) OpOption {
 // GetPrefixRangeEnd gets the range end of the prefix.
 // 'Get(foo, WithPrefix())' is equal to 'Get(foo, WithRange(GetPrefixRangeEnd(foo))'.
 func GetPrefixRangeEnd(prefix string) string {
-	return string(getPrefix([]byte(prefix)))
-}
-
-func getPrefix(key []byte) []byte {
-	end := make([]byte, len(key))
-	copy(end, key)
-	for i := len(end) - 1; i >= 0; i-- {
-		if end[i] < 0xff {
-			end[i] = end[i] + 1
-			end = end[:i+1]
-			return end
-		}
-	}
-	// next prefix does not exist (e.g., 0xffff);
-	// default to WithFromKey policy
-	return noPrefixEnd
+	return string(keyutil.PrefixEnd([]byte(prefix)))
 }
 
 // WithPrefix enables 'Get', 'Delete', or 'Watch' requests to operate
@@ -257,7 +263,9 @@ func getPrefix(key []byte) []byte {
 // can return 'foo1', 'foo2', and so on.
 func WithPrefix() OpOption {
 	return func(op *Op) {
-		op.end = getPrefix(op.key)
+		// next prefix does not exist (e.g., 0xffff);
+		// default to WithFromKey policy
+		op.end = keyutil.PrefixEnd(op.key)
 	}
 }
 
@@ -303,6 +311,18 @@ func WithMinCreateRev(rev int64) OpOption { return func(op *Op) { op.minCreateRe
 // WithMaxCreateRev filters out keys for Get with creation revisions greater than the given revision.
 func WithMaxCreateRev(rev int64) OpOption { return func(op *Op) { op.maxCreateRev = rev } }
 
+// WithLeaseTTL makes the 'Get' request also return the remaining lease TTL
+// of each key in GetResponse.LeaseTtls. It has no effect inside transactions.
+func WithLeaseTTL() OpOption {
+	return func(op *Op) { op.leaseTTL = true }
+}
+
+// WithValueIndex makes the 'Get' request return only the keys holding value,
+// looked up through the named secondary value index of the server.
+func WithValueIndex(index, value string) OpOption {
+	return func(op *Op) { op.index, op.indexValue = index, []byte(value) }
+}
+
 // WithFirstCreate gets the key with the oldest creation revision in the request range.
 func WithFirstCreate() []OpOption { return withTop(SortByCreateRevision, SortAscend) }
 
@@ -388,3 +408,13 @@ func toLeaseTimeToLiveRequest(id LeaseID, opts ...LeaseOption) *pb.LeaseTimeToLi
 	ret.applyOpts(opts)
 	return &pb.LeaseTimeToLiveRequest{ID: int64(id), Keys: ret.attachedKeys}
 }
+
+func toLeaseTimeToLivesRequest(ids []LeaseID, opts ...LeaseOption) *pb.LeaseTimeToLivesRequest {
+	ret := &LeaseOp{}
+	ret.applyOpts(opts)
+	r := &pb.LeaseTimeToLivesRequest{IDs: make([]int64, len(ids)), Keys: ret.attachedKeys}
+	for i, id := range ids {
+		r.IDs[i] = int64(id)
+	}
+	return r
+}
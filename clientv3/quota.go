@@ -0,0 +1,65 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	pb "etcd/etcdserver/etcdserverpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type (
+	QuotaSetResponse  pb.QuotaSetResponse
+	QuotaGetResponse  pb.QuotaGetResponse
+	QuotaListResponse pb.QuotaListResponse
+)
+
+type Quota interface {
+	// QuotaSet limits the total size, in bytes, of the keys and values under
+	// a prefix, and the number of keys under it. A limit of 0 means no limit;
+	// setting no limit removes the quota. Writes going over a quota fail
+	// with rpctypes.ErrQuotaExceeded.
+	QuotaSet(ctx context.Context, prefix string, maxBytes, maxKeys int64) (*QuotaSetResponse, error)
+
+	// QuotaGet gets the quota of a prefix and the usage of the prefix.
+	QuotaGet(ctx context.Context, prefix string) (*QuotaGetResponse, error)
+
+	// QuotaList gets the quotas of all prefixes.
+	QuotaList(ctx context.Context) (*QuotaListResponse, error)
+}
+
+type quota struct {
+	remote pb.QuotaClient
+}
+
+func NewQuota(c *Client) Quota {
+	return &quota{remote: pb.NewQuotaClient(c.conn)}
+}
+
+func (q *quota) QuotaSet(ctx context.Context, prefix string, maxBytes, maxKeys int64) (*QuotaSetResponse, error) {
+	r := &pb.QuotaSetRequest{Quota: &pb.PrefixQuota{Prefix: []byte(prefix), MaxBytes: maxBytes, MaxKeys: maxKeys}}
+	resp, err := q.remote.QuotaSet(ctx, r)
+	return (*QuotaSetResponse)(resp), toErr(ctx, err)
+}
+
+func (q *quota) QuotaGet(ctx context.Context, prefix string) (*QuotaGetResponse, error) {
+	resp, err := q.remote.QuotaGet(ctx, &pb.QuotaGetRequest{Prefix: []byte(prefix)}, grpc.FailFast(false))
+	return (*QuotaGetResponse)(resp), toErr(ctx, err)
+}
+
+func (q *quota) QuotaList(ctx context.Context) (*QuotaListResponse, error) {
+	resp, err := q.remote.QuotaList(ctx, &pb.QuotaListRequest{}, grpc.FailFast(false))
+	return (*QuotaListResponse)(resp), toErr(ctx, err)
+}
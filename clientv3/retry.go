@@ -95,6 +95,14 @@ func (rkv *retryKVClient) Range(ctx context.Context, in *pb.RangeRequest, opts .
 	return resp, err
 }
 
+func (rkv *retryKVClient) History(ctx context.Context, in *pb.HistoryRequest, opts ...grpc.CallOption) (resp *pb.HistoryResponse, err error) {
+	err = rkv.retryf(ctx, func(rctx context.Context) error {
+		resp, err = rkv.retryWriteKVClient.History(rctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
 type retryWriteKVClient struct {
 	pb.KVClient
 	retryf retryRpcFunc
@@ -284,6 +292,30 @@ func (rac *retryAuthClient) RoleGrantPermission(ctx context.Context, in *pb.Auth
 	return resp, err
 }
 
+func (rac *retryAuthClient) RateLimitSet(ctx context.Context, in *pb.AuthRateLimitSetRequest, opts ...grpc.CallOption) (resp *pb.AuthRateLimitSetResponse, err error) {
+	err = rac.retryf(ctx, func(rctx context.Context) error {
+		resp, err = rac.AuthClient.RateLimitSet(rctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (rac *retryAuthClient) TokenRevoke(ctx context.Context, in *pb.AuthTokenRevokeRequest, opts ...grpc.CallOption) (resp *pb.AuthTokenRevokeResponse, err error) {
+	err = rac.retryf(ctx, func(rctx context.Context) error {
+		resp, err = rac.AuthClient.TokenRevoke(rctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (rac *retryAuthClient) AuthImport(ctx context.Context, in *pb.AuthImportRequest, opts ...grpc.CallOption) (resp *pb.AuthImportResponse, err error) {
+	err = rac.retryf(ctx, func(rctx context.Context) error {
+		resp, err = rac.AuthClient.AuthImport(rctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
 func (rac *retryAuthClient) RoleRevokePermission(ctx context.Context, in *pb.AuthRoleRevokePermissionRequest, opts ...grpc.CallOption) (resp *pb.AuthRoleRevokePermissionResponse, err error) {
 	err = rac.retryf(ctx, func(rctx context.Context) error {
 		resp, err = rac.AuthClient.RoleRevokePermission(rctx, in, opts...)
@@ -0,0 +1,207 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defragger
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/lease"
+	"etcd/mvcc/backend"
+	"github.com/coreos/pkg/capnslog"
+	"github.com/jonboulle/clockwork"
+	"golang.org/x/net/context"
+)
+
+var (
+	plog = capnslog.NewPackageLogger("github.com/coreos/etcd", "defragger")
+)
+
+const (
+	checkDefragInterval = time.Minute
+
+	// LockLeaseID is the id of the lease held by the member defragmenting
+	// its backend, so that the members of a cluster defragment one at a time.
+	// The lease expires if the member fails while holding it. The id is
+	// below math.MaxInt64, the exclusive end of the ids a lessor recovers
+	// from its backend, and clients may not use it.
+	LockLeaseID = lease.LeaseID(math.MaxInt64 - 1)
+	// lockTTL is the TTL of the lock lease, in seconds.
+	lockTTL = 60
+
+	// minDefragSize is the backend size under which the backend is not
+	// defragmented, whatever its fragmentation.
+	minDefragSize = 64 * 1024 * 1024
+)
+
+type BackendGetter interface {
+	Backend() backend.Backend
+}
+
+// Locker grants and revokes the lease used as the cluster wide lock.
+type Locker interface {
+	LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error)
+	LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error)
+	LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, error)
+}
+
+// Window is a daily time window, in UTC.
+type Window struct {
+	// Start and End are the offsets of the window from midnight.
+	// The window wraps around midnight if End is before Start.
+	// Equal offsets mean the whole day.
+	Start, End time.Duration
+}
+
+// ParseWindow parses a window of the form "HH:MM-HH:MM". The empty string
+// is the whole day.
+func ParseWindow(s string) (Window, error) {
+	if s == "" {
+		return Window{}, nil
+	}
+	var sh, sm, eh, em int
+	if n, err := fmt.Sscanf(s, "%d:%d-%d:%d", &sh, &sm, &eh, &em); err != nil || n != 4 {
+		return Window{}, fmt.Errorf("defragger: invalid window %q, want HH:MM-HH:MM", s)
+	}
+	for _, v := range []struct{ v, max int }{{sh, 23}, {sm, 59}, {eh, 23}, {em, 59}} {
+		if v.v < 0 || v.v > v.max {
+			return Window{}, fmt.Errorf("defragger: invalid window %q, want HH:MM-HH:MM", s)
+		}
+	}
+	return Window{
+		Start: time.Duration(sh)*time.Hour + time.Duration(sm)*time.Minute,
+		End:   time.Duration(eh)*time.Hour + time.Duration(em)*time.Minute,
+	}, nil
+}
+
+// Contains returns true if t is in the window.
+func (w Window) Contains(t time.Time) bool {
+	if w.Start == w.End {
+		return true
+	}
+	t = t.UTC()
+	d := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.Start < w.End {
+		return d >= w.Start && d < w.End
+	}
+	return d >= w.Start || d < w.End
+}
+
+// Auto defragments the backend of the member when the share of its size
+// not in use exceeds a threshold, holding a cluster wide lock.
+type Auto struct {
+	clock     clockwork.Clock
+	threshold float64
+	window    Window
+	minSize   int64
+
+	bg BackendGetter
+	l  Locker
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	donec  chan struct{}
+}
+
+// NewAuto returns an Auto defragmenting the backend when its fragmentation,
+// the share of its size not in use, exceeds threshold within window.
+func NewAuto(threshold float64, window Window, bg BackendGetter, l Locker) *Auto {
+	return &Auto{
+		clock:     clockwork.NewRealClock(),
+		threshold: threshold,
+		window:    window,
+		minSize:   minDefragSize,
+		bg:        bg,
+		l:         l,
+	}
+}
+
+func (a *Auto) Run() {
+	a.ctx, a.cancel = context.WithCancel(context.Background())
+	a.donec = make(chan struct{})
+
+	go func() {
+		defer close(a.donec)
+		for {
+			select {
+			case <-a.ctx.Done():
+				return
+			case <-a.clock.After(checkDefragInterval):
+			}
+			if !a.window.Contains(a.clock.Now()) {
+				continue
+			}
+			be := a.bg.Backend()
+			size, inUse := be.Size(), be.SizeInUse()
+			if size < a.minSize {
+				continue
+			}
+			frag := float64(size-inUse) / float64(size)
+			if frag < a.threshold {
+				continue
+			}
+			a.defrag(be, frag)
+		}
+	}()
+}
+
+// Stop stops defragmenting, waiting for the ongoing defragmentation.
+func (a *Auto) Stop() {
+	a.cancel()
+	<-a.donec
+}
+
+func (a *Auto) defrag(be backend.Backend, frag float64) {
+	_, err := a.l.LeaseGrant(a.ctx, &pb.LeaseGrantRequest{ID: int64(LockLeaseID), TTL: lockTTL})
+	if err == lease.ErrLeaseExists {
+		plog.Infof("skipped auto-defragmentation (another member is defragmenting)")
+		return
+	}
+	if err != nil {
+		plog.Warningf("failed to acquire the auto-defragmentation lock (%v)", err)
+		return
+	}
+
+	// keep the lock while defragmenting
+	stopc, renewc := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(renewc)
+		for {
+			select {
+			case <-stopc:
+				return
+			case <-a.clock.After(lockTTL * time.Second / 3):
+			}
+			if _, rerr := a.l.LeaseRenew(a.ctx, LockLeaseID); rerr != nil {
+				plog.Warningf("failed to renew the auto-defragmentation lock (%v)", rerr)
+			}
+		}
+	}()
+
+	plog.Noticef("starting auto-defragmentation (%.0f%% of %d bytes not in use)", frag*100, be.Size())
+	if err = be.Defrag(); err != nil {
+		plog.Warningf("failed auto-defragmentation (%v)", err)
+	} else {
+		plog.Noticef("finished auto-defragmentation (size %d bytes)", be.Size())
+	}
+
+	close(stopc)
+	<-renewc
+	if _, err = a.l.LeaseRevoke(a.ctx, &pb.LeaseRevokeRequest{ID: int64(LockLeaseID)}); err != nil {
+		plog.Warningf("failed to release the auto-defragmentation lock (%v)", err)
+	}
+}
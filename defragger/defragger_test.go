@@ -0,0 +1,183 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defragger
+
+import (
+	"testing"
+	"time"
+
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/lease"
+	"etcd/mvcc/backend"
+	"etcd/pkg/testutil"
+	"github.com/jonboulle/clockwork"
+	"golang.org/x/net/context"
+)
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		s  string
+		w  Window
+		ok bool
+	}{
+		{"", Window{}, true},
+		{"01:30-05:00", Window{90 * time.Minute, 5 * time.Hour}, true},
+		{"23:00-02:15", Window{23 * time.Hour, 2*time.Hour + 15*time.Minute}, true},
+		{"24:00-02:00", Window{}, false},
+		{"01:60-02:00", Window{}, false},
+		{"01:00", Window{}, false},
+		{"night", Window{}, false},
+	}
+	for i, tt := range tests {
+		w, err := ParseWindow(tt.s)
+		if (err == nil) != tt.ok {
+			t.Errorf("#%d: err = %v, want ok %v", i, err, tt.ok)
+		}
+		if w != tt.w {
+			t.Errorf("#%d: window = %+v, want %+v", i, w, tt.w)
+		}
+	}
+}
+
+func TestWindowContains(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2017, 1, 1, h, m, 0, 0, time.UTC) }
+	tests := []struct {
+		w    string
+		t    time.Time
+		want bool
+	}{
+		{"", at(12, 0), true},
+		{"01:00-05:00", at(1, 0), true},
+		{"01:00-05:00", at(4, 59), true},
+		{"01:00-05:00", at(5, 0), false},
+		{"01:00-05:00", at(0, 59), false},
+		{"23:00-02:00", at(23, 30), true},
+		{"23:00-02:00", at(1, 0), true},
+		{"23:00-02:00", at(12, 0), false},
+	}
+	for i, tt := range tests {
+		w, err := ParseWindow(tt.w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := w.Contains(tt.t); got != tt.want {
+			t.Errorf("#%d: %q contains %v = %v, want %v", i, tt.w, tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestAuto(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	be := &fakeBackend{rec: testutil.NewRecorderStream(), size: 100 << 20, inUse: 40 << 20}
+	l := &fakeLocker{rec: testutil.NewRecorderStream()}
+	a := &Auto{clock: fc, threshold: 0.5, bg: &fakeBackendGetter{be}, l: l, minSize: minDefragSize}
+
+	a.Run()
+	defer a.Stop()
+
+	fc.BlockUntil(1)
+	fc.Advance(checkDefragInterval)
+	acts, err := l.rec.Wait(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acts[0].Name != "LeaseGrant" {
+		t.Fatalf("action = %s, want LeaseGrant", acts[0].Name)
+	}
+	if _, err = be.rec.Wait(1); err != nil {
+		t.Fatal(err)
+	}
+	if acts, err = l.rec.Wait(1); err != nil {
+		t.Fatal(err)
+	}
+	if acts[0].Name != "LeaseRevoke" {
+		t.Fatalf("action = %s, want LeaseRevoke", acts[0].Name)
+	}
+}
+
+func TestAutoSkip(t *testing.T) {
+	tests := []struct {
+		size, inUse int64
+		locked      bool
+		window      Window
+	}{
+		// not fragmented enough
+		{100 << 20, 60 << 20, false, Window{}},
+		// too small
+		{10 << 20, 1 << 20, false, Window{}},
+		// another member defragments
+		{100 << 20, 10 << 20, true, Window{}},
+		// out of the window
+		{100 << 20, 10 << 20, false, Window{time.Hour, time.Hour + time.Minute}},
+	}
+	for i, tt := range tests {
+		// the fake clock starts at 1984-04-04 00:00 UTC
+		fc := clockwork.NewFakeClock()
+		be := &fakeBackend{rec: &testutil.RecorderBuffered{}, size: tt.size, inUse: tt.inUse}
+		l := &fakeLocker{rec: &testutil.RecorderBuffered{}, locked: tt.locked}
+		a := &Auto{clock: fc, threshold: 0.5, window: tt.window, bg: &fakeBackendGetter{be}, l: l, minSize: minDefragSize}
+		a.Run()
+
+		fc.BlockUntil(1)
+		fc.Advance(checkDefragInterval)
+		// wait for the next check
+		fc.BlockUntil(1)
+		a.Stop()
+
+		if acts := be.rec.Action(); len(acts) != 0 {
+			t.Errorf("#%d: defragmented, want skipped", i)
+		}
+	}
+}
+
+type fakeBackendGetter struct{ be *fakeBackend }
+
+func (g *fakeBackendGetter) Backend() backend.Backend { return g.be }
+
+type fakeBackend struct {
+	backend.Backend
+	rec         testutil.Recorder
+	size, inUse int64
+}
+
+func (b *fakeBackend) Size() int64      { return b.size }
+func (b *fakeBackend) SizeInUse() int64 { return b.inUse }
+func (b *fakeBackend) Defrag() error {
+	b.rec.Record(testutil.Action{Name: "Defrag"})
+	return nil
+}
+
+type fakeLocker struct {
+	rec    testutil.Recorder
+	locked bool
+}
+
+func (l *fakeLocker) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	l.rec.Record(testutil.Action{Name: "LeaseGrant", Params: []interface{}{r}})
+	if l.locked {
+		return nil, lease.ErrLeaseExists
+	}
+	return &pb.LeaseGrantResponse{ID: r.ID, TTL: r.TTL}, nil
+}
+
+func (l *fakeLocker) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	l.rec.Record(testutil.Action{Name: "LeaseRevoke", Params: []interface{}{r}})
+	return &pb.LeaseRevokeResponse{}, nil
+}
+
+func (l *fakeLocker) LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, error) {
+	l.rec.Record(testutil.Action{Name: "LeaseRenew"})
+	return lockTTL, nil
+}
@@ -94,6 +94,78 @@ func TestCtlV3Migrate(t *testing.T) {
 	}
 }
 
+func TestCtlV3MigrateAuth(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	epc := setupEtcdctlTest(t, &configNoTLS, false)
+	defer func() {
+		if errC := epc.Close(); errC != nil {
+			t.Fatalf("error closing etcd processes (%v)", errC)
+		}
+	}()
+
+	if err := etcdctlSet(epc, "/foo/a", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if err := etcdctlRoleAdd(epc, "foo-role"); err != nil {
+		t.Fatal(err)
+	}
+	if err := etcdctlRoleGrant(epc, "foo-role", "--rw", "--path=/foo/*"); err != nil {
+		t.Fatal(err)
+	}
+	if err := etcdctlUserAdd(epc, "root", "123"); err != nil {
+		t.Fatal(err)
+	}
+	if err := etcdctlUserAdd(epc, "foo-user", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	if err := etcdctlUserGrant(epc, "foo-user", "foo-role"); err != nil {
+		t.Fatal(err)
+	}
+	if err := etcdctlAuthEnable(epc); err != nil {
+		t.Fatal(err)
+	}
+
+	dataDir := epc.procs[0].cfg.dataDirPath
+	if err := epc.StopAll(); err != nil {
+		t.Fatalf("error closing etcd processes (%v)", err)
+	}
+
+	os.Setenv("ETCDCTL_API", "3")
+	defer os.Unsetenv("ETCDCTL_API")
+	cx := ctlCtx{
+		t:           t,
+		cfg:         configNoTLS,
+		dialTimeout: 7 * time.Second,
+		epc:         epc,
+	}
+	// enabling v2 auth adds the guest role, which has no v3 equivalent
+	cmdArgs := append(cx.PrefixArgs(), "migrate", "--data-dir", dataDir, "--wal-dir", "")
+	if err := spawnWithExpects(cmdArgs, `not translated exactly: role "guest"`, "migrated 2 v2 users and 2 v2 roles", "finished transforming keys"); err != nil {
+		t.Fatal(err)
+	}
+
+	epc.procs[0].cfg.keepDataDir = true
+	if err := epc.RestartAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	cx.user, cx.pass = "foo-user", "pass"
+	if err := ctlV3Put(cx, "/foo/b", "bar", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctlV3Get(cx, []string{"/foo/a"}, kv{"/foo/a", "bar"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctlV3PutFailPerm(cx, "/fop", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	cx.user, cx.pass = "root", "123"
+	if err := ctlV3Put(cx, "/fop", "bar", ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func ctlV3Migrate(cx ctlCtx, dataDir, walDir string) error {
 	cmdArgs := append(cx.PrefixArgs(), "migrate", "--data-dir", dataDir, "--wal-dir", walDir)
 	return spawnWithExpects(cmdArgs, "finished transforming keys")
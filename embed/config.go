@@ -15,6 +15,7 @@
 package embed
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -22,13 +23,21 @@ import (
 	"net/url"
 	"strings"
 
+	"etcd/audit"
+	"etcd/auth"
+	"etcd/defragger"
 	"etcd/discovery"
+	"etcd/envelope"
 	"etcd/etcdserver"
+	"etcd/mvcc"
 	"etcd/pkg/cors"
+	"etcd/pkg/encryption"
 	"etcd/pkg/netutil"
 	"etcd/pkg/transport"
 	"etcd/pkg/types"
+	"etcd/rafthttp"
 	"github.com/ghodss/yaml"
+	"golang.org/x/crypto/bcrypt"
 )
 
 const (
@@ -73,6 +82,7 @@ type Config struct {
 	WalDir                  string `json:"wal-dir"`
 	MaxSnapFiles            uint   `json:"max-snapshots"`
 	MaxWalFiles             uint   `json:"max-wals"`
+	WalArchiveDir           string `json:"wal-archive-dir"`
 	Name                    string `json:"name"`
 	SnapCount               uint64 `json:"snapshot-count"`
 	AutoCompactionRetention int    `json:"auto-compaction-retention"`
@@ -84,6 +94,38 @@ type Config struct {
 	ElectionMs        uint  `json:"election-timeout"`
 	QuotaBackendBytes int64 `json:"quota-backend-bytes"`
 
+	// BackendBatchIntervalMs is the maximum number of milliseconds before
+	// committing the backend batch tx. 0 means use the default.
+	BackendBatchIntervalMs uint `json:"backend-batch-interval"`
+	// BackendBatchLimit is the maximum number of puts before committing
+	// the backend batch tx. 0 means use the default.
+	BackendBatchLimit int `json:"backend-batch-limit"`
+	// BackendMmapSize is the initial mmap size of the backend in bytes.
+	// 0 means use the default.
+	BackendMmapSize int64 `json:"backend-mmap-size"`
+
+	// CompactionBatchLimit is the maximum number of revisions compacted
+	// per batch. 0 means use the default.
+	CompactionBatchLimit int `json:"compaction-batch-limit"`
+	// CompactionSleepIntervalMs is the number of milliseconds between
+	// compaction batches. 0 means use the default.
+	CompactionSleepIntervalMs uint `json:"compaction-sleep-interval"`
+	// CompactionBatchMaxLatencyMs is the number of milliseconds after which
+	// a compaction batch yields to the writes. 0 means no limit.
+	CompactionBatchMaxLatencyMs uint `json:"compaction-batch-max-latency"`
+
+	// AutoDefragThreshold is the fragmentation, the share of the backend
+	// size not in use, above which the backend is defragmented. 0 disables
+	// automatic defragmentation.
+	AutoDefragThreshold float64 `json:"auto-defrag-threshold"`
+	// AutoDefragWindow is the daily UTC window, as "HH:MM-HH:MM", in which
+	// automatic defragmentation may run. Empty means any time.
+	AutoDefragWindow string `json:"auto-defrag-window"`
+
+	// ValueIndexes is a comma separated list of "name=prefix" secondary
+	// indexes over the values of the keys with the prefix.
+	ValueIndexes string `json:"value-indexes"`
+
 	// clustering
 
 	APUrls, ACUrls      []url.URL
@@ -95,6 +137,13 @@ type Config struct {
 	InitialClusterToken string `json:"initial-cluster-token"`
 	StrictReconfigCheck bool   `json:"strict-reconfig-check"`
 
+	// PeerSnapshotSendRateLimit is the maximum number of bytes per second
+	// sent to each peer when sending database snapshots. 0 means no limit.
+	PeerSnapshotSendRateLimit int64 `json:"peer-snapshot-send-rate-limit"`
+	// PeerCompression is the compression used to send raft messages and
+	// snapshots to the peers that support it. Empty disables compression.
+	PeerCompression string `json:"peer-compression"`
+
 	// security
 
 	ClientTLSInfo transport.TLSInfo
@@ -102,6 +151,76 @@ type Config struct {
 	PeerTLSInfo   transport.TLSInfo
 	PeerAutoTLS   bool
 
+	// ClientCertIdentity is the rule mapping verified client certificates
+	// to v3 auth users: "cn", "uri" or "uri:<prefix>". Empty disables it.
+	ClientCertIdentity string `json:"client-cert-auth-identity"`
+
+	// AuthBcryptCost is the bcrypt cost of hashing the passwords of auth users.
+	AuthBcryptCost int `json:"auth-bcrypt-cost"`
+	// AuthPasswordMinLength is the minimum length of new auth user passwords.
+	AuthPasswordMinLength int `json:"auth-password-min-length"`
+	// AuthLockoutAttempts is the number of consecutive failed
+	// authentications locking a user out. 0 disables the lockout.
+	AuthLockoutAttempts int `json:"auth-lockout-attempts"`
+	// AuthLockoutSeconds is how long a locked out user cannot authenticate
+	// with its password.
+	AuthLockoutSeconds int `json:"auth-lockout-seconds"`
+
+	// AuthOIDCIssuer is the issuer of the OIDC tokens authenticating v3
+	// auth users. Empty disables OIDC tokens.
+	AuthOIDCIssuer string `json:"auth-oidc-issuer"`
+	// AuthOIDCJWKSFile is the path of the JSON Web Key Set verifying the
+	// signatures of the OIDC tokens.
+	AuthOIDCJWKSFile string `json:"auth-oidc-jwks-file"`
+	// AuthOIDCAudience is the audience the OIDC tokens must have, if any.
+	AuthOIDCAudience string `json:"auth-oidc-audience"`
+	// AuthOIDCUsernameClaim is the claim with the user name.
+	AuthOIDCUsernameClaim string `json:"auth-oidc-username-claim"`
+	// AuthOIDCUsernamePrefix is prepended to the user names of the tokens.
+	AuthOIDCUsernamePrefix string `json:"auth-oidc-username-prefix"`
+	// AuthOIDCGroupsClaim is the claim with the groups of the user, which
+	// are its roles. Empty grants no roles.
+	AuthOIDCGroupsClaim string `json:"auth-oidc-groups-claim"`
+	// AuthOIDCGroupsPrefix is prepended to the groups of the tokens.
+	AuthOIDCGroupsPrefix string `json:"auth-oidc-groups-prefix"`
+	// AuthOIDCAllowRootGroup lets a group of the tokens grant the root role.
+	AuthOIDCAllowRootGroup bool `json:"auth-oidc-allow-root-group"`
+
+	// audit
+
+	// AuditLogFile is the path of the audit log. Empty disables auditing.
+	AuditLogFile string `json:"audit-log-file"`
+	// AuditLogMaxSize is the size, in megabytes, above which the audit log
+	// is rotated.
+	AuditLogMaxSize int64 `json:"audit-log-max-size"`
+	// AuditLogMaxBackups is the number of rotated audit logs to keep.
+	AuditLogMaxBackups int `json:"audit-log-max-backups"`
+	// AuditSink stores the audit records in place of AuditLogFile. It is
+	// only used for embedding etcd into other applications.
+	AuditSink audit.Sink `json:"-"`
+	// AuditIncludePrefixes and AuditExcludePrefixes are comma separated
+	// lists of the key prefixes whose requests are recorded or not.
+	AuditIncludePrefixes string `json:"audit-include-prefixes"`
+	AuditExcludePrefixes string `json:"audit-exclude-prefixes"`
+
+	// EncryptionKeyFile is the path to the file holding the keys used to
+	// encrypt the WAL, snapshots and backend at rest.
+	EncryptionKeyFile string `json:"encryption-key-file"`
+	// EncryptionKeyProvider provides the keys used to encrypt data at rest
+	// in place of EncryptionKeyFile. It is only used for embedding etcd
+	// into other applications.
+	EncryptionKeyProvider encryption.KeyProvider `json:"-"`
+	// ValueEncryptionPrefixes is a comma separated list of the key prefixes
+	// whose values are encrypted with per-prefix data keys.
+	ValueEncryptionPrefixes string `json:"value-encryption-prefixes"`
+	// ValueEncryptionKeyFile is the path to the file holding the master keys
+	// wrapping the data keys of the encrypted prefixes.
+	ValueEncryptionKeyFile string `json:"value-encryption-key-file"`
+	// ValueEncryptionKeyProvider provides the master keys in place of
+	// ValueEncryptionKeyFile. It is only used for embedding etcd into other
+	// applications.
+	ValueEncryptionKeyProvider encryption.KeyProvider `json:"-"`
+
 	// debug
 
 	Debug        bool   `json:"debug"`
@@ -152,21 +271,28 @@ func NewConfig() *Config {
 	lcurl, _ := url.Parse(DefaultListenClientURLs)
 	acurl, _ := url.Parse(DefaultAdvertiseClientURLs)
 	cfg := &Config{
-		CorsInfo:            &cors.CORSInfo{},
-		MaxSnapFiles:        DefaultMaxSnapshots,
-		MaxWalFiles:         DefaultMaxWALs,
-		Name:                DefaultName,
-		SnapCount:           etcdserver.DefaultSnapCount,
-		TickMs:              100,
-		ElectionMs:          1000,
-		LPUrls:              []url.URL{*lpurl},
-		LCUrls:              []url.URL{*lcurl},
-		APUrls:              []url.URL{*apurl},
-		ACUrls:              []url.URL{*acurl},
-		ClusterState:        ClusterStateFlagNew,
-		InitialClusterToken: "etcd-cluster",
-		StrictReconfigCheck: true,
-		Metrics:             "basic",
+		CorsInfo:               &cors.CORSInfo{},
+		MaxSnapFiles:           DefaultMaxSnapshots,
+		MaxWalFiles:            DefaultMaxWALs,
+		Name:                   DefaultName,
+		SnapCount:              etcdserver.DefaultSnapCount,
+		TickMs:                 100,
+		ElectionMs:             1000,
+		LPUrls:                 []url.URL{*lpurl},
+		LCUrls:                 []url.URL{*lcurl},
+		APUrls:                 []url.URL{*apurl},
+		ACUrls:                 []url.URL{*acurl},
+		ClusterState:           ClusterStateFlagNew,
+		InitialClusterToken:    "etcd-cluster",
+		StrictReconfigCheck:    true,
+		Metrics:                "basic",
+		AuthBcryptCost:         bcrypt.DefaultCost,
+		AuthLockoutSeconds:     300,
+		AuthOIDCUsernameClaim:  "sub",
+		AuthOIDCUsernamePrefix: "oidc:",
+		AuthOIDCGroupsPrefix:   "oidc:",
+		AuditLogMaxSize:        100,
+		AuditLogMaxBackups:     5,
 	}
 	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
 	return cfg
@@ -281,6 +407,79 @@ func (cfg *Config) Validate() error {
 	if cfg.ElectionMs > maxElectionMs {
 		return fmt.Errorf("--election-timeout[%vms] is too long, and should be set less than %vms", cfg.ElectionMs, maxElectionMs)
 	}
+	if err := rafthttp.ValidateCompression(cfg.PeerCompression); err != nil {
+		return err
+	}
+	if cfg.BackendBatchLimit < 0 {
+		return fmt.Errorf("backend-batch-limit %d must not be negative", cfg.BackendBatchLimit)
+	}
+	if cfg.BackendMmapSize < 0 {
+		return fmt.Errorf("backend-mmap-size %d must not be negative", cfg.BackendMmapSize)
+	}
+	if cfg.CompactionBatchLimit < 0 {
+		return fmt.Errorf("compaction-batch-limit %d must not be negative", cfg.CompactionBatchLimit)
+	}
+	if cfg.AutoDefragThreshold < 0 || cfg.AutoDefragThreshold >= 1 {
+		return fmt.Errorf("auto-defrag-threshold %v must be in [0, 1)", cfg.AutoDefragThreshold)
+	}
+	if _, err := defragger.ParseWindow(cfg.AutoDefragWindow); err != nil {
+		return err
+	}
+	valueIndexes, err := mvcc.ParseValueIndexes(cfg.ValueIndexes)
+	if err != nil {
+		return err
+	}
+	if _, err := auth.ParseCertIdentity(cfg.ClientCertIdentity); err != nil {
+		return err
+	}
+	if cfg.ClientCertIdentity != "" && !cfg.ClientTLSInfo.ClientCertAuth {
+		return fmt.Errorf("client-cert-auth-identity requires client-cert-auth")
+	}
+	if cfg.AuthBcryptCost != 0 && (cfg.AuthBcryptCost < bcrypt.MinCost || cfg.AuthBcryptCost > bcrypt.MaxCost) {
+		return fmt.Errorf("auth-bcrypt-cost %d must be in [%d, %d]", cfg.AuthBcryptCost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	if cfg.AuthPasswordMinLength < 0 {
+		return fmt.Errorf("auth-password-min-length %d must not be negative", cfg.AuthPasswordMinLength)
+	}
+	if cfg.AuthLockoutAttempts < 0 {
+		return fmt.Errorf("auth-lockout-attempts %d must not be negative", cfg.AuthLockoutAttempts)
+	}
+	if cfg.AuthLockoutSeconds < 0 {
+		return fmt.Errorf("auth-lockout-seconds %d must not be negative", cfg.AuthLockoutSeconds)
+	}
+	if (cfg.AuthOIDCIssuer == "") != (cfg.AuthOIDCJWKSFile == "") {
+		return fmt.Errorf("auth-oidc-issuer and auth-oidc-jwks-file must be set together")
+	}
+	if cfg.AuditLogFile != "" && cfg.AuditSink != nil {
+		return fmt.Errorf("cannot set both AuditLogFile and AuditSink")
+	}
+	if cfg.AuditLogFile != "" && cfg.AuditLogMaxSize <= 0 {
+		return fmt.Errorf("audit-log-max-size %d must be positive", cfg.AuditLogMaxSize)
+	}
+	if cfg.AuditLogMaxBackups < 0 {
+		return fmt.Errorf("audit-log-max-backups %d must not be negative", cfg.AuditLogMaxBackups)
+	}
+	if cfg.EncryptionKeyFile != "" && cfg.EncryptionKeyProvider != nil {
+		return fmt.Errorf("cannot set both EncryptionKeyFile and EncryptionKeyProvider")
+	}
+	if cfg.ValueEncryptionKeyFile != "" && cfg.ValueEncryptionKeyProvider != nil {
+		return fmt.Errorf("cannot set both ValueEncryptionKeyFile and ValueEncryptionKeyProvider")
+	}
+	valueEncryptionPrefixes, err := envelope.ParsePrefixes(cfg.ValueEncryptionPrefixes)
+	if err != nil {
+		return err
+	}
+	for _, vi := range valueIndexes {
+		for _, p := range valueEncryptionPrefixes {
+			// the indexes would hold the digests of the encrypted values
+			if bytes.HasPrefix(p, []byte(vi.Prefix)) || bytes.HasPrefix([]byte(vi.Prefix), p) {
+				return fmt.Errorf("value index %q overlaps the encrypted prefix %q", vi.Name, p)
+			}
+		}
+	}
+	if cfg.ValueEncryptionPrefixes != "" && cfg.ValueEncryptionKeyFile == "" && cfg.ValueEncryptionKeyProvider == nil {
+		return fmt.Errorf("value-encryption-prefixes requires value-encryption-key-file")
+	}
 
 	// check this last since proxying in etcdmain may make this OK
 	if cfg.LCUrls != nil && cfg.ACUrls == nil {
@@ -349,6 +548,31 @@ func (cfg Config) defaultClientHost() bool {
 	return len(cfg.ACUrls) == 1 && cfg.ACUrls[0].String() == DefaultAdvertiseClientURLs
 }
 
+// authOIDC returns the verifier of the OIDC tokens of auth users, if any.
+func (cfg Config) authOIDC() (*auth.OIDC, error) {
+	if cfg.AuthOIDCIssuer == "" {
+		return nil, nil
+	}
+	b, err := ioutil.ReadFile(cfg.AuthOIDCJWKSFile)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := auth.ParseJWKS(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", cfg.AuthOIDCJWKSFile, err)
+	}
+	return &auth.OIDC{
+		Issuer:         cfg.AuthOIDCIssuer,
+		Audience:       cfg.AuthOIDCAudience,
+		UsernameClaim:  cfg.AuthOIDCUsernameClaim,
+		UsernamePrefix: cfg.AuthOIDCUsernamePrefix,
+		GroupsClaim:    cfg.AuthOIDCGroupsClaim,
+		GroupsPrefix:   cfg.AuthOIDCGroupsPrefix,
+		AllowRootGroup: cfg.AuthOIDCAllowRootGroup,
+		Keys:           keys,
+	}, nil
+}
+
 // UpdateDefaultClusterFromName updates cluster advertise URLs with, if available, default host,
 // if advertise URLs are default values(localhost:2379,2380) AND if listen URL is 0.0.0.0.
 // e.g. advertise peer URL localhost:2380 or listen peer URL 0.0.0.0:2380
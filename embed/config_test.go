@@ -149,3 +149,26 @@ func mustCreateCfgFile(t *testing.T, b []byte) *os.File {
 	}
 	return tmpfile
 }
+
+// TestValidateValueIndexEncryptionOverlap ensures a value index cannot cover
+// the keys of an encrypted prefix.
+func TestValidateValueIndexEncryptionOverlap(t *testing.T) {
+	tests := []struct {
+		indexes string
+		ok      bool
+	}{
+		{"a=/plain/", true},
+		{"a=/secret/x/", false},
+		{"a=/sec", false},
+		{"a=", false},
+	}
+	for i, tt := range tests {
+		cfg := NewConfig()
+		cfg.ValueIndexes = tt.indexes
+		cfg.ValueEncryptionPrefixes = "/secret/"
+		cfg.ValueEncryptionKeyFile = "keys"
+		if err := cfg.Validate(); (err == nil) != tt.ok {
+			t.Errorf("#%d: err = %v, want ok %v", i, err, tt.ok)
+		}
+	}
+}
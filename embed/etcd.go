@@ -20,10 +20,17 @@ import (
 	"net"
 	"net/http"
 	"path/filepath"
+	"time"
 
+	"etcd/audit"
+	"etcd/auth"
+	"etcd/defragger"
+	"etcd/envelope"
 	"etcd/etcdserver"
 	"etcd/etcdserver/api/v2http"
+	"etcd/mvcc"
 	"etcd/pkg/cors"
+	"etcd/pkg/encryption"
 	runtimeutil "etcd/pkg/runtime"
 	"etcd/pkg/transport"
 	"etcd/pkg/types"
@@ -53,9 +60,11 @@ type Etcd struct {
 	Clients []net.Listener
 	Server  *etcdserver.EtcdServer
 
-	cfg   Config
-	errc  chan error
-	sctxs map[string]*serveCtx
+	cfg  Config
+	errc chan error
+	// auditSink is the audit log opened for AuditLogFile
+	auditSink audit.Sink
+	sctxs     map[string]*serveCtx
 }
 
 // StartEtcd launches the etcd server and HTTP handlers for client/server communication.
@@ -74,6 +83,10 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		}
 	}()
 
+	var cipher *encryption.Cipher
+	if cipher, err = setupEncryption(cfg); err != nil {
+		return
+	}
 	if e.Peers, err = startPeerListeners(cfg); err != nil {
 		return
 	}
@@ -96,28 +109,83 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		}
 	}
 
+	defragWindow, err := defragger.ParseWindow(cfg.AutoDefragWindow)
+	if err != nil {
+		return e, err
+	}
+	valueIndexes, err := mvcc.ParseValueIndexes(cfg.ValueIndexes)
+	if err != nil {
+		return e, err
+	}
+	valueEncryptionPrefixes, err := envelope.ParsePrefixes(cfg.ValueEncryptionPrefixes)
+	if err != nil {
+		return e, err
+	}
+	valueEncryptionKeys := cfg.ValueEncryptionKeyProvider
+	if cfg.ValueEncryptionKeyFile != "" {
+		if valueEncryptionKeys, err = encryption.NewFileKeyProvider(cfg.ValueEncryptionKeyFile); err != nil {
+			return e, err
+		}
+	}
+	certIdentity, err := auth.ParseCertIdentity(cfg.ClientCertIdentity)
+	if err != nil {
+		return e, err
+	}
+	oidc, err := cfg.authOIDC()
+	if err != nil {
+		return e, err
+	}
+	auditLogger, err := e.setupAudit()
+	if err != nil {
+		return e, err
+	}
+
 	srvcfg := &etcdserver.ServerConfig{
-		Name:                    cfg.Name,
-		ClientURLs:              cfg.ACUrls,
-		PeerURLs:                cfg.APUrls,
-		DataDir:                 cfg.Dir,
-		DedicatedWALDir:         cfg.WalDir,
-		SnapCount:               cfg.SnapCount,
-		MaxSnapFiles:            cfg.MaxSnapFiles,
-		MaxWALFiles:             cfg.MaxWalFiles,
-		InitialPeerURLsMap:      urlsmap,
-		InitialClusterToken:     token,
-		DiscoveryURL:            cfg.Durl,
-		DiscoveryProxy:          cfg.Dproxy,
-		NewCluster:              cfg.IsNewCluster(),
-		ForceNewCluster:         cfg.ForceNewCluster,
-		PeerTLSInfo:             cfg.PeerTLSInfo,
-		TickMs:                  cfg.TickMs,
-		ElectionTicks:           cfg.ElectionTicks(),
-		AutoCompactionRetention: cfg.AutoCompactionRetention,
-		QuotaBackendBytes:       cfg.QuotaBackendBytes,
-		StrictReconfigCheck:     cfg.StrictReconfigCheck,
-		ClientCertAuthEnabled:   cfg.ClientTLSInfo.ClientCertAuth,
+		Name:                      cfg.Name,
+		ClientURLs:                cfg.ACUrls,
+		PeerURLs:                  cfg.APUrls,
+		DataDir:                   cfg.Dir,
+		DedicatedWALDir:           cfg.WalDir,
+		SnapCount:                 cfg.SnapCount,
+		MaxSnapFiles:              cfg.MaxSnapFiles,
+		MaxWALFiles:               cfg.MaxWalFiles,
+		WALArchiveDir:             cfg.WalArchiveDir,
+		InitialPeerURLsMap:        urlsmap,
+		InitialClusterToken:       token,
+		DiscoveryURL:              cfg.Durl,
+		DiscoveryProxy:            cfg.Dproxy,
+		NewCluster:                cfg.IsNewCluster(),
+		ForceNewCluster:           cfg.ForceNewCluster,
+		PeerTLSInfo:               cfg.PeerTLSInfo,
+		TickMs:                    cfg.TickMs,
+		ElectionTicks:             cfg.ElectionTicks(),
+		AutoCompactionRetention:   cfg.AutoCompactionRetention,
+		QuotaBackendBytes:         cfg.QuotaBackendBytes,
+		BackendBatchInterval:      time.Duration(cfg.BackendBatchIntervalMs) * time.Millisecond,
+		BackendBatchLimit:         cfg.BackendBatchLimit,
+		BackendMmapSize:           uint64(cfg.BackendMmapSize),
+		EncryptionCipher:          cipher,
+		CompactionBatchLimit:      cfg.CompactionBatchLimit,
+		CompactionSleepInterval:   time.Duration(cfg.CompactionSleepIntervalMs) * time.Millisecond,
+		CompactionBatchMaxLatency: time.Duration(cfg.CompactionBatchMaxLatencyMs) * time.Millisecond,
+		AutoDefragThreshold:       cfg.AutoDefragThreshold,
+		AutoDefragWindow:          defragWindow,
+		ValueIndexes:              valueIndexes,
+		ValueEncryptionPrefixes:   valueEncryptionPrefixes,
+		ValueEncryptionKeys:       valueEncryptionKeys,
+		StrictReconfigCheck:       cfg.StrictReconfigCheck,
+		PeerSnapshotSendRateLimit: cfg.PeerSnapshotSendRateLimit,
+		PeerCompression:           cfg.PeerCompression,
+		ClientCertAuthEnabled:     cfg.ClientTLSInfo.ClientCertAuth,
+		ClientCertIdentity:        certIdentity,
+		AuthPasswordPolicy: auth.PasswordPolicy{
+			BcryptCost:        cfg.AuthBcryptCost,
+			MinLength:         cfg.AuthPasswordMinLength,
+			MaxFailedAttempts: cfg.AuthLockoutAttempts,
+			LockoutDuration:   time.Duration(cfg.AuthLockoutSeconds) * time.Second,
+		},
+		AuthOIDC: oidc,
+		Audit:    auditLogger,
 	}
 
 	if e.Server, err = etcdserver.NewServer(srvcfg); err != nil {
@@ -125,7 +193,7 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 	}
 
 	// buffer channel so goroutines on closed connections won't wait forever
-	e.errc = make(chan error, len(e.Peers)+len(e.Clients)+2*len(e.sctxs))
+	e.errc = make(chan error, len(e.Peers)+len(e.Clients)+3*len(e.sctxs))
 
 	e.Server.Start()
 	if err = e.serve(); err != nil {
@@ -156,10 +224,56 @@ func (e *Etcd) Close() {
 	if e.Server != nil {
 		e.Server.Stop()
 	}
+	if e.auditSink != nil {
+		e.auditSink.Close()
+	}
 }
 
 func (e *Etcd) Err() <-chan error { return e.errc }
 
+// setupEncryption returns the cipher encrypting data at rest, or nil if
+// no key provider is configured.
+func setupEncryption(cfg *Config) (*encryption.Cipher, error) {
+	kp := cfg.EncryptionKeyProvider
+	if cfg.EncryptionKeyFile != "" {
+		var err error
+		if kp, err = encryption.NewFileKeyProvider(cfg.EncryptionKeyFile); err != nil {
+			return nil, err
+		}
+	}
+	if kp == nil {
+		return nil, nil
+	}
+	k, err := kp.ActiveKey()
+	if err != nil {
+		return nil, err
+	}
+	plog.Infof("encrypting data at rest with key %d", k.ID)
+	return encryption.NewCipher(kp), nil
+}
+
+// setupAudit returns the audit logger writing to the audit log or to the
+// audit sink of the configuration, if any.
+func (e *Etcd) setupAudit() (*audit.Logger, error) {
+	cfg := &e.cfg
+	sink := cfg.AuditSink
+	if cfg.AuditLogFile != "" {
+		var err error
+		if sink, err = audit.NewFileSink(cfg.AuditLogFile, cfg.AuditLogMaxSize*1024*1024, cfg.AuditLogMaxBackups); err != nil {
+			return nil, err
+		}
+		e.auditSink = sink
+	}
+	if sink == nil {
+		return nil, nil
+	}
+	rules := audit.Rules{
+		Include: audit.ParsePrefixes(cfg.AuditIncludePrefixes),
+		Exclude: audit.ParsePrefixes(cfg.AuditExcludePrefixes),
+	}
+	return audit.NewLogger(sink, rules), nil
+}
+
 func startPeerListeners(cfg *Config) (plns []net.Listener, err error) {
 	if cfg.PeerAutoTLS && cfg.PeerTLSInfo.Empty() {
 		phosts := make([]string, len(cfg.LPUrls))
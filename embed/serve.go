@@ -16,6 +16,7 @@ package embed
 
 import (
 	"crypto/tls"
+	"errors"
 	"io/ioutil"
 	defaultLog "log"
 	"net"
@@ -24,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"etcd/auth"
 	"etcd/etcdserver"
 	"etcd/etcdserver/api/v3rpc"
 	pb "etcd/etcdserver/etcdserverpb"
@@ -96,7 +98,11 @@ func (sctx *serveCtx) serve(s *etcdserver.EtcdServer, tlscfg *tls.Config, handle
 		// trust local server
 		dtls.InsecureSkipVerify = true
 		creds := credentials.NewTLS(dtls)
-		opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+		// the gateway connects in-process with the certificate of the
+		// server, so its connections are told apart from the clients'
+		gwl := newGatewayListener(sctx.ctx)
+		go func() { errc <- gs.Serve(gwl) }()
+		opts := []grpc.DialOption{grpc.WithTransportCredentials(creds), grpc.WithDialer(gwl.dial)}
 		gwmux, err := sctx.registerGateway(opts)
 		if err != nil {
 			return err
@@ -171,6 +177,10 @@ func (sctx *serveCtx) registerGateway(opts []grpc.DialOption) (*gw.ServeMux, err
 	if err != nil {
 		return nil, err
 	}
+	err = pb.RegisterQuotaHandlerFromEndpoint(ctx, gwmux, addr, opts)
+	if err != nil {
+		return nil, err
+	}
 	return gwmux, nil
 }
 
@@ -204,3 +214,60 @@ func (sctx *serveCtx) registerPprof() {
 	f(pprofPrefix+"/threadcreate", pprof.Handler("threadcreate"))
 	f(pprofPrefix+"/block", pprof.Handler("block"))
 }
+
+var (
+	errGatewayListenerClosed = errors.New("embed: gateway listener closed")
+	errGatewayDialTimeout    = errors.New("embed: gateway dial timed out")
+)
+
+// gatewayListener accepts the connections the grpc-gateway dials
+// in-process. Their remote address is auth.GatewayAddr, which no client
+// connection can have.
+type gatewayListener struct {
+	connc chan net.Conn
+	donec <-chan struct{}
+}
+
+// newGatewayListener creates a gatewayListener closed with ctx.
+func newGatewayListener(ctx context.Context) *gatewayListener {
+	return &gatewayListener{connc: make(chan net.Conn), donec: ctx.Done()}
+}
+
+func (l *gatewayListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.connc:
+		return c, nil
+	case <-l.donec:
+		return nil, errGatewayListenerClosed
+	}
+}
+
+// Close does nothing; the listener is closed with its context.
+func (l *gatewayListener) Close() error { return nil }
+
+func (l *gatewayListener) Addr() net.Addr { return auth.GatewayAddr{} }
+
+// dial connects to the listener, ignoring addr. A zero timeout waits for
+// the listener until it is closed.
+func (l *gatewayListener) dial(addr string, timeout time.Duration) (net.Conn, error) {
+	var timeoutc <-chan time.Time
+	if timeout > 0 {
+		timeoutc = time.After(timeout)
+	}
+	cc, sc := net.Pipe()
+	err := errGatewayListenerClosed
+	select {
+	case l.connc <- &gatewayConn{sc}:
+		return cc, nil
+	case <-timeoutc:
+		err = errGatewayDialTimeout
+	case <-l.donec:
+	}
+	cc.Close()
+	sc.Close()
+	return nil, err
+}
+
+type gatewayConn struct{ net.Conn }
+
+func (c *gatewayConn) RemoteAddr() net.Addr { return auth.GatewayAddr{} }
@@ -0,0 +1,346 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package envelope encrypts the values of key prefixes with data keys,
+// which are themselves encrypted with the master keys of a key provider.
+//
+// Each encrypted prefix has its own data keys. The data keys are stored in
+// the backend wrapped with a master key, so a copy of the backend, like a
+// snapshot, does not reveal the values without the master keys.
+package envelope
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc/backend"
+	"etcd/pkg/encryption"
+	"etcd/pkg/keyutil"
+	"github.com/coreos/pkg/capnslog"
+)
+
+var (
+	valueKeysBucketName = []byte("valueKeys")
+	plog                = capnslog.NewPackageLogger("github.com/coreos/etcd", "envelope")
+
+	ErrNotEncrypted = errors.New("envelope: prefix is not encrypted")
+	ErrNoDataKey    = errors.New("envelope: prefix has no data key")
+	ErrNoMasterKey  = errors.New("envelope: no master key to unwrap the data key")
+	ErrValueCompare = errors.New("envelope: cannot compare encrypted values")
+)
+
+// dataKeySize is the size of the data keys, for AES-256.
+const dataKeySize = 32
+
+type BackendGetter interface {
+	Backend() backend.Backend
+}
+
+// Store holds the data keys of the encrypted prefixes. It persists them to
+// the backend wrapped with the master keys and keeps them unwrapped in
+// memory once used.
+type Store struct {
+	// prefixes are the prefixes the member encrypts the new values of,
+	// longest first.
+	prefixes [][]byte
+	masters  *encryption.Cipher
+	values   *encryption.Cipher
+
+	mu   sync.RWMutex
+	keys map[uint32]*pb.ValueKey
+	// active is the id of the key new values are encrypted with by prefix.
+	active map[string]uint32
+	// secrets holds the unwrapped data keys.
+	secrets map[uint32]encryption.Key
+	maxID   uint32
+
+	bg BackendGetter
+}
+
+// NewStore returns the store of the data keys of bg. The values of the keys
+// with one of the given prefixes are encrypted. The master keys wrap the
+// data keys; they may be nil if no prefix is encrypted.
+func NewStore(bg BackendGetter, prefixes [][]byte, masters encryption.KeyProvider) (*Store, error) {
+	if len(prefixes) != 0 && masters == nil {
+		return nil, ErrNoMasterKey
+	}
+	s := &Store{
+		keys:    make(map[uint32]*pb.ValueKey),
+		active:  make(map[string]uint32),
+		secrets: make(map[uint32]encryption.Key),
+		bg:      bg,
+	}
+	for _, p := range prefixes {
+		s.prefixes = append(s.prefixes, append([]byte(nil), p...))
+	}
+	sort.Sort(prefixesByLength(s.prefixes))
+	if masters != nil {
+		s.masters = encryption.NewCipher(masters)
+	}
+	s.values = encryption.NewCipher(dataKeys{s})
+	err := s.restore()
+	return s, err
+}
+
+// ParsePrefixes parses a comma separated list of prefixes.
+func ParsePrefixes(s string) ([][]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var ret [][]byte
+	for _, p := range strings.Split(s, ",") {
+		if p == "" {
+			return nil, fmt.Errorf("envelope: empty prefix in %q", s)
+		}
+		ret = append(ret, []byte(p))
+	}
+	return ret, nil
+}
+
+// NewKey generates a data key for the given encrypted prefix, wrapped with
+// the active master key. The key is used once added to the store with Add.
+func (s *Store) NewKey(prefix []byte) (*pb.ValueKey, error) {
+	if !s.isPrefix(prefix) {
+		return nil, ErrNotEncrypted
+	}
+	secret := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return nil, err
+	}
+	wrapped, err := s.masters.Encrypt(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ValueKey{Prefix: prefix, WrappedKey: wrapped}, nil
+}
+
+// Add adds a data key to the store, which becomes the active key of its
+// prefix, and returns its id. If initial is true, the key is only added if
+// the prefix has no key yet; otherwise the id of the active key is returned.
+func (s *Store) Add(k *pb.ValueKey, initial bool) uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id, ok := s.active[string(k.Prefix)]; ok && initial {
+		return id
+	}
+
+	nk := &pb.ValueKey{Id: s.maxID + 1, Prefix: k.Prefix, WrappedKey: k.WrappedKey}
+	v, err := nk.Marshal()
+	if err != nil {
+		plog.Panicf("failed to marshal data key")
+	}
+	tx := s.bg.Backend().BatchTx()
+	tx.Lock()
+	tx.UnsafePut(valueKeysBucketName, keyID(nk.Id), v)
+	tx.Unlock()
+
+	s.add(nk)
+	plog.Noticef("added data key %d to prefix %q", nk.Id, nk.Prefix)
+	return nk.Id
+}
+
+// ActiveKey returns the id of the key the new values of the prefix are
+// encrypted with, or false if the prefix has no key.
+func (s *Store) ActiveKey(prefix []byte) (uint32, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	id, ok := s.active[string(prefix)]
+	return id, ok
+}
+
+// Prefix returns the prefix the member encrypts the value of key for, or
+// nil if it stores the value of key in plaintext.
+func (s *Store) Prefix(key []byte) []byte {
+	for _, p := range s.prefixes {
+		if bytes.HasPrefix(key, p) {
+			return p
+		}
+	}
+	return nil
+}
+
+// Encrypted returns true if the value of key may be encrypted, either by
+// this member or by another member with different prefixes.
+func (s *Store) Encrypted(key []byte) bool {
+	if s.Prefix(key) != nil {
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for p := range s.active {
+		if bytes.HasPrefix(key, []byte(p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// EncryptedRange returns true if the range [key, end) holds keys whose
+// values may be encrypted. An empty end is the single key, and end "\x00"
+// all the keys from key.
+func (s *Store) EncryptedRange(key, end []byte) bool {
+	if len(end) == 0 {
+		return s.Encrypted(key)
+	}
+	overlaps := func(p []byte) bool {
+		if !keyutil.IsFromKey(end) && bytes.Compare(p, end) >= 0 {
+			return false
+		}
+		pend := keyutil.PrefixEnd(p)
+		return keyutil.IsFromKey(pend) || bytes.Compare(key, pend) < 0
+	}
+	for _, p := range s.prefixes {
+		if overlaps(p) {
+			return true
+		}
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for p := range s.active {
+		if overlaps([]byte(p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Encrypt encrypts the value of key with the active data key of its prefix.
+// The value is returned unchanged if the prefix of key is not encrypted.
+func (s *Store) Encrypt(key, value []byte) ([]byte, error) {
+	p := s.Prefix(key)
+	if p == nil {
+		return value, nil
+	}
+	id, ok := s.ActiveKey(p)
+	if !ok {
+		return nil, ErrNoDataKey
+	}
+	k, err := s.dataKey(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.values.EncryptWithKey(k, value)
+}
+
+// Decrypt decrypts the value of key with the data key it is encrypted with.
+// Plaintext values are returned unchanged.
+func (s *Store) Decrypt(key, value []byte) ([]byte, error) {
+	if !s.Encrypted(key) {
+		return value, nil
+	}
+	return s.values.Decrypt(value)
+}
+
+func (s *Store) isPrefix(prefix []byte) bool {
+	for _, p := range s.prefixes {
+		if bytes.Equal(p, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// dataKey returns the unwrapped data key with the given id.
+func (s *Store) dataKey(id uint32) (encryption.Key, error) {
+	s.mu.RLock()
+	k, ok := s.secrets[id]
+	vk := s.keys[id]
+	s.mu.RUnlock()
+	if ok {
+		return k, nil
+	}
+	if vk == nil {
+		return encryption.Key{}, encryption.ErrKeyNotFound
+	}
+	if s.masters == nil {
+		return encryption.Key{}, ErrNoMasterKey
+	}
+	if _, ok = encryption.KeyID(vk.WrappedKey); !ok {
+		plog.Errorf("data key %d is not wrapped", id)
+		return encryption.Key{}, encryption.ErrDecrypt
+	}
+	secret, err := s.masters.Decrypt(vk.WrappedKey)
+	if err != nil {
+		plog.Errorf("cannot unwrap data key %d (%v)", id, err)
+		return encryption.Key{}, err
+	}
+
+	k = encryption.Key{ID: id, Secret: secret}
+	s.mu.Lock()
+	s.secrets[id] = k
+	s.mu.Unlock()
+	return k, nil
+}
+
+// add adds k to the keys in memory. Keys are added by increasing id.
+func (s *Store) add(k *pb.ValueKey) {
+	s.keys[k.Id] = k
+	s.active[string(k.Prefix)] = k.Id
+	if k.Id > s.maxID {
+		s.maxID = k.Id
+	}
+}
+
+func (s *Store) restore() error {
+	b := s.bg.Backend()
+	tx := b.BatchTx()
+
+	tx.Lock()
+	tx.UnsafeCreateBucket(valueKeysBucketName)
+	// the bucket is sorted by id
+	err := tx.UnsafeForEach(valueKeysBucketName, func(k, v []byte) error {
+		var vk pb.ValueKey
+		if err := vk.Unmarshal(v); err != nil {
+			return err
+		}
+		s.add(&vk)
+		return nil
+	})
+	tx.Unlock()
+
+	b.ForceCommit()
+	return err
+}
+
+func keyID(id uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, id)
+	return b
+}
+
+// dataKeys provides the data keys to the cipher of the values. The values
+// are encrypted with the active key of their prefix rather than with an
+// active key of the provider.
+type dataKeys struct{ s *Store }
+
+func (dk dataKeys) ActiveKey() (encryption.Key, error) {
+	return encryption.Key{}, encryption.ErrKeyNotFound
+}
+
+func (dk dataKeys) Key(id uint32) (encryption.Key, error) { return dk.s.dataKey(id) }
+
+type prefixesByLength [][]byte
+
+func (p prefixesByLength) Len() int           { return len(p) }
+func (p prefixesByLength) Less(i, j int) bool { return len(p[i]) > len(p[j]) }
+func (p prefixesByLength) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
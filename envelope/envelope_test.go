@@ -0,0 +1,192 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envelope
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"etcd/mvcc/backend"
+	"etcd/pkg/encryption"
+)
+
+type fakeBackendGetter struct{ b backend.Backend }
+
+func (bg *fakeBackendGetter) Backend() backend.Backend { return bg.b }
+
+func newMasterKeys(ids ...uint32) encryption.KeyProvider {
+	var keys []encryption.Key
+	for _, id := range ids {
+		keys = append(keys, encryption.Key{ID: id, Secret: bytes.Repeat([]byte{byte(id)}, 32)})
+	}
+	return encryption.NewKeyProvider(keys...)
+}
+
+func TestStoreEncrypt(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	defer b.Close()
+
+	bg := &fakeBackendGetter{b}
+	s, err := NewStore(bg, [][]byte{[]byte("/s/"), []byte("/s/t/")}, newMasterKeys(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = s.Encrypt([]byte("/s/a"), []byte("v")); err != ErrNoDataKey {
+		t.Fatalf("err = %v, want %v", err, ErrNoDataKey)
+	}
+	if _, err = s.NewKey([]byte("/x/")); err != ErrNotEncrypted {
+		t.Fatalf("err = %v, want %v", err, ErrNotEncrypted)
+	}
+	for _, p := range []string{"/s/", "/s/t/"} {
+		k, kerr := s.NewKey([]byte(p))
+		if kerr != nil {
+			t.Fatal(kerr)
+		}
+		s.Add(k, true)
+	}
+
+	// the longest prefix is used
+	if p := s.Prefix([]byte("/s/t/a")); string(p) != "/s/t/" {
+		t.Fatalf("prefix = %q, want %q", p, "/s/t/")
+	}
+	v1, err := s.Encrypt([]byte("/s/t/a"), []byte("v"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := encryption.KeyID(v1); id != 2 {
+		t.Fatalf("key id = %d, want 2", id)
+	}
+	plain, err := s.Encrypt([]byte("/x/a"), []byte("v"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plain) != "v" {
+		t.Fatalf("value = %q, want plaintext", plain)
+	}
+
+	// an initial key does not replace the active key
+	k, err := s.NewKey([]byte("/s/t/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := s.Add(k, true); id != 2 {
+		t.Fatalf("id = %d, want 2", id)
+	}
+	if id := s.Add(k, false); id != 3 {
+		t.Fatalf("id = %d, want 3", id)
+	}
+	v3, err := s.Encrypt([]byte("/s/t/a"), []byte("v"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := encryption.KeyID(v3); id != 3 {
+		t.Fatalf("key id = %d, want 3", id)
+	}
+
+	// the keys are restored from the backend, and the values encrypted
+	// with them are readable by a member not encrypting the prefixes
+	s, err = NewStore(bg, nil, newMasterKeys(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := s.ActiveKey([]byte("/s/t/")); id != 3 {
+		t.Fatalf("active key = %d, want 3", id)
+	}
+	for _, v := range [][]byte{v1, v3} {
+		d, derr := s.Decrypt([]byte("/s/t/a"), v)
+		if derr != nil {
+			t.Fatal(derr)
+		}
+		if string(d) != "v" {
+			t.Fatalf("value = %q, want %q", d, "v")
+		}
+	}
+	if !s.Encrypted([]byte("/s/b")) || s.Encrypted([]byte("/x/a")) {
+		t.Fatalf("unexpected encrypted prefixes")
+	}
+}
+
+func TestStoreEncryptedRange(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	defer b.Close()
+
+	s, err := NewStore(&fakeBackendGetter{b}, [][]byte{[]byte("/s/")}, newMasterKeys(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		key, end string
+		w        bool
+	}{
+		{"/s/a", "", true},
+		{"/t/a", "", false},
+		{"/", "/t", true},
+		{"/", "/s/", false},
+		{"/s0", "/t", false},
+		{"/s/z", "/t", true},
+		{"/", "\x00", true},
+		{"/t", "\x00", false},
+	}
+	for i, tt := range tests {
+		if g := s.EncryptedRange([]byte(tt.key), []byte(tt.end)); g != tt.w {
+			t.Errorf("#%d: encrypted range [%q, %q) = %v, want %v", i, tt.key, tt.end, g, tt.w)
+		}
+	}
+}
+
+func TestStoreMasterKeyRotation(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	defer b.Close()
+
+	bg := &fakeBackendGetter{b}
+	s, err := NewStore(bg, [][]byte{[]byte("/s/")}, newMasterKeys(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := s.NewKey([]byte("/s/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Add(k, true)
+	v, err := s.Encrypt([]byte("/s/a"), []byte("v"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the data key stays readable as long as its master key is provided
+	if s, err = NewStore(bg, [][]byte{[]byte("/s/")}, newMasterKeys(1, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Decrypt([]byte("/s/a"), v); err != nil {
+		t.Fatal(err)
+	}
+	if s, err = NewStore(bg, [][]byte{[]byte("/s/")}, newMasterKeys(2)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Decrypt([]byte("/s/a"), v); err != encryption.ErrKeyNotFound {
+		t.Fatalf("err = %v, want %v", err, encryption.ErrKeyNotFound)
+	}
+	if s, err = NewStore(bg, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Decrypt([]byte("/s/a"), v); err != ErrNoMasterKey {
+		t.Fatalf("err = %v, want %v", err, ErrNoMasterKey)
+	}
+}
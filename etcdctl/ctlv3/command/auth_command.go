@@ -16,6 +16,7 @@ package command
 
 import (
 	"fmt"
+	"strconv"
 
 	"etcd/etcdserver/api/v3rpc/rpctypes"
 	"github.com/spf13/cobra"
@@ -24,12 +25,15 @@ import (
 // NewAuthCommand returns the cobra command for "auth".
 func NewAuthCommand() *cobra.Command {
 	ac := &cobra.Command{
-		Use:   "auth <enable or disable>",
-		Short: "Enable or disable authentication",
+		Use:   "auth <enable, disable, token, export or import>",
+		Short: "Enable or disable authentication, manage auth tokens, or export and import users and roles",
 	}
 
 	ac.AddCommand(newAuthEnableCommand())
 	ac.AddCommand(newAuthDisableCommand())
+	ac.AddCommand(newAuthTokenCommand())
+	ac.AddCommand(newAuthExportCommand())
+	ac.AddCommand(newAuthImportCommand())
 
 	return ac
 }
@@ -95,3 +99,60 @@ func authDisableCommandFunc(cmd *cobra.Command, args []string) {
 
 	fmt.Println("Authentication Disabled")
 }
+
+func newAuthTokenCommand() *cobra.Command {
+	tc := &cobra.Command{
+		Use:   "token <subcommand>",
+		Short: "Auth token related commands",
+	}
+
+	tc.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "Lists the active auth tokens",
+		Run:   authTokenListCommandFunc,
+	})
+	tc.AddCommand(&cobra.Command{
+		Use:   "revoke <token ID>",
+		Short: "Revokes an auth token",
+		Run:   authTokenRevokeCommandFunc,
+	})
+
+	return tc
+}
+
+// authTokenListCommandFunc executes the "auth token list" command.
+func authTokenListCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("auth token list command does not accept any arguments."))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Auth.TokenList(ctx)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+
+	display.TokenList(*resp)
+}
+
+// authTokenRevokeCommandFunc executes the "auth token revoke" command.
+func authTokenRevokeCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("auth token revoke command needs 1 argument."))
+	}
+
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		ExitWithError(ExitBadArgs, fmt.Errorf("bad token ID %q", args[0]))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Auth.TokenRevoke(ctx, id)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+
+	display.TokenRevoke(id, *resp)
+}
@@ -0,0 +1,270 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"etcd/auth/authpb"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// authDocumentVersion is the version of the documents written by
+	// "auth export". "auth import" also reads the documents of version 1,
+	// which have no key encoding.
+	authDocumentVersion = 2
+
+	// keyEncodingBase64 encodes the keys of a document in base64, since
+	// JSON strings cannot hold the keys that are not valid UTF-8.
+	keyEncodingBase64 = "base64"
+)
+
+var (
+	authExportWithPasswords bool
+	authImportDryRun        bool
+)
+
+// authDocument is the JSON document of the users and roles of a cluster.
+type authDocument struct {
+	Version int `json:"version"`
+	// KeyEncoding is the encoding of the permission keys and of the admin
+	// prefixes: empty for UTF-8 strings, or "base64".
+	KeyEncoding string        `json:"keyEncoding,omitempty"`
+	Users       []authDocUser `json:"users"`
+	Roles       []authDocRole `json:"roles"`
+}
+
+type authDocUser struct {
+	Name string `json:"name"`
+	// Password is the bcrypt hash of the password of the user.
+	Password        string   `json:"password,omitempty"`
+	PasswordChanged int64    `json:"passwordChanged,omitempty"`
+	NoPassword      bool     `json:"noPassword,omitempty"`
+	Roles           []string `json:"roles,omitempty"`
+}
+
+type authDocRole struct {
+	Name        string        `json:"name"`
+	Permissions []authDocPerm `json:"permissions,omitempty"`
+	Admin       bool          `json:"admin,omitempty"`
+	AdminPrefix string        `json:"adminPrefix,omitempty"`
+}
+
+type authDocPerm struct {
+	Type     string `json:"type"`
+	Key      string `json:"key"`
+	RangeEnd string `json:"rangeEnd,omitempty"`
+	Deny     bool   `json:"deny,omitempty"`
+}
+
+func newAuthExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [options]",
+		Short: "Exports all users and roles as a JSON document",
+		Run:   authExportCommandFunc,
+	}
+	cmd.Flags().BoolVar(&authExportWithPasswords, "with-passwords", false, "export the password hashes of the users")
+	return cmd
+}
+
+func newAuthImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [options] <filename>",
+		Short: "Adds or updates the users and roles of an exported JSON document",
+		Run:   authImportCommandFunc,
+	}
+	cmd.Flags().BoolVar(&authImportDryRun, "dry-run", false, "print the changes of the import without applying them")
+	return cmd
+}
+
+// authExportCommandFunc executes the "auth export" command.
+func authExportCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("auth export command does not accept any arguments."))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Auth.AuthExport(ctx, authExportWithPasswords)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+
+	doc := newAuthDocument(resp.Users, resp.Roles)
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	fmt.Println(string(b))
+}
+
+// authImportCommandFunc executes the "auth import" command.
+func authImportCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("auth import command needs 1 argument."))
+	}
+
+	b, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		ExitWithError(ExitBadArgs, err)
+	}
+	var doc authDocument
+	if err = json.Unmarshal(b, &doc); err != nil {
+		ExitWithError(ExitBadArgs, fmt.Errorf("bad auth document %q (%v)", args[0], err))
+	}
+	users, roles, err := doc.authpb()
+	if err != nil {
+		ExitWithError(ExitBadArgs, fmt.Errorf("bad auth document %q (%v)", args[0], err))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Auth.AuthImport(ctx, users, roles, authImportDryRun)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+
+	display.AuthImport(authImportDryRun, *resp)
+}
+
+func newAuthDocument(users []*authpb.User, roles []*authpb.Role) *authDocument {
+	doc := &authDocument{Version: authDocumentVersion, Users: []authDocUser{}, Roles: []authDocRole{}}
+	if !validUTF8Keys(roles) {
+		doc.KeyEncoding = keyEncodingBase64
+	}
+	for _, u := range users {
+		du := authDocUser{
+			Name:            string(u.Name),
+			Password:        string(u.Password),
+			PasswordChanged: u.PasswordChanged,
+			Roles:           u.Roles,
+		}
+		if u.Options != nil {
+			du.NoPassword = u.Options.NoPassword
+		}
+		doc.Users = append(doc.Users, du)
+	}
+	for _, r := range roles {
+		dr := authDocRole{Name: string(r.Name)}
+		if r.Options != nil {
+			dr.Admin = r.Options.Admin
+			dr.AdminPrefix = doc.encodeKey(r.Options.AdminPrefix)
+		}
+		for _, p := range r.KeyPermission {
+			dr.Permissions = append(dr.Permissions, authDocPerm{
+				Type:     authpb.Permission_Type_name[int32(p.PermType)],
+				Key:      doc.encodeKey(p.Key),
+				RangeEnd: doc.encodeKey(p.RangeEnd),
+				Deny:     p.Deny,
+			})
+		}
+		doc.Roles = append(doc.Roles, dr)
+	}
+	return doc
+}
+
+// validUTF8Keys returns true if the permission keys and the admin prefixes
+// of the roles are valid UTF-8.
+func validUTF8Keys(roles []*authpb.Role) bool {
+	for _, r := range roles {
+		if r.Options != nil && !utf8.Valid(r.Options.AdminPrefix) {
+			return false
+		}
+		for _, p := range r.KeyPermission {
+			if !utf8.Valid(p.Key) || !utf8.Valid(p.RangeEnd) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (doc *authDocument) encodeKey(k []byte) string {
+	if doc.KeyEncoding == keyEncodingBase64 {
+		return base64.StdEncoding.EncodeToString(k)
+	}
+	return string(k)
+}
+
+func (doc *authDocument) decodeKey(k string) ([]byte, error) {
+	if doc.KeyEncoding == keyEncodingBase64 {
+		return base64.StdEncoding.DecodeString(k)
+	}
+	return []byte(k), nil
+}
+
+// authpb gets the users and roles of the document.
+func (doc *authDocument) authpb() ([]*authpb.User, []*authpb.Role, error) {
+	if doc.Version != 1 && doc.Version != authDocumentVersion {
+		return nil, nil, fmt.Errorf("unsupported version %d", doc.Version)
+	}
+	if doc.KeyEncoding != "" && doc.KeyEncoding != keyEncodingBase64 {
+		return nil, nil, fmt.Errorf("unsupported key encoding %q", doc.KeyEncoding)
+	}
+
+	var users []*authpb.User
+	for _, du := range doc.Users {
+		u := &authpb.User{
+			Name:            []byte(du.Name),
+			Password:        []byte(du.Password),
+			PasswordChanged: du.PasswordChanged,
+			Roles:           du.Roles,
+		}
+		if du.NoPassword {
+			u.Options = &authpb.UserAddOptions{NoPassword: true}
+		}
+		users = append(users, u)
+	}
+
+	var roles []*authpb.Role
+	for _, dr := range doc.Roles {
+		r := &authpb.Role{Name: []byte(dr.Name)}
+		if dr.Admin {
+			prefix, err := doc.decodeKey(dr.AdminPrefix)
+			if err != nil {
+				return nil, nil, fmt.Errorf("bad admin prefix of role %s (%v)", dr.Name, err)
+			}
+			r.Options = &authpb.RoleAddOptions{Admin: true, AdminPrefix: prefix}
+		}
+		for _, dp := range dr.Permissions {
+			typ, ok := authpb.Permission_Type_value[strings.ToUpper(dp.Type)]
+			if !ok {
+				return nil, nil, fmt.Errorf("bad permission type %q of role %s", dp.Type, dr.Name)
+			}
+			key, err := doc.decodeKey(dp.Key)
+			if err != nil {
+				return nil, nil, fmt.Errorf("bad permission key of role %s (%v)", dr.Name, err)
+			}
+			rangeEnd, err := doc.decodeKey(dp.RangeEnd)
+			if err != nil {
+				return nil, nil, fmt.Errorf("bad permission range end of role %s (%v)", dr.Name, err)
+			}
+			r.KeyPermission = append(r.KeyPermission, &authpb.Permission{
+				PermType: authpb.Permission_Type(typ),
+				Key:      key,
+				RangeEnd: rangeEnd,
+				Deny:     dp.Deny,
+			})
+		}
+		roles = append(roles, r)
+	}
+	return users, roles, nil
+}
@@ -21,16 +21,25 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var defragStatus bool
+
 // NewDefragCommand returns the cobra command for "Defrag".
 func NewDefragCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "defrag",
 		Short: "Defragments the storage of the etcd members with given endpoints",
 		Run:   defragCommandFunc,
 	}
+	cmd.Flags().BoolVar(&defragStatus, "status", false, "Prints the progress of the ongoing defragmentations instead of defragmenting")
+	return cmd
 }
 
 func defragCommandFunc(cmd *cobra.Command, args []string) {
+	if defragStatus {
+		defragStatusCommandFunc(cmd)
+		return
+	}
+
 	failures := 0
 	c := mustClientFromCmd(cmd)
 	for _, ep := range c.Endpoints() {
@@ -49,3 +58,31 @@ func defragCommandFunc(cmd *cobra.Command, args []string) {
 		os.Exit(ExitError)
 	}
 }
+
+func defragStatusCommandFunc(cmd *cobra.Command) {
+	failures := 0
+	c := mustClientFromCmd(cmd)
+	for _, ep := range c.Endpoints() {
+		ctx, cancel := commandCtx(cmd)
+		resp, err := c.Status(ctx, ep)
+		cancel()
+		switch {
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "Failed to get the status of etcd member[%s] (%v)\n", ep, err)
+			failures++
+		case resp.Defrag == nil:
+			fmt.Printf("etcd member[%s] is not defragmenting\n", ep)
+		default:
+			d := resp.Defrag
+			pct := 100.0
+			if d.TotalKeys > 0 {
+				pct = float64(d.CopiedKeys) * 100 / float64(d.TotalKeys)
+			}
+			fmt.Printf("Defragmenting etcd member[%s]: %d/%d keys copied (%.1f%%), %d writes logged\n", ep, d.CopiedKeys, d.TotalKeys, pct, d.LoggedWrites)
+		}
+	}
+
+	if failures != 0 {
+		os.Exit(ExitError)
+	}
+}
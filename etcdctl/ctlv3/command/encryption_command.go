@@ -0,0 +1,174 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"etcd/pkg/encryption"
+	"etcd/snap"
+	"etcd/wal"
+	"github.com/boltdb/bolt"
+	"github.com/spf13/cobra"
+)
+
+var (
+	encryptionWALDir  string
+	encryptionKeyFile string
+)
+
+// NewEncryptionCommand returns the cobra command for "encryption".
+func NewEncryptionCommand() *cobra.Command {
+	ec := &cobra.Command{
+		Use:   "encryption <subcommand>",
+		Short: "Encryption at rest related commands",
+	}
+	ec.AddCommand(newEncryptionStatusCommand())
+	ec.AddCommand(newEncryptionRotateValueKeyCommand())
+	return ec
+}
+
+func newEncryptionStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status <data-dir>",
+		Short: "Checks the encryption at rest of an etcd data directory",
+		Long: `Counts the plaintext and encrypted wal records, snapshot files and backend values
+of the data directory by the id of the key they are encrypted with.
+The member must be stopped, or the data directory copied, to open the backend.
+`,
+		Run: encryptionStatusCommandFunc,
+	}
+	cmd.Flags().StringVar(&encryptionWALDir, "wal-dir", "", "Path to the dedicated wal directory of the member")
+	cmd.Flags().StringVar(&encryptionKeyFile, "encryption-key-file", "", "Path to the key file of the member, to check all the keys in use are available")
+	return cmd
+}
+
+func newEncryptionRotateValueKeyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-value-key <prefix>",
+		Short: "Replaces the data key encrypting the values of an encrypted prefix",
+		Run:   encryptionRotateValueKeyCommandFunc,
+	}
+}
+
+func encryptionRotateValueKeyCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("encryption rotate-value-key requires exactly one argument"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).ValueKeyRotate(ctx, args[0])
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	fmt.Printf("Rotated the data key of prefix %q to key %d\n", args[0], resp.KeyId)
+}
+
+func encryptionStatusCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("encryption status requires exactly one argument"))
+	}
+	dataDir := args[0]
+	waldir := encryptionWALDir
+	if waldir == "" {
+		waldir = filepath.Join(dataDir, "member", "wal")
+	}
+	snapdir := filepath.Join(dataDir, "member", "snap")
+
+	wst, err := wal.EncryptionStatus(waldir)
+	if err != nil {
+		ExitWithError(ExitError, fmt.Errorf("cannot read wal (%v)", err))
+	}
+	sst, err := snap.EncryptionStatus(snapdir)
+	if err != nil {
+		ExitWithError(ExitError, fmt.Errorf("cannot read snapshots (%v)", err))
+	}
+	dst, err := dbEncryptionStatus(filepath.Join(snapdir, "db"))
+	if err != nil {
+		ExitWithError(ExitError, fmt.Errorf("cannot read backend (%v)", err))
+	}
+
+	fmt.Printf("wal: %s\n", formatEncryptionStatus(wst))
+	fmt.Printf("snap: %s\n", formatEncryptionStatus(sst))
+	fmt.Printf("db: %s\n", formatEncryptionStatus(dst))
+
+	if encryptionKeyFile == "" {
+		return
+	}
+	kp, err := encryption.NewFileKeyProvider(encryptionKeyFile)
+	if err != nil {
+		ExitWithError(ExitBadArgs, err)
+	}
+	active, err := kp.ActiveKey()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	fmt.Printf("active key: %d\n", active.ID)
+
+	var missing []string
+	for _, st := range []encryption.Status{wst, sst, dst} {
+		for id := range st.Encrypted {
+			if _, err := kp.Key(id); err != nil {
+				missing = append(missing, fmt.Sprint(id))
+			}
+		}
+	}
+	if len(missing) != 0 {
+		ExitWithError(ExitError, fmt.Errorf("keys %s in use are missing from %s", strings.Join(missing, ","), encryptionKeyFile))
+	}
+}
+
+// dbEncryptionStatus counts the plaintext and encrypted values of
+// the backend at the given path.
+func dbEncryptionStatus(p string) (encryption.Status, error) {
+	var st encryption.Status
+	if _, err := os.Stat(p); err != nil {
+		return st, err
+	}
+	db, err := bolt.Open(p, 0400, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return st, err
+	}
+	defer db.Close()
+
+	err = db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(_ []byte, b *bolt.Bucket) error {
+			return b.ForEach(func(_, v []byte) error {
+				st.Add(v)
+				return nil
+			})
+		})
+	})
+	return st, err
+}
+
+func formatEncryptionStatus(st encryption.Status) string {
+	ids := make([]int, 0, len(st.Encrypted))
+	for id := range st.Encrypted {
+		ids = append(ids, int(id))
+	}
+	sort.Ints(ids)
+	s := fmt.Sprintf("plaintext=%d", st.Plaintext)
+	for _, id := range ids {
+		s += fmt.Sprintf(" key%d=%d", id, st.Encrypted[uint32(id)])
+	}
+	return s
+}
@@ -31,6 +31,8 @@ var (
 	getFromKey     bool
 	getRev         int64
 	getKeysOnly    bool
+	getLeaseTTL    bool
+	getIndex       string
 	printValueOnly bool
 )
 
@@ -50,6 +52,8 @@ func NewGetCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&getFromKey, "from-key", false, "Get keys that are greater than or equal to the given key using byte compare")
 	cmd.Flags().Int64Var(&getRev, "rev", 0, "Specify the kv revision")
 	cmd.Flags().BoolVar(&getKeysOnly, "keys-only", false, "Get only the keys")
+	cmd.Flags().BoolVar(&getLeaseTTL, "lease-ttl", false, "Get the remaining lease TTL of each key")
+	cmd.Flags().StringVar(&getIndex, "index", "", "Get only the keys holding a value, as 'name=value', through the named value index")
 	cmd.Flags().BoolVar(&printValueOnly, "print-value-only", false, `Only write values when using the "simple" output format`)
 	return cmd
 }
@@ -159,5 +163,17 @@ func getGetOp(cmd *cobra.Command, args []string) (string, []clientv3.OpOption) {
 		opts = append(opts, clientv3.WithKeysOnly())
 	}
 
+	if getLeaseTTL {
+		opts = append(opts, clientv3.WithLeaseTTL())
+	}
+
+	if getIndex != "" {
+		iv := strings.SplitN(getIndex, "=", 2)
+		if len(iv) != 2 || iv[0] == "" {
+			ExitWithError(ExitBadArgs, fmt.Errorf("bad index %q, expected 'name=value'", getIndex))
+		}
+		opts = append(opts, clientv3.WithValueIndex(iv[0], iv[1]))
+	}
+
 	return key, opts
 }
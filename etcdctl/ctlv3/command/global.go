@@ -43,7 +43,8 @@ type GlobalFlags struct {
 	OutputFormat string
 	IsHex        bool
 
-	User string
+	User  string
+	Token string
 }
 
 type secureCfg struct {
@@ -58,6 +59,7 @@ type secureCfg struct {
 type authCfg struct {
 	username string
 	password string
+	token    string
 }
 
 var display printer = &simplePrinter{}
@@ -152,6 +154,7 @@ func newClientCfg(endpoints []string, dialTimeout time.Duration, scfg *secureCfg
 	if acfg != nil {
 		cfg.Username = acfg.username
 		cfg.Password = acfg.password
+		cfg.Token = acfg.token
 	}
 
 	return cfg, nil
@@ -237,7 +240,14 @@ func authCfgFromCmd(cmd *cobra.Command) *authCfg {
 	}
 
 	if userFlag == "" {
-		return nil
+		token, err := cmd.Flags().GetString("token")
+		if err != nil {
+			ExitWithError(ExitBadArgs, err)
+		}
+		if token == "" {
+			return nil
+		}
+		return &authCfg{token: token}
 	}
 
 	var cfg authCfg
@@ -0,0 +1,90 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+
+	"etcd/clientv3"
+	"github.com/spf13/cobra"
+)
+
+var (
+	historyConsistency string
+	historyLimit       int64
+	historyPrefix      bool
+	historyMinRev      int64
+	historyMaxRev      int64
+)
+
+// NewHistoryCommand returns the cobra command for "history".
+func NewHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [options] <key> [range_end]",
+		Short: "Gets the retained revisions of the key or a range of keys",
+		Run:   historyCommandFunc,
+	}
+
+	cmd.Flags().StringVar(&historyConsistency, "consistency", "l", "Linearizable(l) or Serializable(s)")
+	cmd.Flags().Int64Var(&historyLimit, "limit", 0, "Maximum number of revisions")
+	cmd.Flags().BoolVar(&historyPrefix, "prefix", false, "Get the revisions of the keys with matching prefix")
+	cmd.Flags().Int64Var(&historyMinRev, "min-rev", 0, "Minimum revision to return")
+	cmd.Flags().Int64Var(&historyMaxRev, "max-rev", 0, "Maximum revision to return")
+	return cmd
+}
+
+// historyCommandFunc executes the "history" command.
+func historyCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("history command needs arguments."))
+	}
+
+	opts := []clientv3.OpOption{}
+	switch historyConsistency {
+	case "s":
+		opts = append(opts, clientv3.WithSerializable())
+	case "l":
+	default:
+		ExitWithError(ExitBadFeature, fmt.Errorf("unknown consistency flag %q", historyConsistency))
+	}
+
+	key := args[0]
+	if len(args) > 1 {
+		if historyPrefix {
+			ExitWithError(ExitBadArgs, fmt.Errorf("too many arguments, only accept one argument when `--prefix` is set."))
+		}
+		opts = append(opts, clientv3.WithRange(args[1]))
+	}
+	if historyPrefix {
+		if len(key) == 0 {
+			key = "\x00"
+			opts = append(opts, clientv3.WithFromKey())
+		} else {
+			opts = append(opts, clientv3.WithPrefix())
+		}
+	}
+
+	opts = append(opts, clientv3.WithLimit(historyLimit))
+	opts = append(opts, clientv3.WithMinModRev(historyMinRev))
+	opts = append(opts, clientv3.WithMaxModRev(historyMaxRev))
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).History(ctx, key, opts...)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	display.History(*resp)
+}
@@ -103,7 +103,7 @@ var timeToLiveKeys bool
 // NewLeaseTimeToLiveCommand returns the cobra command for "lease timetolive".
 func NewLeaseTimeToLiveCommand() *cobra.Command {
 	lc := &cobra.Command{
-		Use:   "timetolive <leaseID> [options]",
+		Use:   "timetolive <leaseID> [<leaseID>...] [options]",
 		Short: "Get lease information",
 
 		Run: leaseTimeToLiveCommandFunc,
@@ -115,13 +115,27 @@ func NewLeaseTimeToLiveCommand() *cobra.Command {
 
 // leaseTimeToLiveCommandFunc executes the "lease timetolive" command.
 func leaseTimeToLiveCommandFunc(cmd *cobra.Command, args []string) {
-	if len(args) != 1 {
+	if len(args) == 0 {
 		ExitWithError(ExitBadArgs, fmt.Errorf("lease timetolive command needs lease ID as argument"))
 	}
 	var opts []v3.LeaseOption
 	if timeToLiveKeys {
 		opts = append(opts, v3.WithAttachedKeys())
 	}
+	if len(args) > 1 {
+		ids := make([]v3.LeaseID, len(args))
+		for i := range args {
+			ids[i] = leaseFromArgs(args[i])
+		}
+		resp, rerr := mustClientFromCmd(cmd).TimeToLives(context.TODO(), ids, opts...)
+		if rerr != nil {
+			ExitWithError(ExitBadConnection, rerr)
+		}
+		for _, l := range resp.Leases {
+			display.TimeToLive(*l, timeToLiveKeys)
+		}
+		return
+	}
 	resp, rerr := mustClientFromCmd(cmd).TimeToLive(context.TODO(), leaseFromArgs(args[0]), opts...)
 	if rerr != nil {
 		ExitWithError(ExitBadConnection, rerr)
@@ -22,12 +22,18 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
+	"etcd/auth"
+	"etcd/auth/authpb"
 	"etcd/client"
+	"etcd/clientv3"
 	etcdErr "etcd/error"
 	"etcd/etcdserver"
 	"etcd/etcdserver/api"
+	v2auth "etcd/etcdserver/auth"
 	pb "etcd/etcdserver/etcdserverpb"
 	"etcd/etcdserver/membership"
 	"etcd/mvcc"
@@ -55,7 +61,7 @@ var (
 func NewMigrateCommand() *cobra.Command {
 	mc := &cobra.Command{
 		Use:   "migrate",
-		Short: "Migrates keys in a v2 store to a mvcc store",
+		Short: "Migrates keys, users and roles in a v2 store to a mvcc store",
 		Run:   migrateCommandFunc,
 	}
 
@@ -96,6 +102,8 @@ func migrateCommandFunc(cmd *cobra.Command, args []string) {
 		ExitWithError(ExitError, err)
 	}
 
+	migrateAuth(st, be)
+
 	fmt.Println("finished transforming keys")
 }
 
@@ -249,8 +257,9 @@ func writeStore(w io.Writer, st store.Store) uint64 {
 	all, err := st.Get("/1", true, true)
 	if err != nil {
 		if eerr, ok := err.(*etcdErr.Error); ok && eerr.ErrorCode == etcdErr.EcodeKeyNotFound {
+			// the v2 users and roles may still need to be migrated
 			fmt.Println("no v2 keys to migrate")
-			os.Exit(0)
+			return 0
 		}
 		ExitWithError(ExitError, err)
 	}
@@ -408,3 +417,175 @@ func transform(n *client.Node) *mvccpb.KeyValue {
 	}
 	return kv
 }
+
+// migrateAuth adds the v2 users and roles of st to the v3 auth store of be,
+// and enables v3 authentication if v2 authentication is enabled. The v2
+// rules without exact v3 equivalent are reported.
+func migrateAuth(st store.Store, be backend.Backend) {
+	users, roles, enabled := readV2Auth(st)
+	if len(users) == 0 && len(roles) == 0 {
+		fmt.Println("no v2 users and roles to migrate")
+		return
+	}
+
+	r, notes := translateV2Auth(users, roles)
+	for _, note := range notes {
+		fmt.Println("not translated exactly:", note)
+	}
+
+	as := auth.NewAuthStore(be, nil)
+	defer as.Close()
+	resp, err := as.AuthImport(r)
+	if err != nil {
+		fmt.Println("failed to migrate users and roles")
+		ExitWithError(ExitError, err)
+	}
+	for _, c := range resp.Changes {
+		if c.Action == pb.AuthImportChange_UPDATE {
+			fmt.Printf("updated v3 %s %s (%s)\n", strings.ToLower(c.Kind.String()), c.Name, strings.Join(c.Fields, ", "))
+		}
+	}
+	if enabled {
+		if err = as.AuthEnable(); err != nil {
+			fmt.Println("failed to enable authentication")
+			ExitWithError(ExitError, err)
+		}
+	}
+
+	fmt.Printf("migrated %d v2 users and %d v2 roles\n", len(users), len(roles))
+}
+
+// readV2Auth reads the users and roles of v2 auth, and if it is enabled.
+func readV2Auth(st store.Store) (users []v2auth.User, roles []v2auth.Role, enabled bool) {
+	if n := getV2AuthNode(st, "/enabled"); n != nil && n.Value != nil {
+		if err := json.Unmarshal([]byte(*n.Value), &enabled); err != nil {
+			ExitWithError(ExitError, err)
+		}
+	}
+	if n := getV2AuthNode(st, "/users"); n != nil {
+		for _, un := range n.Nodes {
+			var u v2auth.User
+			if err := json.Unmarshal([]byte(*un.Value), &u); err != nil {
+				ExitWithError(ExitError, err)
+			}
+			users = append(users, u)
+		}
+	}
+	if n := getV2AuthNode(st, "/roles"); n != nil {
+		for _, rn := range n.Nodes {
+			var r v2auth.Role
+			if err := json.Unmarshal([]byte(*rn.Value), &r); err != nil {
+				ExitWithError(ExitError, err)
+			}
+			roles = append(roles, r)
+		}
+	}
+	return users, roles, enabled
+}
+
+func getV2AuthNode(st store.Store, res string) *store.NodeExtern {
+	ev, err := st.Get(v2auth.StorePermsPrefix+res, true, true)
+	if err != nil {
+		if eerr, ok := err.(*etcdErr.Error); ok && eerr.ErrorCode == etcdErr.EcodeKeyNotFound {
+			return nil
+		}
+		ExitWithError(ExitError, err)
+	}
+	return ev.Node
+}
+
+// translateV2Auth translates v2 users and roles into v3 ones. It returns
+// the notes on the v2 rules that are not translated exactly.
+func translateV2Auth(users []v2auth.User, roles []v2auth.Role) (*pb.AuthImportRequest, []string) {
+	var notes []string
+	r := &pb.AuthImportRequest{}
+
+	// the v2 root role is implicit; the v3 one must exist
+	exists := map[string]bool{v2auth.RootRoleName: true}
+	r.Roles = append(r.Roles, &authpb.Role{Name: []byte(v2auth.RootRoleName)})
+	for _, role := range roles {
+		if role.Role == v2auth.RootRoleName {
+			continue
+		}
+		if role.Role == v2auth.GuestRoleName {
+			notes = append(notes, fmt.Sprintf("role %q only applies to the users granted it, v3 has no access without authentication", role.Role))
+		}
+		perms, pnotes := translateV2Permissions(role.Role, role.Permissions.KV)
+		notes = append(notes, pnotes...)
+		r.Roles = append(r.Roles, &authpb.Role{Name: []byte(role.Role), KeyPermission: perms})
+		exists[role.Role] = true
+	}
+
+	for _, u := range users {
+		nu := &authpb.User{Name: []byte(u.User), Password: []byte(u.Password)}
+		for _, role := range u.Roles {
+			if !exists[role] {
+				notes = append(notes, fmt.Sprintf("user %q: role %q does not exist, it is not granted", u.User, role))
+				continue
+			}
+			nu.Roles = append(nu.Roles, role)
+		}
+		// the v2 root user holds the root role implicitly
+		if u.User == "root" && !hasString(nu.Roles, v2auth.RootRoleName) {
+			nu.Roles = append(nu.Roles, v2auth.RootRoleName)
+		}
+		r.Users = append(r.Users, nu)
+	}
+	return r, notes
+}
+
+// translateV2Permissions translates the v2 path patterns of a role into v3
+// permissions. A pattern ending with "*" grants the keys with the prefix
+// before the "*", other patterns grant a single key.
+func translateV2Permissions(role string, kv v2auth.RWPermission) ([]*authpb.Permission, []string) {
+	var notes []string
+	access := make(map[string]authpb.Permission_Type)
+	add := func(pattern string, typ authpb.Permission_Type) {
+		if len(pattern) == 0 {
+			notes = append(notes, fmt.Sprintf("role %q: the empty pattern is ignored", role))
+			return
+		}
+		if cur, ok := access[pattern]; ok && cur != typ {
+			typ = authpb.READWRITE
+		}
+		access[pattern] = typ
+	}
+	for _, p := range kv.Read {
+		add(p, authpb.READ)
+	}
+	for _, p := range kv.Write {
+		add(p, authpb.WRITE)
+	}
+
+	patterns := make([]string, 0, len(access))
+	for p := range access {
+		patterns = append(patterns, p)
+	}
+	sort.Strings(patterns)
+
+	var perms []*authpb.Permission
+	for _, p := range patterns {
+		perm := &authpb.Permission{PermType: access[p], Key: []byte(p)}
+		if strings.HasSuffix(p, "*") {
+			prefix := strings.TrimSuffix(p, "*")
+			if len(prefix) == 0 {
+				// all migrated keys are under "/", but v3 keys need not be
+				notes = append(notes, fmt.Sprintf("role %q: pattern %q only grants the keys under \"/\"", role, p))
+				prefix = "/"
+			}
+			perm.Key = []byte(prefix)
+			perm.RangeEnd = []byte(clientv3.GetPrefixRangeEnd(prefix))
+		}
+		perms = append(perms, perm)
+	}
+	return perms, notes
+}
+
+func hasString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
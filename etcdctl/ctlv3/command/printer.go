@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	v3 "etcd/clientv3"
 	"github.com/dustin/go-humanize"
@@ -31,6 +32,7 @@ type printer interface {
 	Put(v3.PutResponse)
 	Txn(v3.TxnResponse)
 	Watch(v3.WatchResponse)
+	History(v3.HistoryResponse)
 
 	Grant(r v3.LeaseGrantResponse)
 	Revoke(id v3.LeaseID, r v3.LeaseRevokeResponse)
@@ -47,6 +49,18 @@ type printer interface {
 	Alarm(v3.AlarmResponse)
 	DBStatus(dbstatus)
 
+	QuotaSet(prefix string, maxBytes, maxKeys int64, r v3.QuotaSetResponse)
+	QuotaGet(v3.QuotaGetResponse)
+	QuotaList(v3.QuotaListResponse)
+
+	RateLimitSet(method string, rate int64, r v3.AuthRateLimitSetResponse)
+	RateLimitList(v3.AuthRateLimitListResponse)
+
+	TokenList(v3.AuthTokenListResponse)
+	TokenRevoke(id uint64, r v3.AuthTokenRevokeResponse)
+
+	AuthImport(dryRun bool, r v3.AuthImportResponse)
+
 	RoleAdd(role string, r v3.AuthRoleAddResponse)
 	RoleGet(role string, r v3.AuthRoleGetResponse)
 	RoleDelete(role string, r v3.AuthRoleDeleteResponse)
@@ -90,6 +104,8 @@ func (p *printerRPC) Put(r v3.PutResponse)     { p.p((*pb.PutResponse)(&r)) }
 func (p *printerRPC) Txn(r v3.TxnResponse)     { p.p((*pb.TxnResponse)(&r)) }
 func (p *printerRPC) Watch(r v3.WatchResponse) { p.p(&r) }
 
+func (p *printerRPC) History(r v3.HistoryResponse) { p.p((*pb.HistoryResponse)(&r)) }
+
 func (p *printerRPC) Grant(r v3.LeaseGrantResponse)                      { p.p(r) }
 func (p *printerRPC) Revoke(id v3.LeaseID, r v3.LeaseRevokeResponse)     { p.p(r) }
 func (p *printerRPC) KeepAlive(r v3.LeaseKeepAliveResponse)              { p.p(r) }
@@ -105,6 +121,30 @@ func (p *printerRPC) MemberUpdate(id uint64, r v3.MemberUpdateResponse) {
 func (p *printerRPC) MemberList(r v3.MemberListResponse) { p.p((*pb.MemberListResponse)(&r)) }
 func (p *printerRPC) Alarm(r v3.AlarmResponse)           { p.p((*pb.AlarmResponse)(&r)) }
 
+func (p *printerRPC) QuotaSet(_ string, _, _ int64, r v3.QuotaSetResponse) {
+	p.p((*pb.QuotaSetResponse)(&r))
+}
+func (p *printerRPC) QuotaGet(r v3.QuotaGetResponse)   { p.p((*pb.QuotaGetResponse)(&r)) }
+func (p *printerRPC) QuotaList(r v3.QuotaListResponse) { p.p((*pb.QuotaListResponse)(&r)) }
+
+func (p *printerRPC) RateLimitSet(_ string, _ int64, r v3.AuthRateLimitSetResponse) {
+	p.p((*pb.AuthRateLimitSetResponse)(&r))
+}
+func (p *printerRPC) RateLimitList(r v3.AuthRateLimitListResponse) {
+	p.p((*pb.AuthRateLimitListResponse)(&r))
+}
+
+func (p *printerRPC) TokenList(r v3.AuthTokenListResponse) {
+	p.p((*pb.AuthTokenListResponse)(&r))
+}
+func (p *printerRPC) TokenRevoke(_ uint64, r v3.AuthTokenRevokeResponse) {
+	p.p((*pb.AuthTokenRevokeResponse)(&r))
+}
+
+func (p *printerRPC) AuthImport(_ bool, r v3.AuthImportResponse) {
+	p.p((*pb.AuthImportResponse)(&r))
+}
+
 func (p *printerRPC) RoleAdd(_ string, r v3.AuthRoleAddResponse) { p.p((*pb.AuthRoleAddResponse)(&r)) }
 func (p *printerRPC) RoleGet(_ string, r v3.AuthRoleGetResponse) { p.p((*pb.AuthRoleGetResponse)(&r)) }
 func (p *printerRPC) RoleDelete(_ string, r v3.AuthRoleDeleteResponse) {
@@ -179,6 +219,76 @@ func makeEndpointStatusTable(statusList []epStatus) (hdr []string, rows [][]stri
 	return
 }
 
+func makeQuotaListTable(r v3.QuotaListResponse) (hdr []string, rows [][]string) {
+	hdr = []string{"prefix", "max bytes", "max keys"}
+	for _, q := range r.Quotas {
+		rows = append(rows, []string{
+			string(q.Prefix),
+			quotaLimit(q.MaxBytes),
+			quotaLimit(q.MaxKeys),
+		})
+	}
+	return
+}
+
+func quotaLimit(l int64) string {
+	if l <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprint(l)
+}
+
+func makeRateLimitListTable(r v3.AuthRateLimitListResponse) (hdr []string, rows [][]string) {
+	hdr = []string{"method", "key", "rate", "burst"}
+	for _, l := range r.Limits {
+		key := strings.ToLower(l.Key.String())
+		if l.Key == v3.RateLimitByTag {
+			key += ":" + l.Tag
+		}
+		rows = append(rows, []string{
+			l.Method,
+			key,
+			fmt.Sprint(l.Rate),
+			fmt.Sprint(l.Burst),
+		})
+	}
+	return
+}
+
+func makeTokenListTable(r v3.AuthTokenListResponse) (hdr []string, rows [][]string) {
+	hdr = []string{"ID", "user", "member", "created", "last used"}
+	for _, t := range r.Tokens {
+		rows = append(rows, []string{
+			fmt.Sprint(t.ID),
+			t.User,
+			fmt.Sprintf("%x", t.MemberId),
+			tokenTime(t.Created),
+			tokenTime(t.LastUsed),
+		})
+	}
+	return
+}
+
+func makeAuthImportTable(r v3.AuthImportResponse) (hdr []string, rows [][]string) {
+	hdr = []string{"kind", "action", "name", "fields"}
+	for _, c := range r.Changes {
+		rows = append(rows, []string{
+			strings.ToLower(c.Kind.String()),
+			strings.ToLower(c.Action.String()),
+			c.Name,
+			strings.Join(c.Fields, " "),
+		})
+	}
+	return
+}
+
+func tokenTime(sec int64) string {
+	if sec == 0 {
+		return "never"
+	}
+	return time.Unix(sec, 0).Format(time.RFC3339)
+}
+
 func makeDBStatusTable(ds dbstatus) (hdr []string, rows [][]string) {
 	hdr = []string{"hash", "revision", "total keys", "total size"}
 	rows = append(rows, []string{
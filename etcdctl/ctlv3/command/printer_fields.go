@@ -50,8 +50,11 @@ func (p *fieldsPrinter) Del(r v3.DeleteResponse) {
 
 func (p *fieldsPrinter) Get(r v3.GetResponse) {
 	p.hdr(r.Header)
-	for _, kv := range r.Kvs {
+	for i, kv := range r.Kvs {
 		p.kv("", kv)
+		if i < len(r.LeaseTtls) {
+			fmt.Println(`"LeaseTTL" :`, r.LeaseTtls[i])
+		}
 	}
 	fmt.Println(`"More" :`, r.More)
 	fmt.Println(`"Count" :`, r.Count)
@@ -92,6 +95,15 @@ func (p *fieldsPrinter) Watch(resp v3.WatchResponse) {
 	}
 }
 
+func (p *fieldsPrinter) History(r v3.HistoryResponse) {
+	p.hdr(r.Header)
+	for _, e := range r.Events {
+		fmt.Println(`"Type" :`, e.Type)
+		p.kv("", e.Kv)
+	}
+	fmt.Println(`"More" :`, r.More)
+}
+
 func (p *fieldsPrinter) Grant(r v3.LeaseGrantResponse) {
 	p.hdr(r.ResponseHeader)
 	fmt.Println(`"ID" :`, r.ID)
@@ -155,6 +167,72 @@ func (p *fieldsPrinter) Alarm(r v3.AlarmResponse) {
 	}
 }
 
+func (p *fieldsPrinter) QuotaSet(prefix string, maxBytes, maxKeys int64, r v3.QuotaSetResponse) {
+	p.hdr(r.Header)
+}
+
+func (p *fieldsPrinter) QuotaGet(r v3.QuotaGetResponse) {
+	p.hdr(r.Header)
+	fmt.Printf("\"Prefix\" : %q\n", string(r.Quota.Prefix))
+	fmt.Println(`"MaxBytes" :`, r.Quota.MaxBytes)
+	fmt.Println(`"MaxKeys" :`, r.Quota.MaxKeys)
+	fmt.Println(`"Bytes" :`, r.Usage.Bytes)
+	fmt.Println(`"Keys" :`, r.Usage.Keys)
+}
+
+func (p *fieldsPrinter) QuotaList(r v3.QuotaListResponse) {
+	p.hdr(r.Header)
+	for _, q := range r.Quotas {
+		fmt.Printf("\"Prefix\" : %q\n", string(q.Prefix))
+		fmt.Println(`"MaxBytes" :`, q.MaxBytes)
+		fmt.Println(`"MaxKeys" :`, q.MaxKeys)
+		fmt.Println()
+	}
+}
+
+func (p *fieldsPrinter) RateLimitSet(method string, rate int64, r v3.AuthRateLimitSetResponse) {
+	p.hdr(r.Header)
+}
+
+func (p *fieldsPrinter) RateLimitList(r v3.AuthRateLimitListResponse) {
+	p.hdr(r.Header)
+	for _, l := range r.Limits {
+		fmt.Printf("\"Method\" : %q\n", l.Method)
+		fmt.Printf("\"Key\" : %q\n", l.Key)
+		fmt.Printf("\"Tag\" : %q\n", l.Tag)
+		fmt.Println(`"Rate" :`, l.Rate)
+		fmt.Println(`"Burst" :`, l.Burst)
+		fmt.Println()
+	}
+}
+
+func (p *fieldsPrinter) TokenList(r v3.AuthTokenListResponse) {
+	p.hdr(r.Header)
+	for _, t := range r.Tokens {
+		fmt.Println(`"ID" :`, t.ID)
+		fmt.Printf("\"User\" : %q\n", t.User)
+		fmt.Println(`"MemberID" :`, t.MemberId)
+		fmt.Println(`"Created" :`, t.Created)
+		fmt.Println(`"LastUsed" :`, t.LastUsed)
+		fmt.Println()
+	}
+}
+
+func (p *fieldsPrinter) TokenRevoke(id uint64, r v3.AuthTokenRevokeResponse) {
+	p.hdr(r.Header)
+}
+
+func (p *fieldsPrinter) AuthImport(dryRun bool, r v3.AuthImportResponse) {
+	p.hdr(r.Header)
+	for _, c := range r.Changes {
+		fmt.Printf("\"Kind\" : %q\n", c.Kind)
+		fmt.Printf("\"Action\" : %q\n", c.Action)
+		fmt.Printf("\"Name\" : %q\n", c.Name)
+		fmt.Printf("\"Fields\" : %q\n", c.Fields)
+		fmt.Println()
+	}
+}
+
 func (p *fieldsPrinter) DBStatus(r dbstatus) {
 	fmt.Println(`"Hash" :`, r.Hash)
 	fmt.Println(`"Revision" :`, r.Revision)
@@ -17,7 +17,9 @@ package command
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"etcd/auth/authpb"
 	v3 "etcd/clientv3"
 	pb "etcd/etcdserver/etcdserverpb"
 )
@@ -79,6 +81,13 @@ func (s *simplePrinter) Watch(resp v3.WatchResponse) {
 	}
 }
 
+func (s *simplePrinter) History(resp v3.HistoryResponse) {
+	for _, e := range resp.Events {
+		fmt.Println(e.Type)
+		printKV(s.isHex, s.valueOnly, e.Kv)
+	}
+}
+
 func (s *simplePrinter) Grant(resp v3.LeaseGrantResponse) {
 	fmt.Printf("lease %016x granted with TTL(%ds)\n", resp.ID, resp.TTL)
 }
@@ -92,6 +101,10 @@ func (p *simplePrinter) KeepAlive(resp v3.LeaseKeepAliveResponse) {
 }
 
 func (s *simplePrinter) TimeToLive(resp v3.LeaseTimeToLiveResponse, keys bool) {
+	if resp.GrantedTTL == 0 && resp.TTL == -1 {
+		fmt.Printf("lease %016x already expired\n", resp.ID)
+		return
+	}
 	txt := fmt.Sprintf("lease %016x granted with TTL(%ds), remaining(%ds)", resp.ID, resp.GrantedTTL, resp.TTL)
 	if keys {
 		ks := make([]string, len(resp.Keys))
@@ -109,6 +122,72 @@ func (s *simplePrinter) Alarm(resp v3.AlarmResponse) {
 	}
 }
 
+func (s *simplePrinter) QuotaSet(prefix string, maxBytes, maxKeys int64, r v3.QuotaSetResponse) {
+	if maxBytes <= 0 && maxKeys <= 0 {
+		fmt.Printf("Quota of prefix %s removed\n", prefix)
+		return
+	}
+	fmt.Printf("Quota of prefix %s set\n", prefix)
+}
+
+func (s *simplePrinter) QuotaGet(r v3.QuotaGetResponse) {
+	fmt.Printf("Quota of prefix %s\n", r.Quota.Prefix)
+	fmt.Printf("\tbytes: %d of %s\n", r.Usage.Bytes, quotaLimit(r.Quota.MaxBytes))
+	fmt.Printf("\tkeys: %d of %s\n", r.Usage.Keys, quotaLimit(r.Quota.MaxKeys))
+}
+
+func (s *simplePrinter) QuotaList(r v3.QuotaListResponse) {
+	_, rows := makeQuotaListTable(r)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) RateLimitSet(method string, rate int64, r v3.AuthRateLimitSetResponse) {
+	if rate == 0 {
+		fmt.Printf("Rate limit of %s removed\n", method)
+		return
+	}
+	fmt.Printf("Rate limit of %s set\n", method)
+}
+
+func (s *simplePrinter) RateLimitList(r v3.AuthRateLimitListResponse) {
+	_, rows := makeRateLimitListTable(r)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) TokenList(r v3.AuthTokenListResponse) {
+	_, rows := makeTokenListTable(r)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) TokenRevoke(id uint64, r v3.AuthTokenRevokeResponse) {
+	fmt.Printf("Token %d revoked\n", id)
+}
+
+func (s *simplePrinter) AuthImport(dryRun bool, r v3.AuthImportResponse) {
+	for _, c := range r.Changes {
+		kind := strings.ToLower(c.Kind.String())
+		if c.Action == pb.AuthImportChange_ADD {
+			fmt.Printf("+ %s %s\n", kind, c.Name)
+		} else {
+			fmt.Printf("~ %s %s: %s\n", kind, c.Name, strings.Join(c.Fields, ", "))
+		}
+	}
+	switch {
+	case len(r.Changes) == 0:
+		fmt.Println("Nothing to import")
+	case dryRun:
+		fmt.Println("Dry run, no change applied")
+	default:
+		fmt.Println("Users and roles imported")
+	}
+}
+
 func (s *simplePrinter) MemberAdd(r v3.MemberAddResponse) {
 	fmt.Printf("Member %16x added to cluster %16x\n", r.Member.ID, r.Header.ClusterId)
 }
@@ -148,7 +227,9 @@ func (s *simplePrinter) RoleAdd(role string, r v3.AuthRoleAddResponse) {
 
 func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 	fmt.Printf("Role %s\n", role)
-	fmt.Println("KV Read:")
+	if r.Options != nil && r.Options.Admin {
+		fmt.Printf("Admin of prefix: %s\n", string(r.Options.AdminPrefix))
+	}
 
 	printRange := func(perm *v3.Permission) {
 		sKey := string(perm.Key)
@@ -159,19 +240,18 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 		}
 		fmt.Printf("\n")
 	}
-
-	for _, perm := range r.Perm {
-		if perm.PermType == v3.PermRead || perm.PermType == v3.PermReadWrite {
-			if len(perm.RangeEnd) == 0 {
-				fmt.Printf("\t%s\n", string(perm.Key))
-			} else {
-				printRange((*v3.Permission)(perm))
+	// perms lists the permissions of the type t, or of READWRITE if rw
+	perms := func(deny bool, t authpb.Permission_Type, rw bool) (ps []*authpb.Permission) {
+		for _, perm := range r.Perm {
+			if perm.Deny == deny && (perm.PermType == t || (rw && perm.PermType == v3.PermReadWrite)) {
+				ps = append(ps, perm)
 			}
 		}
+		return ps
 	}
-	fmt.Println("KV Write:")
-	for _, perm := range r.Perm {
-		if perm.PermType == v3.PermWrite || perm.PermType == v3.PermReadWrite {
+	printPerms := func(title string, ps []*authpb.Permission) {
+		fmt.Println(title)
+		for _, perm := range ps {
 			if len(perm.RangeEnd) == 0 {
 				fmt.Printf("\t%s\n", string(perm.Key))
 			} else {
@@ -179,6 +259,23 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 			}
 		}
 	}
+
+	printPerms("KV Read:", perms(false, v3.PermRead, true))
+	printPerms("KV Write:", perms(false, v3.PermWrite, true))
+	if ps := perms(false, v3.PermWatch, false); len(ps) > 0 {
+		printPerms("KV Watch:", ps)
+	}
+	hasDeny := false
+	for _, perm := range r.Perm {
+		hasDeny = hasDeny || perm.Deny
+	}
+	if hasDeny {
+		printPerms("KV Read Denied:", perms(true, v3.PermRead, true))
+		printPerms("KV Write Denied:", perms(true, v3.PermWrite, true))
+		if ps := perms(true, v3.PermWatch, false); len(ps) > 0 {
+			printPerms("KV Watch Denied:", ps)
+		}
+	}
 }
 
 func (s *simplePrinter) RoleList(r v3.AuthRoleListResponse) {
@@ -214,6 +311,18 @@ func (s *simplePrinter) UserGet(name string, r v3.AuthUserGetResponse) {
 		fmt.Printf(" %s", role)
 	}
 	fmt.Printf("\n")
+	if r.Options != nil && r.Options.NoPassword {
+		fmt.Printf("No password: true\n")
+	}
+	if r.PasswordChanged != 0 {
+		fmt.Printf("Password changed: %s\n", time.Unix(r.PasswordChanged, 0).UTC().Format(time.RFC3339))
+	}
+	if r.FailedAttempts != 0 {
+		fmt.Printf("Failed attempts: %d\n", r.FailedAttempts)
+	}
+	if r.LockedUntil > time.Now().Unix() {
+		fmt.Printf("Locked until: %s\n", time.Unix(r.LockedUntil, 0).UTC().Format(time.RFC3339))
+	}
 }
 
 func (s *simplePrinter) UserChangePassword(v3.AuthUserChangePasswordResponse) {
@@ -42,6 +42,42 @@ func (tp *tablePrinter) EndpointStatus(r []epStatus) {
 	}
 	table.Render()
 }
+func (tp *tablePrinter) QuotaList(r v3.QuotaListResponse) {
+	hdr, rows := makeQuotaListTable(r)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+func (tp *tablePrinter) RateLimitList(r v3.AuthRateLimitListResponse) {
+	hdr, rows := makeRateLimitListTable(r)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+func (tp *tablePrinter) TokenList(r v3.AuthTokenListResponse) {
+	hdr, rows := makeTokenListTable(r)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+func (tp *tablePrinter) AuthImport(dryRun bool, r v3.AuthImportResponse) {
+	hdr, rows := makeAuthImportTable(r)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
 func (tp *tablePrinter) DBStatus(r dbstatus) {
 	hdr, rows := makeDBStatusTable(r)
 	table := tablewriter.NewWriter(os.Stdout)
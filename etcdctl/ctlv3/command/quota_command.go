@@ -0,0 +1,112 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	quotaMaxBytes int64
+	quotaMaxKeys  int64
+)
+
+// NewQuotaCommand returns the cobra command for "quota".
+func NewQuotaCommand() *cobra.Command {
+	qc := &cobra.Command{
+		Use:   "quota <subcommand>",
+		Short: "Prefix quota related commands",
+	}
+
+	qc.AddCommand(newQuotaSetCommand())
+	qc.AddCommand(newQuotaGetCommand())
+	qc.AddCommand(newQuotaListCommand())
+
+	return qc
+}
+
+func newQuotaSetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <prefix>",
+		Short: "Sets the quota of a key prefix",
+		Run:   quotaSetCommandFunc,
+	}
+	cmd.Flags().Int64Var(&quotaMaxBytes, "max-bytes", 0, "Maximum total size of the keys and values under the prefix (0 means no limit)")
+	cmd.Flags().Int64Var(&quotaMaxKeys, "max-keys", 0, "Maximum number of keys under the prefix (0 means no limit)")
+	return cmd
+}
+
+func newQuotaGetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <prefix>",
+		Short: "Gets the quota of a key prefix and its usage",
+		Run:   quotaGetCommandFunc,
+	}
+}
+
+func newQuotaListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists all quotas",
+		Run:   quotaListCommandFunc,
+	}
+}
+
+// quotaSetCommandFunc executes the "quota set" command.
+func quotaSetCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("quota set command requires prefix as its argument"))
+	}
+	if quotaMaxBytes < 0 || quotaMaxKeys < 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("quota limits must not be negative"))
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).QuotaSet(ctx, args[0], quotaMaxBytes, quotaMaxKeys)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	display.QuotaSet(args[0], quotaMaxBytes, quotaMaxKeys, *resp)
+}
+
+// quotaGetCommandFunc executes the "quota get" command.
+func quotaGetCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("quota get command requires prefix as its argument"))
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).QuotaGet(ctx, args[0])
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	display.QuotaGet(*resp)
+}
+
+// quotaListCommandFunc executes the "quota list" command.
+func quotaListCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("quota list command accepts no arguments"))
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).QuotaList(ctx)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	display.QuotaList(*resp)
+}
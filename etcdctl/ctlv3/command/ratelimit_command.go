@@ -0,0 +1,109 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"etcd/clientv3"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rateLimitBurst int64
+	rateLimitKey   string
+	rateLimitTag   string
+)
+
+// NewRateLimitCommand returns the cobra command for "ratelimit".
+func NewRateLimitCommand() *cobra.Command {
+	rc := &cobra.Command{
+		Use:   "ratelimit <subcommand>",
+		Short: "Request rate limit related commands",
+	}
+
+	rc.AddCommand(newRateLimitSetCommand())
+	rc.AddCommand(newRateLimitListCommand())
+
+	return rc
+}
+
+func newRateLimitSetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <method> <rate>",
+		Short: "Sets the rate limit, in requests per second, of an RPC (\"*\" for the RPCs without their own limit)",
+		Run:   rateLimitSetCommandFunc,
+	}
+	cmd.Flags().Int64Var(&rateLimitBurst, "burst", 0, "Number of requests a client may send at once (0 means the rate)")
+	cmd.Flags().StringVar(&rateLimitKey, "key", "user", "Identifies the clients by 'user', 'ip' or 'tag'")
+	cmd.Flags().StringVar(&rateLimitTag, "tag", "", "Metadata key identifying the clients when the key is 'tag'")
+	return cmd
+}
+
+func newRateLimitListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists all rate limits",
+		Run:   rateLimitListCommandFunc,
+	}
+}
+
+// rateLimitSetCommandFunc executes the "ratelimit set" command.
+func rateLimitSetCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("ratelimit set command requires method and rate as its arguments"))
+	}
+	rate, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil || rate < 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("bad rate %q", args[1]))
+	}
+	if rateLimitBurst < 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("burst must not be negative"))
+	}
+	key, err := clientv3.StrToRateLimitKey(rateLimitKey)
+	if err != nil {
+		ExitWithError(ExitBadArgs, err)
+	}
+
+	l := clientv3.RateLimit{
+		Method: args[0],
+		Key:    key,
+		Tag:    rateLimitTag,
+		Rate:   rate,
+		Burst:  rateLimitBurst,
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Auth.RateLimitSet(ctx, l)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	display.RateLimitSet(args[0], rate, *resp)
+}
+
+// rateLimitListCommandFunc executes the "ratelimit list" command.
+func rateLimitListCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("ratelimit list command accepts no arguments"))
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Auth.RateLimitList(ctx)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	display.RateLimitList(*resp)
+}
@@ -24,6 +24,9 @@ import (
 
 var (
 	grantPermissionPrefix bool
+	grantPermissionDeny   bool
+	roleAddAdmin          bool
+	roleAddAdminPrefix    string
 )
 
 // NewRoleCommand returns the cobra command for "role".
@@ -44,11 +47,16 @@ func NewRoleCommand() *cobra.Command {
 }
 
 func newRoleAddCommand() *cobra.Command {
-	return &cobra.Command{
-		Use:   "add <role name>",
+	cmd := &cobra.Command{
+		Use:   "add [options] <role name>",
 		Short: "Adds a new role",
 		Run:   roleAddCommandFunc,
 	}
+
+	cmd.Flags().BoolVar(&roleAddAdmin, "admin", false, "Add a delegated admin role managing the users and roles within --admin-prefix")
+	cmd.Flags().StringVar(&roleAddAdminPrefix, "admin-prefix", "", "Key prefix managed by a delegated admin role")
+
+	return cmd
 }
 
 func newRoleDeleteCommand() *cobra.Command {
@@ -83,6 +91,7 @@ func newRoleGrantPermissionCommand() *cobra.Command {
 	}
 
 	cmd.Flags().BoolVar(&grantPermissionPrefix, "prefix", false, "grant a prefix permission")
+	cmd.Flags().BoolVar(&grantPermissionDeny, "deny", false, "deny the permission; denied permissions take precedence over granted ones")
 
 	return cmd
 }
@@ -101,7 +110,20 @@ func roleAddCommandFunc(cmd *cobra.Command, args []string) {
 		ExitWithError(ExitBadArgs, fmt.Errorf("role add command requires role name as its argument."))
 	}
 
-	resp, err := mustClientFromCmd(cmd).Auth.RoleAdd(context.TODO(), args[0])
+	if !roleAddAdmin && roleAddAdminPrefix != "" {
+		ExitWithError(ExitBadArgs, fmt.Errorf("--admin-prefix requires --admin"))
+	}
+
+	var (
+		resp *clientv3.AuthRoleAddResponse
+		err  error
+	)
+	if roleAddAdmin {
+		opt := &clientv3.RoleAddOptions{Admin: true, AdminPrefix: []byte(roleAddAdminPrefix)}
+		resp, err = mustClientFromCmd(cmd).Auth.RoleAddWithOptions(context.TODO(), args[0], opt)
+	} else {
+		resp, err = mustClientFromCmd(cmd).Auth.RoleAdd(context.TODO(), args[0])
+	}
 	if err != nil {
 		ExitWithError(ExitError, err)
 	}
@@ -173,7 +195,12 @@ func roleGrantPermissionCommandFunc(cmd *cobra.Command, args []string) {
 		rangeEnd = clientv3.GetPrefixRangeEnd(args[2])
 	}
 
-	resp, err := mustClientFromCmd(cmd).Auth.RoleGrantPermission(context.TODO(), args[0], args[2], rangeEnd, perm)
+	var resp *clientv3.AuthRoleGrantPermissionResponse
+	if grantPermissionDeny {
+		resp, err = mustClientFromCmd(cmd).Auth.RoleDenyPermission(context.TODO(), args[0], args[2], rangeEnd, perm)
+	} else {
+		resp, err = mustClientFromCmd(cmd).Auth.RoleGrantPermission(context.TODO(), args[0], args[2], rangeEnd, perm)
+	}
 	if err != nil {
 		ExitWithError(ExitError, err)
 	}
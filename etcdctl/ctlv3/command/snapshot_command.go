@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"etcd/etcdserver"
 	"etcd/etcdserver/etcdserverpb"
@@ -33,6 +34,7 @@ import (
 	"etcd/lease"
 	"etcd/mvcc"
 	"etcd/mvcc/backend"
+	"etcd/pkg/encryption"
 	"etcd/pkg/fileutil"
 	"etcd/pkg/types"
 	"etcd/raft"
@@ -58,6 +60,13 @@ var (
 	restorePeerURLs     string
 	restoreName         string
 	skipHashCheck       bool
+	restoreKeyFile      string
+	restoreCipher       *encryption.Cipher
+
+	replayWALDir        string
+	replayWALArchiveDir string
+	replayToRevision    int64
+	replayToTime        string
 )
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
@@ -103,6 +112,11 @@ func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd.Flags().StringVar(&restorePeerURLs, "initial-advertise-peer-urls", defaultInitialAdvertisePeerURLs, "List of this member's peer URLs to advertise to the rest of the cluster")
 	cmd.Flags().StringVar(&restoreName, "name", defaultName, "Human-readable name for this member")
 	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
+	cmd.Flags().StringVar(&restoreKeyFile, "encryption-key-file", "", "Path to the key file to read an encrypted snapshot and encrypt the restored data directory with")
+	cmd.Flags().StringVar(&replayWALDir, "replay-wal-dir", "", "Path to a copy of a member wal directory to replay onto the snapshot")
+	cmd.Flags().StringVar(&replayWALArchiveDir, "replay-wal-archive-dir", "", "Path to a member wal archive directory to replay onto the snapshot")
+	cmd.Flags().Int64Var(&replayToRevision, "replay-to-revision", 0, "Stop replaying the wal once the given revision is reached (0 is unlimited)")
+	cmd.Flags().StringVar(&replayToTime, "replay-to-time", "", "Stop replaying the wal at the first request proposed after the given RFC3339 time")
 
 	return cmd
 }
@@ -166,6 +180,14 @@ func snapshotRestoreCommandFunc(cmd *cobra.Command, args []string) {
 		ExitWithError(ExitBadArgs, uerr)
 	}
 
+	if restoreKeyFile != "" {
+		kp, err := encryption.NewFileKeyProvider(restoreKeyFile)
+		if err != nil {
+			ExitWithError(ExitBadArgs, err)
+		}
+		restoreCipher = encryption.NewCipher(kp)
+	}
+
 	cfg := etcdserver.ServerConfig{
 		InitialClusterToken: restoreClusterToken,
 		InitialPeerURLsMap:  urlmap,
@@ -193,6 +215,14 @@ func snapshotRestoreCommandFunc(cmd *cobra.Command, args []string) {
 		ExitWithError(ExitInvalidInput, fmt.Errorf("data-dir %q exists", basedir))
 	}
 
+	replay := replayWALDir != "" || replayWALArchiveDir != ""
+	if !replay && (replayToRevision != 0 || replayToTime != "") {
+		ExitWithError(ExitBadArgs, fmt.Errorf("--replay-to-revision and --replay-to-time require --replay-wal-dir or --replay-wal-archive-dir"))
+	}
+	if replayToRevision != 0 && replayToTime != "" {
+		ExitWithError(ExitBadArgs, fmt.Errorf("--replay-to-revision and --replay-to-time cannot be used together"))
+	}
+
 	makeDB(snapdir, args[0], len(cl.Members()))
 	makeWALAndSnap(waldir, snapdir, cl)
 }
@@ -225,7 +255,7 @@ func makeWALAndSnap(waldir, snapdir string, cl *membership.RaftCluster) {
 		ExitWithError(ExitInvalidInput, merr)
 	}
 
-	w, walerr := wal.Create(waldir, metadata)
+	w, walerr := wal.CreateWithCipher(waldir, metadata, restoreCipher)
 	if walerr != nil {
 		ExitWithError(ExitIO, walerr)
 	}
@@ -285,7 +315,7 @@ func makeWALAndSnap(waldir, snapdir string, cl *membership.RaftCluster) {
 			},
 		},
 	}
-	snapshotter := snap.New(snapdir)
+	snapshotter := snap.NewWithCipher(snapdir, restoreCipher)
 	if err := snapshotter.SaveSnap(raftSnap); err != nil {
 		panic(err)
 	}
@@ -370,9 +400,13 @@ func makeDB(snapdir, dbfile string, commit int) {
 	// db hash is OK, can now modify DB so it can be part of a new cluster
 	db.Close()
 
+	if replayWALDir != "" || replayWALArchiveDir != "" {
+		replayWAL(dbpath)
+	}
+
 	// update consistentIndex so applies go through on etcdserver despite
 	// having a new raft instance
-	be := backend.NewDefaultBackend(dbpath)
+	be := newRestoreBackend(dbpath)
 	// a lessor never timeouts leases
 	lessor := lease.NewLessor(be, math.MaxInt64)
 
@@ -394,6 +428,65 @@ func makeDB(snapdir, dbfile string, commit int) {
 	s.Close()
 }
 
+// newRestoreBackend opens the restored database at dbpath, encrypting the
+// values it writes with the restore key.
+func newRestoreBackend(dbpath string) backend.Backend {
+	bcfg := backend.DefaultBackendConfig()
+	bcfg.Path = dbpath
+	bcfg.Cipher = restoreCipher
+	return backend.NewWithConfig(bcfg)
+}
+
+// replayWAL replays the committed entries of the wal given by the replay
+// flags onto the database at dbpath.
+func replayWAL(dbpath string) {
+	cfg := etcdserver.ReplayConfig{ToRevision: replayToRevision}
+	if replayToTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, replayToTime)
+		if err != nil {
+			ExitWithError(ExitBadArgs, err)
+		}
+		cfg.ToTime = t
+	}
+
+	be := newRestoreBackend(dbpath)
+	defer be.Close()
+
+	// open the wal at the raft index the snapshot was taken at
+	s := mvcc.NewStore(be, &lease.FakeLessor{}, nil)
+	walsnap := walpb.Snapshot{Index: s.ConsistentIndex()}
+	s.Close()
+
+	var (
+		w   *wal.WAL
+		err error
+	)
+	if replayWALArchiveDir != "" {
+		w, err = wal.OpenArchiveWithCipher(replayWALArchiveDir, replayWALDir, walsnap, restoreCipher)
+	} else {
+		w, err = wal.OpenForReadWithCipher(replayWALDir, walsnap, restoreCipher)
+	}
+	if err != nil {
+		ExitWithError(ExitInvalidInput, fmt.Errorf("cannot open wal at index %d (%v)", walsnap.Index, err))
+	}
+	_, st, ents, err := w.ReadAll()
+	w.Close()
+	if err != nil && err != wal.ErrSnapshotNotFound {
+		ExitWithError(ExitInvalidInput, err)
+	}
+
+	// only replay committed entries
+	for len(ents) > 0 && ents[len(ents)-1].Index > st.Commit {
+		ents = ents[:len(ents)-1]
+	}
+
+	res, err := etcdserver.ReplayBackend(be, ents, cfg)
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	fmt.Printf("Replayed wal from index %d to index %d (revision %d)\n", walsnap.Index, res.Index, res.Revision)
+}
+
 type dbstatus struct {
 	Hash      uint32 `json:"hash"`
 	Revision  int64  `json:"revision"`
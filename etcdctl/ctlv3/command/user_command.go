@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 
+	"etcd/clientv3"
 	"github.com/bgentry/speakeasy"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
@@ -47,6 +48,7 @@ func NewUserCommand() *cobra.Command {
 
 var (
 	passwordInteractive bool
+	noPassword          bool
 )
 
 func newUserAddCommand() *cobra.Command {
@@ -57,6 +59,7 @@ func newUserAddCommand() *cobra.Command {
 	}
 
 	cmd.Flags().BoolVar(&passwordInteractive, "interactive", true, "Read password from stdin instead of interactive terminal")
+	cmd.Flags().BoolVar(&noPassword, "no-password", false, "Create a user without password, authenticated by client certificate or token only")
 
 	return &cmd
 }
@@ -127,7 +130,12 @@ func userAddCommandFunc(cmd *cobra.Command, args []string) {
 	var user string
 
 	splitted := strings.SplitN(args[0], ":", 2)
-	if len(splitted) < 2 {
+	if noPassword {
+		if len(splitted) == 2 {
+			ExitWithError(ExitBadArgs, fmt.Errorf("password is not allowed with --no-password."))
+		}
+		user = args[0]
+	} else if len(splitted) < 2 {
 		user = args[0]
 		if !passwordInteractive {
 			fmt.Scanf("%s", &password)
@@ -142,7 +150,8 @@ func userAddCommandFunc(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	resp, err := mustClientFromCmd(cmd).Auth.UserAdd(context.TODO(), user, password)
+	opt := &clientv3.UserAddOptions{NoPassword: noPassword}
+	resp, err := mustClientFromCmd(cmd).Auth.UserAddWithOptions(context.TODO(), user, password, opt)
 	if err != nil {
 		ExitWithError(ExitError, err)
 	}
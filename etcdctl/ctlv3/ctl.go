@@ -58,6 +58,7 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&globalFlags.TLS.KeyFile, "key", "", "identify secure client using this TLS key file")
 	rootCmd.PersistentFlags().StringVar(&globalFlags.TLS.CAFile, "cacert", "", "verify certificates of TLS-enabled secure servers using this CA bundle")
 	rootCmd.PersistentFlags().StringVar(&globalFlags.User, "user", "", "username[:password] for authentication (prompt if password is not supplied)")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.Token, "token", "", "token for authentication issued by an identity provider, like an OIDC ID token (ignored if --user is given)")
 
 	rootCmd.AddCommand(
 		command.NewGetCommand(),
@@ -67,12 +68,15 @@ func init() {
 		command.NewCompactionCommand(),
 		command.NewAlarmCommand(),
 		command.NewDefragCommand(),
+		command.NewQuotaCommand(),
 		command.NewEndpointCommand(),
 		command.NewWatchCommand(),
+		command.NewHistoryCommand(),
 		command.NewVersionCommand(),
 		command.NewLeaseCommand(),
 		command.NewMemberCommand(),
 		command.NewSnapshotCommand(),
+		command.NewEncryptionCommand(),
 		command.NewMakeMirrorCommand(),
 		command.NewMigrateCommand(),
 		command.NewLockCommand(),
@@ -80,6 +84,7 @@ func init() {
 		command.NewAuthCommand(),
 		command.NewUserCommand(),
 		command.NewRoleCommand(),
+		command.NewRateLimitCommand(),
 	)
 }
 
@@ -130,11 +130,15 @@ func newConfig() *config {
 	fs.Var(flags.NewURLsValue(embed.DefaultListenClientURLs), "listen-client-urls", "List of URLs to listen on for client traffic.")
 	fs.UintVar(&cfg.MaxSnapFiles, "max-snapshots", cfg.MaxSnapFiles, "Maximum number of snapshot files to retain (0 is unlimited).")
 	fs.UintVar(&cfg.MaxWalFiles, "max-wals", cfg.MaxWalFiles, "Maximum number of wal files to retain (0 is unlimited).")
+	fs.StringVar(&cfg.WalArchiveDir, "wal-archive-dir", cfg.WalArchiveDir, "Path to the directory wal files exceeding max-wals are archived into instead of being deleted.")
 	fs.StringVar(&cfg.Name, "name", cfg.Name, "Human-readable name for this member.")
 	fs.Uint64Var(&cfg.SnapCount, "snapshot-count", cfg.SnapCount, "Number of committed transactions to trigger a snapshot to disk.")
 	fs.UintVar(&cfg.TickMs, "heartbeat-interval", cfg.TickMs, "Time (in milliseconds) of a heartbeat interval.")
 	fs.UintVar(&cfg.ElectionMs, "election-timeout", cfg.ElectionMs, "Time (in milliseconds) for an election to timeout.")
 	fs.Int64Var(&cfg.QuotaBackendBytes, "quota-backend-bytes", cfg.QuotaBackendBytes, "Raise alarms when backend size exceeds the given quota. 0 means use the default quota.")
+	fs.UintVar(&cfg.BackendBatchIntervalMs, "backend-batch-interval", cfg.BackendBatchIntervalMs, "Maximum time (in milliseconds) before committing the backend transaction. 0 means use the default (100).")
+	fs.IntVar(&cfg.BackendBatchLimit, "backend-batch-limit", cfg.BackendBatchLimit, "Maximum number of operations before committing the backend transaction. 0 means use the default (10000).")
+	fs.Int64Var(&cfg.BackendMmapSize, "backend-mmap-size", cfg.BackendMmapSize, "Initial mmap size (in bytes) of the backend. 0 means use the default (10GB on linux).")
 
 	// clustering
 	fs.Var(flags.NewURLsValue(embed.DefaultInitialAdvertisePeerURLs), "initial-advertise-peer-urls", "List of this member's peer URLs to advertise to the rest of the cluster.")
@@ -155,6 +159,8 @@ func newConfig() *config {
 		plog.Panicf("unexpected error setting up clusterStateFlag: %v", err)
 	}
 	fs.BoolVar(&cfg.StrictReconfigCheck, "strict-reconfig-check", cfg.StrictReconfigCheck, "Reject reconfiguration requests that would cause quorum loss.")
+	fs.StringVar(&cfg.PeerCompression, "peer-compression", cfg.PeerCompression, "Compression used to send raft messages and snapshots to peers that support it ('snappy' or 'gzip'). Empty disables compression.")
+	fs.Int64Var(&cfg.PeerSnapshotSendRateLimit, "peer-snapshot-send-rate-limit", cfg.PeerSnapshotSendRateLimit, "Maximum number of bytes per second sent to each peer when sending database snapshots. 0 means no limit.")
 
 	// proxy
 	fs.Var(cfg.proxy, "proxy", fmt.Sprintf("Valid values include %s", strings.Join(cfg.proxy.Values, ", ")))
@@ -174,6 +180,7 @@ func newConfig() *config {
 	fs.StringVar(&cfg.ClientTLSInfo.KeyFile, "key-file", "", "Path to the client server TLS key file.")
 	fs.BoolVar(&cfg.ClientTLSInfo.ClientCertAuth, "client-cert-auth", false, "Enable client cert authentication.")
 	fs.StringVar(&cfg.ClientTLSInfo.TrustedCAFile, "trusted-ca-file", "", "Path to the client server TLS trusted CA key file.")
+	fs.StringVar(&cfg.ClientCertIdentity, "client-cert-auth-identity", "", "Rule mapping verified client certificates to v3 auth users: 'cn', 'uri' or 'uri:<prefix>'. Empty disables it.")
 	fs.BoolVar(&cfg.ClientAutoTLS, "auto-tls", false, "Client TLS using generated certificates")
 	fs.StringVar(&cfg.PeerTLSInfo.CAFile, "peer-ca-file", "", "DEPRECATED: Path to the peer server TLS CA file.")
 	fs.StringVar(&cfg.PeerTLSInfo.CertFile, "peer-cert-file", "", "Path to the peer server TLS cert file.")
@@ -181,6 +188,28 @@ func newConfig() *config {
 	fs.BoolVar(&cfg.PeerTLSInfo.ClientCertAuth, "peer-client-cert-auth", false, "Enable peer client cert authentication.")
 	fs.StringVar(&cfg.PeerTLSInfo.TrustedCAFile, "peer-trusted-ca-file", "", "Path to the peer server TLS trusted CA file.")
 	fs.BoolVar(&cfg.PeerAutoTLS, "peer-auto-tls", false, "Peer TLS using generated certificates")
+	fs.StringVar(&cfg.EncryptionKeyFile, "encryption-key-file", "", "Path to the file holding the keys used to encrypt the wal, snapshots and backend at rest.")
+	fs.StringVar(&cfg.ValueEncryptionPrefixes, "value-encryption-prefixes", "", "Comma-separated list of the key prefixes whose values are encrypted with per-prefix data keys.")
+	fs.StringVar(&cfg.ValueEncryptionKeyFile, "value-encryption-key-file", "", "Path to the file holding the master keys wrapping the data keys of the encrypted prefixes.")
+	fs.IntVar(&cfg.AuthBcryptCost, "auth-bcrypt-cost", cfg.AuthBcryptCost, "Bcrypt cost of hashing the passwords of v3 auth users.")
+	fs.IntVar(&cfg.AuthPasswordMinLength, "auth-password-min-length", 0, "Minimum length of new v3 auth user passwords.")
+	fs.IntVar(&cfg.AuthLockoutAttempts, "auth-lockout-attempts", 0, "Number of consecutive failed authentications locking a v3 auth user out. 0 disables the lockout.")
+	fs.IntVar(&cfg.AuthLockoutSeconds, "auth-lockout-seconds", cfg.AuthLockoutSeconds, "Time (in seconds) a locked out v3 auth user cannot authenticate with its password.")
+	fs.StringVar(&cfg.AuthOIDCIssuer, "auth-oidc-issuer", "", "Issuer of the OIDC tokens authenticating v3 auth users. Empty disables OIDC tokens.")
+	fs.StringVar(&cfg.AuthOIDCJWKSFile, "auth-oidc-jwks-file", "", "Path to the JSON Web Key Set verifying the signatures of the OIDC tokens.")
+	fs.StringVar(&cfg.AuthOIDCAudience, "auth-oidc-audience", "", "Audience the OIDC tokens must have. Empty accepts any audience.")
+	fs.StringVar(&cfg.AuthOIDCUsernameClaim, "auth-oidc-username-claim", cfg.AuthOIDCUsernameClaim, "Claim of the OIDC tokens holding the v3 auth user name.")
+	fs.StringVar(&cfg.AuthOIDCUsernamePrefix, "auth-oidc-username-prefix", cfg.AuthOIDCUsernamePrefix, "Prefix prepended to the user names of the OIDC tokens.")
+	fs.StringVar(&cfg.AuthOIDCGroupsClaim, "auth-oidc-groups-claim", "", "Claim of the OIDC tokens holding the groups granted as v3 auth roles. Empty grants no roles.")
+	fs.StringVar(&cfg.AuthOIDCGroupsPrefix, "auth-oidc-groups-prefix", cfg.AuthOIDCGroupsPrefix, "Prefix prepended to the groups of the OIDC tokens to get the role names.")
+	fs.BoolVar(&cfg.AuthOIDCAllowRootGroup, "auth-oidc-allow-root-group", false, "Let a group of the OIDC tokens grant the root role.")
+
+	// audit
+	fs.StringVar(&cfg.AuditLogFile, "audit-log-file", "", "Path to the audit log of the v3 mutations and administrative operations. Empty disables auditing.")
+	fs.Int64Var(&cfg.AuditLogMaxSize, "audit-log-max-size", cfg.AuditLogMaxSize, "Size (in megabytes) above which the audit log is rotated.")
+	fs.IntVar(&cfg.AuditLogMaxBackups, "audit-log-max-backups", cfg.AuditLogMaxBackups, "Number of rotated audit logs to keep.")
+	fs.StringVar(&cfg.AuditIncludePrefixes, "audit-include-prefixes", "", "Comma-separated list of the key prefixes whose requests are audited. Empty means all keys.")
+	fs.StringVar(&cfg.AuditExcludePrefixes, "audit-exclude-prefixes", "", "Comma-separated list of the key prefixes whose requests are not audited.")
 
 	// logging
 	fs.BoolVar(&cfg.Debug, "debug", false, "Enable debug-level logging for etcd.")
@@ -194,6 +223,12 @@ func newConfig() *config {
 	fs.BoolVar(&cfg.printVersion, "version", false, "Print the version and exit.")
 
 	fs.IntVar(&cfg.AutoCompactionRetention, "auto-compaction-retention", 0, "Auto compaction retention for mvcc key value store in hour. 0 means disable auto compaction.")
+	fs.IntVar(&cfg.CompactionBatchLimit, "compaction-batch-limit", 0, "Maximum number of revisions compacted per batch. 0 means use the default (10000).")
+	fs.UintVar(&cfg.CompactionSleepIntervalMs, "compaction-sleep-interval", 0, "Time (in milliseconds) to pause between compaction batches. 0 means use the default (100).")
+	fs.UintVar(&cfg.CompactionBatchMaxLatencyMs, "compaction-batch-max-latency", 0, "Time (in milliseconds) after which a compaction batch yields to writes. 0 means no limit.")
+	fs.Float64Var(&cfg.AutoDefragThreshold, "auto-defrag-threshold", 0, "Share of the backend size not in use above which the backend is defragmented. 0 means disable auto defragmentation.")
+	fs.StringVar(&cfg.AutoDefragWindow, "auto-defrag-window", "", "Daily UTC window ('HH:MM-HH:MM') in which auto defragmentation may run. Empty means any time.")
+	fs.StringVar(&cfg.ValueIndexes, "value-indexes", "", "Comma-separated list of 'name=prefix' secondary indexes over the values of the keys with the prefix.")
 
 	// pprof profiler via HTTP
 	fs.BoolVar(&cfg.EnablePprof, "enable-pprof", false, "Enable runtime profiling data via HTTP server. Address is at client URL + \"/debug/pprof/\"")
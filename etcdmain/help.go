@@ -56,10 +56,18 @@ member flags:
 		maximum number of snapshot files to retain (0 is unlimited).
 	--max-wals '` + strconv.Itoa(embed.DefaultMaxWALs) + `'
 		maximum number of wal files to retain (0 is unlimited).
+	--wal-archive-dir ''
+		path to the directory wal files exceeding max-wals are archived into instead of being deleted.
 	--cors ''
 		comma-separated whitelist of origins for CORS (cross-origin resource sharing).
 	--quota-backend-bytes '0'
 		raise alarms when backend size exceeds the given quota (0 defaults to low space quota).
+	--backend-batch-interval '0'
+		maximum time (in milliseconds) before committing the backend transaction (0 defaults to 100).
+	--backend-batch-limit '0'
+		maximum number of operations before committing the backend transaction (0 defaults to 10000).
+	--backend-mmap-size '0'
+		initial mmap size (in bytes) of the backend (0 defaults to 10GB on linux).
 
 clustering flags:
 
@@ -88,6 +96,22 @@ clustering flags:
 		reject reconfiguration requests that would cause quorum loss.
 	--auto-compaction-retention '0'
 		auto compaction retention in hour. 0 means disable auto compaction.
+	--compaction-batch-limit '0'
+		maximum number of revisions compacted per batch (0 defaults to 10000).
+	--compaction-sleep-interval '0'
+		time (in milliseconds) to pause between compaction batches (0 defaults to 100).
+	--compaction-batch-max-latency '0'
+		time (in milliseconds) after which a compaction batch yields to writes. 0 means no limit.
+	--auto-defrag-threshold '0'
+		share of the backend size not in use above which the backend is defragmented. 0 means disable auto defragmentation.
+	--auto-defrag-window ''
+		daily UTC window ('HH:MM-HH:MM') in which auto defragmentation may run. Empty means any time.
+	--value-indexes ''
+		comma-separated list of 'name=prefix' secondary indexes over the values of the keys with the prefix.
+	--peer-compression ''
+		compression used to send raft messages and snapshots to peers that support it ('snappy' or 'gzip'). Empty disables compression.
+	--peer-snapshot-send-rate-limit '0'
+		maximum number of bytes per second sent to each peer when sending database snapshots. 0 means no limit.
 
 proxy flags:
 	"proxy" supports v2 API only.
@@ -118,6 +142,8 @@ security flags:
 		enable client cert authentication.
 	--trusted-ca-file ''
 		path to the client server TLS trusted CA key file.
+	--client-cert-auth-identity ''
+		rule mapping verified client certificates to v3 auth users: 'cn' for the CommonName, 'uri' or 'uri:<prefix>' for a URI SAN. Empty disables it.
 	--auto-tls 'false'
 		client TLS using generated certificates.
 	--peer-ca-file '' [DEPRECATED]
@@ -132,6 +158,49 @@ security flags:
 		path to the peer server TLS trusted CA file.
 	--peer-auto-tls 'false'
 		peer TLS using self-generated certificates if --peer-key-file and --peer-cert-file are not provided.
+	--encryption-key-file ''
+		path to the file holding the keys used to encrypt the wal, snapshots and backend at rest.
+	--value-encryption-prefixes ''
+		comma-separated list of the key prefixes whose values are encrypted with per-prefix data keys.
+	--value-encryption-key-file ''
+		path to the file holding the master keys wrapping the data keys of the encrypted prefixes.
+	--auth-bcrypt-cost 10
+		bcrypt cost of hashing the passwords of v3 auth users.
+	--auth-password-min-length 0
+		minimum length of new v3 auth user passwords.
+	--auth-lockout-attempts 0
+		number of consecutive failed authentications locking a v3 auth user out. 0 disables the lockout.
+	--auth-lockout-seconds 300
+		time (in seconds) a locked out v3 auth user cannot authenticate with its password.
+	--auth-oidc-issuer ''
+		issuer of the OIDC tokens authenticating v3 auth users. Empty disables OIDC tokens.
+	--auth-oidc-jwks-file ''
+		path to the JSON Web Key Set verifying the signatures of the OIDC tokens.
+	--auth-oidc-audience ''
+		audience the OIDC tokens must have. Empty accepts any audience.
+	--auth-oidc-username-claim 'sub'
+		claim of the OIDC tokens holding the v3 auth user name.
+	--auth-oidc-username-prefix 'oidc:'
+		prefix prepended to the user names of the OIDC tokens.
+	--auth-oidc-groups-claim ''
+		claim of the OIDC tokens holding the groups granted as v3 auth roles. Empty grants no roles.
+	--auth-oidc-groups-prefix 'oidc:'
+		prefix prepended to the groups of the OIDC tokens to get the role names.
+	--auth-oidc-allow-root-group 'false'
+		let a group of the OIDC tokens grant the root role.
+
+audit flags:
+
+	--audit-log-file ''
+		path to the audit log of the v3 mutations and administrative operations. Empty disables auditing.
+	--audit-log-max-size 100
+		size (in megabytes) above which the audit log is rotated.
+	--audit-log-max-backups 5
+		number of rotated audit logs to keep.
+	--audit-include-prefixes ''
+		comma-separated list of the key prefixes whose requests are audited. Empty means all keys.
+	--audit-exclude-prefixes ''
+		comma-separated list of the key prefixes whose requests are not audited.
 
 logging flags
 
@@ -0,0 +1,201 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"etcd/audit"
+	"etcd/etcdserver"
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/pkg/keyutil"
+	"etcd/pkg/types"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// auditedMethods are the RPCs changing the state of the cluster or
+// exporting its data; reads are not audited.
+var auditedMethods = map[string]bool{
+	"/etcdserverpb.KV/Put":                     true,
+	"/etcdserverpb.KV/DeleteRange":             true,
+	"/etcdserverpb.KV/Txn":                     true,
+	"/etcdserverpb.KV/Compact":                 true,
+	"/etcdserverpb.Lease/LeaseGrant":           true,
+	"/etcdserverpb.Lease/LeaseRevoke":          true,
+	"/etcdserverpb.Cluster/MemberAdd":          true,
+	"/etcdserverpb.Cluster/MemberRemove":       true,
+	"/etcdserverpb.Cluster/MemberUpdate":       true,
+	"/etcdserverpb.Maintenance/Alarm":          true,
+	"/etcdserverpb.Maintenance/Defragment":     true,
+	"/etcdserverpb.Maintenance/Snapshot":       true,
+	"/etcdserverpb.Maintenance/ValueKeyRotate": true,
+	"/etcdserverpb.Auth/AuthEnable":            true,
+	"/etcdserverpb.Auth/AuthDisable":           true,
+	"/etcdserverpb.Auth/Authenticate":          true,
+	"/etcdserverpb.Auth/UserAdd":               true,
+	"/etcdserverpb.Auth/UserDelete":            true,
+	"/etcdserverpb.Auth/UserChangePassword":    true,
+	"/etcdserverpb.Auth/UserGrantRole":         true,
+	"/etcdserverpb.Auth/UserRevokeRole":        true,
+	"/etcdserverpb.Auth/RoleAdd":               true,
+	"/etcdserverpb.Auth/RoleDelete":            true,
+	"/etcdserverpb.Auth/RoleGrantPermission":   true,
+	"/etcdserverpb.Auth/RoleRevokePermission":  true,
+	"/etcdserverpb.Auth/RateLimitSet":          true,
+	"/etcdserverpb.Auth/TokenRevoke":           true,
+	"/etcdserverpb.Auth/AuthExport":            true,
+	"/etcdserverpb.Auth/AuthImport":            true,
+	"/etcdserverpb.Quota/QuotaSet":             true,
+}
+
+// auditLogger returns the audit logger of s if fullMethod is audited.
+func auditLogger(s *etcdserver.EtcdServer, fullMethod string) *audit.Logger {
+	if s.Cfg.Audit == nil || !auditedMethods[fullMethod] {
+		return nil
+	}
+	return s.Cfg.Audit
+}
+
+// newAuditRecord describes the request req to fullMethod, served with
+// resp and err.
+func newAuditRecord(s *etcdserver.EtcdServer, ctx context.Context, fullMethod string, req, resp interface{}, err error) *audit.Record {
+	r := &audit.Record{
+		Time:    time.Now().UTC(),
+		Member:  s.ID().String(),
+		Method:  fullMethod,
+		Outcome: audit.OutcomeSuccess,
+	}
+	if ai, aerr := s.AuthStore().AuthInfoFromCtx(ctx); aerr == nil && ai != nil {
+		r.User = ai.Username
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.Source = p.Addr.String()
+	}
+	if err != nil {
+		r.Outcome = audit.OutcomeFailure
+		r.Error = grpc.ErrorDesc(err)
+	}
+	if h, ok := resp.(interface {
+		GetHeader() *pb.ResponseHeader
+	}); ok && h.GetHeader() != nil {
+		r.Revision = h.GetHeader().Revision
+	}
+
+	switch v := req.(type) {
+	case *pb.PutRequest:
+		r.Ranges = []audit.Range{{Key: string(v.Key)}}
+	case *pb.DeleteRangeRequest:
+		r.Ranges = []audit.Range{{Key: string(v.Key), RangeEnd: string(v.RangeEnd)}}
+	case *pb.TxnRequest:
+		tresp, _ := resp.(*pb.TxnResponse)
+		r.Ranges = txnAuditRanges(v, tresp)
+	case *pb.LeaseGrantRequest:
+		r.Lease = v.ID
+		if lresp, ok := resp.(*pb.LeaseGrantResponse); ok && lresp != nil {
+			r.Lease = lresp.ID
+		}
+	case *pb.LeaseRevokeRequest:
+		r.Lease = v.ID
+	case *pb.MemberAddRequest:
+		r.Name = strings.Join(v.PeerURLs, ",")
+		if mresp, ok := resp.(*pb.MemberAddResponse); ok && mresp != nil && mresp.Member != nil {
+			r.TargetMember = types.ID(mresp.Member.ID).String()
+		}
+	case *pb.MemberRemoveRequest:
+		r.TargetMember = types.ID(v.ID).String()
+	case *pb.MemberUpdateRequest:
+		r.TargetMember = types.ID(v.ID).String()
+		r.Name = strings.Join(v.PeerURLs, ",")
+	case *pb.AlarmRequest:
+		r.Name = v.Action.String() + " " + v.Alarm.String()
+		if v.MemberID != 0 {
+			r.TargetMember = types.ID(v.MemberID).String()
+		}
+	case *pb.AuthenticateRequest:
+		r.Name = v.Name
+	case *pb.AuthUserAddRequest:
+		r.Name = v.Name
+	case *pb.AuthUserDeleteRequest:
+		r.Name = v.Name
+	case *pb.AuthUserChangePasswordRequest:
+		r.Name = v.Name
+	case *pb.AuthUserGrantRoleRequest:
+		r.Name, r.Role = v.User, v.Role
+	case *pb.AuthUserRevokeRoleRequest:
+		r.Name, r.Role = v.Name, v.Role
+	case *pb.AuthRoleAddRequest:
+		r.Name = v.Name
+	case *pb.AuthRoleDeleteRequest:
+		r.Name = v.Role
+	case *pb.AuthRoleGrantPermissionRequest:
+		r.Name = v.Name
+		if v.Perm != nil {
+			r.Permission = &audit.Range{Key: string(v.Perm.Key), RangeEnd: string(v.Perm.RangeEnd)}
+		}
+	case *pb.AuthRoleRevokePermissionRequest:
+		r.Name = v.Role
+		r.Permission = &audit.Range{Key: v.Key, RangeEnd: v.RangeEnd}
+	case *pb.AuthRateLimitSetRequest:
+		if v.Limit != nil {
+			r.Name = v.Limit.Method
+		}
+	case *pb.AuthTokenRevokeRequest:
+		r.Name = strconv.FormatUint(v.ID, 10)
+	case *pb.QuotaSetRequest:
+		if v.Quota != nil {
+			r.Ranges = []audit.Range{{Key: string(v.Quota.Prefix), RangeEnd: string(keyutil.PrefixEnd(v.Quota.Prefix))}}
+		}
+	case *pb.ValueKeyRotateRequest:
+		r.Ranges = []audit.Range{{Key: string(v.Prefix), RangeEnd: string(keyutil.PrefixEnd(v.Prefix))}}
+	}
+	return r
+}
+
+// txnAuditRanges returns the ranges of the operations of the branch of a
+// txn taken, or of both branches if the txn failed.
+func txnAuditRanges(r *pb.TxnRequest, resp *pb.TxnResponse) (ranges []audit.Range) {
+	branches := [][]*pb.RequestOp{r.Success, r.Failure}
+	if resp != nil {
+		if resp.Succeeded {
+			branches = branches[:1]
+		} else {
+			branches = branches[1:]
+		}
+	}
+	for _, ops := range branches {
+		for _, op := range ops {
+			switch tv := op.Request.(type) {
+			case *pb.RequestOp_RequestRange:
+				if tv.RequestRange != nil {
+					ranges = append(ranges, audit.Range{Key: string(tv.RequestRange.Key), RangeEnd: string(tv.RequestRange.RangeEnd)})
+				}
+			case *pb.RequestOp_RequestPut:
+				if tv.RequestPut != nil {
+					ranges = append(ranges, audit.Range{Key: string(tv.RequestPut.Key)})
+				}
+			case *pb.RequestOp_RequestDeleteRange:
+				if tv.RequestDeleteRange != nil {
+					ranges = append(ranges, audit.Range{Key: string(tv.RequestDeleteRange.Key), RangeEnd: string(tv.RequestDeleteRange.RangeEnd)})
+				}
+			}
+		}
+	}
+	return ranges
+}
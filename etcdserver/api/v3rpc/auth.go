@@ -84,6 +84,54 @@ func (as *AuthServer) RoleList(ctx context.Context, r *pb.AuthRoleListRequest) (
 	return resp, nil
 }
 
+func (as *AuthServer) RateLimitSet(ctx context.Context, r *pb.AuthRateLimitSetRequest) (*pb.AuthRateLimitSetResponse, error) {
+	resp, err := as.authenticator.RateLimitSet(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (as *AuthServer) RateLimitList(ctx context.Context, r *pb.AuthRateLimitListRequest) (*pb.AuthRateLimitListResponse, error) {
+	resp, err := as.authenticator.RateLimitList(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (as *AuthServer) TokenList(ctx context.Context, r *pb.AuthTokenListRequest) (*pb.AuthTokenListResponse, error) {
+	resp, err := as.authenticator.TokenList(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (as *AuthServer) TokenRevoke(ctx context.Context, r *pb.AuthTokenRevokeRequest) (*pb.AuthTokenRevokeResponse, error) {
+	resp, err := as.authenticator.TokenRevoke(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (as *AuthServer) AuthExport(ctx context.Context, r *pb.AuthExportRequest) (*pb.AuthExportResponse, error) {
+	resp, err := as.authenticator.AuthExport(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (as *AuthServer) AuthImport(ctx context.Context, r *pb.AuthImportRequest) (*pb.AuthImportResponse, error) {
+	resp, err := as.authenticator.AuthImport(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
 func (as *AuthServer) RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error) {
 	resp, err := as.authenticator.RoleRevokePermission(ctx, r)
 	if err != nil {
@@ -39,12 +39,16 @@ type streamsMap struct {
 	streams map[grpc.ServerStream]struct{}
 }
 
-func newUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
+func newUnaryInterceptor(s *etcdserver.EtcdServer, rl *rateLimiter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		if !api.IsCapabilityEnabled(api.V3rpcCapability) {
 			return nil, rpctypes.ErrGRPCNotCapable
 		}
 
+		if al := auditLogger(s, info.FullMethod); al != nil {
+			defer func() { al.Log(newAuditRecord(s, ctx, info.FullMethod, req, resp, err)) }()
+		}
+
 		md, ok := metadata.FromContext(ctx)
 		if ok {
 			if ks := md[rpctypes.MetadataRequireLeaderKey]; len(ks) > 0 && ks[0] == rpctypes.MetadataHasLeader {
@@ -54,18 +58,31 @@ func newUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
 			}
 		}
 
+		if err := rl.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
 		return prometheus.UnaryServerInterceptor(ctx, req, info, handler)
 	}
 }
 
-func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor {
+func newStreamInterceptor(s *etcdserver.EtcdServer, rl *rateLimiter) grpc.StreamServerInterceptor {
 	smap := monitorLeader(s)
 
-	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
 		if !api.IsCapabilityEnabled(api.V3rpcCapability) {
 			return rpctypes.ErrGRPCNotCapable
 		}
 
+		if al := auditLogger(s, info.FullMethod); al != nil {
+			ctx := ss.Context()
+			defer func() { al.Log(newAuditRecord(s, ctx, info.FullMethod, nil, nil, err)) }()
+		}
+
+		if err := rl.allow(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
 		md, ok := metadata.FromContext(ss.Context())
 		if ok {
 			if ks := md[rpctypes.MetadataRequireLeaderKey]; len(ks) > 0 && ks[0] == rpctypes.MetadataHasLeader {
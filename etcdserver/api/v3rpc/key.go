@@ -59,6 +59,23 @@ func (s *kvServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResp
 	return resp, nil
 }
 
+func (s *kvServer) History(ctx context.Context, r *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	if len(r.Key) == 0 {
+		return nil, rpctypes.ErrGRPCEmptyKey
+	}
+
+	resp, err := s.kv.History(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+
+	if resp.Header == nil {
+		plog.Panic("unexpected nil resp.Header")
+	}
+	s.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 func (s *kvServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	if err := checkPutRequest(r); err != nil {
 		return nil, err
@@ -134,7 +151,7 @@ func checkPutRequest(r *pb.PutRequest) error {
 	if len(r.Key) == 0 {
 		return rpctypes.ErrGRPCEmptyKey
 	}
-	return nil
+	return checkLeaseID(r.Lease)
 }
 
 func checkDeleteRequest(r *pb.DeleteRangeRequest) error {
@@ -17,7 +17,9 @@ package v3rpc
 import (
 	"io"
 
+	"etcd/defragger"
 	"etcd/etcdserver"
+	"etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "etcd/etcdserver/etcdserverpb"
 	"etcd/lease"
 	"golang.org/x/net/context"
@@ -33,6 +35,9 @@ func NewLeaseServer(s *etcdserver.EtcdServer) pb.LeaseServer {
 }
 
 func (ls *LeaseServer) LeaseGrant(ctx context.Context, cr *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	if err := checkLeaseID(cr.ID); err != nil {
+		return nil, err
+	}
 	resp, err := ls.le.LeaseGrant(ctx, cr)
 
 	if err != nil {
@@ -43,6 +48,9 @@ func (ls *LeaseServer) LeaseGrant(ctx context.Context, cr *pb.LeaseGrantRequest)
 }
 
 func (ls *LeaseServer) LeaseRevoke(ctx context.Context, rr *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	if err := checkLeaseID(rr.ID); err != nil {
+		return nil, err
+	}
 	resp, err := ls.le.LeaseRevoke(ctx, rr)
 	if err != nil {
 		return nil, togRPCError(err)
@@ -60,6 +68,15 @@ func (ls *LeaseServer) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLi
 	return resp, nil
 }
 
+func (ls *LeaseServer) LeaseTimeToLives(ctx context.Context, rr *pb.LeaseTimeToLivesRequest) (*pb.LeaseTimeToLivesResponse, error) {
+	resp, err := ls.le.LeaseTimeToLives(ctx, rr)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	ls.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 func (ls *LeaseServer) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error {
 	for {
 		req, err := stream.Recv()
@@ -69,6 +86,9 @@ func (ls *LeaseServer) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) erro
 		if err != nil {
 			return err
 		}
+		if err = checkLeaseID(req.ID); err != nil {
+			return err
+		}
 
 		// Create header before we sent out the renew request.
 		// This can make sure that the revision is strictly smaller or equal to
@@ -96,3 +116,11 @@ func (ls *LeaseServer) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) erro
 		}
 	}
 }
+
+// checkLeaseID rejects the ids of leases reserved by etcdserver.
+func checkLeaseID(id int64) error {
+	if lease.LeaseID(id) == defragger.LockLeaseID {
+		return rpctypes.ErrGRPCLeaseReserved
+	}
+	return nil
+}
@@ -50,16 +50,21 @@ type AuthGetter interface {
 	AuthStore() auth.AuthStore
 }
 
+type ValueKeyRotator interface {
+	ValueKeyRotate(ctx context.Context, r *pb.ValueKeyRotateRequest) (*pb.ValueKeyRotateResponse, error)
+}
+
 type maintenanceServer struct {
 	rg  RaftStatusGetter
 	kg  KVGetter
 	bg  BackendGetter
 	a   Alarmer
+	vr  ValueKeyRotator
 	hdr header
 }
 
 func NewMaintenanceServer(s *etcdserver.EtcdServer) pb.MaintenanceServer {
-	srv := &maintenanceServer{rg: s, kg: s, bg: s, a: s, hdr: newHeader(s)}
+	srv := &maintenanceServer{rg: s, kg: s, bg: s, a: s, vr: s, hdr: newHeader(s)}
 	return &authMaintenanceServer{srv, s}
 }
 
@@ -135,17 +140,33 @@ func (ms *maintenanceServer) Alarm(ctx context.Context, ar *pb.AlarmRequest) (*p
 
 func (ms *maintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (*pb.StatusResponse, error) {
 	resp := &pb.StatusResponse{
-		Header:    &pb.ResponseHeader{Revision: ms.hdr.rev()},
-		Version:   version.Version,
-		DbSize:    ms.bg.Backend().Size(),
-		Leader:    uint64(ms.rg.Leader()),
-		RaftIndex: ms.rg.Index(),
-		RaftTerm:  ms.rg.Term(),
+		Header:      &pb.ResponseHeader{Revision: ms.hdr.rev()},
+		Version:     version.Version,
+		DbSize:      ms.bg.Backend().Size(),
+		DbSizeInUse: ms.bg.Backend().SizeInUse(),
+		Leader:      uint64(ms.rg.Leader()),
+		RaftIndex:   ms.rg.Index(),
+		RaftTerm:    ms.rg.Term(),
+	}
+	if ds := ms.bg.Backend().DefragStatus(); ds.InProgress {
+		resp.Defrag = &pb.DefragStatus{
+			CopiedKeys:   ds.CopiedKeys,
+			TotalKeys:    ds.TotalKeys,
+			LoggedWrites: ds.LoggedWrites,
+		}
 	}
 	ms.hdr.fill(resp.Header)
 	return resp, nil
 }
 
+func (ms *maintenanceServer) ValueKeyRotate(ctx context.Context, r *pb.ValueKeyRotateRequest) (*pb.ValueKeyRotateResponse, error) {
+	resp, err := ms.vr.ValueKeyRotate(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
 type authMaintenanceServer struct {
 	*maintenanceServer
 	ag AuthGetter
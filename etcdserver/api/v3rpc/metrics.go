@@ -30,9 +30,22 @@ var (
 		Name:      "client_grpc_received_bytes_total",
 		Help:      "The total number of bytes received from grpc clients.",
 	})
+
+	throttledRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "throttled_requests_total",
+		Help:      "The total number of client requests rejected by the rate limits.",
+	},
+		// client is the throttled client, as "user:<name>" or "tag:<value>";
+		// clients limited by ip, and the clients past maxThrottledClients,
+		// are only labeled with the kind of their limit.
+		[]string{"method", "client"},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(sentBytes)
 	prometheus.MustRegister(receivedBytes)
+	prometheus.MustRegister(throttledRequests)
 }
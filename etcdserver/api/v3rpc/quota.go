@@ -87,3 +87,35 @@ func NewQuotaLeaseServer(s *etcdserver.EtcdServer) pb.LeaseServer {
 		quotaAlarmer{etcdserver.NewBackendQuota(s), s, s.ID()},
 	}
 }
+
+type QuotaServer struct {
+	qm etcdserver.QuotaManager
+}
+
+func NewQuotaServer(s *etcdserver.EtcdServer) *QuotaServer {
+	return &QuotaServer{qm: s}
+}
+
+func (qs *QuotaServer) QuotaSet(ctx context.Context, r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error) {
+	resp, err := qs.qm.QuotaSet(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (qs *QuotaServer) QuotaGet(ctx context.Context, r *pb.QuotaGetRequest) (*pb.QuotaGetResponse, error) {
+	resp, err := qs.qm.QuotaGet(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (qs *QuotaServer) QuotaList(ctx context.Context, r *pb.QuotaListRequest) (*pb.QuotaListResponse, error) {
+	resp, err := qs.qm.QuotaList(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
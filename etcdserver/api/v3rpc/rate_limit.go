@@ -0,0 +1,185 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"etcd/auth/authpb"
+	"etcd/etcdserver"
+	"etcd/etcdserver/api/v3rpc/rpctypes"
+	"etcd/pkg/logutil"
+
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	purgeRateLimitInterval = time.Minute
+
+	// maxThrottledClients bounds the number of clients labeling the
+	// throttled requests metric.
+	maxThrottledClients = 1000
+)
+
+// mlog merges the repeated warnings of the throttled clients.
+var mlog = logutil.NewMergeLogger(plog)
+
+type rateLimitKey struct {
+	method string
+	client string
+}
+
+type rateLimitBucket struct {
+	limit authpb.RateLimit
+	l     *rate.Limiter
+	used  time.Time
+}
+
+// rateLimiter throttles the requests of each client with a token bucket,
+// following the rate limits of the auth store. Each member enforces the
+// limits on its own.
+type rateLimiter struct {
+	s *etcdserver.EtcdServer
+
+	mu      sync.Mutex
+	buckets map[rateLimitKey]*rateLimitBucket
+	// throttled is the set of clients labeling the throttled requests metric.
+	throttled map[string]struct{}
+}
+
+func newRateLimiter(s *etcdserver.EtcdServer) *rateLimiter {
+	rl := &rateLimiter{
+		s:         s,
+		buckets:   make(map[rateLimitKey]*rateLimitBucket),
+		throttled: make(map[string]struct{}),
+	}
+
+	go func() {
+		for {
+			select {
+			case <-s.StopNotify():
+				return
+			case <-time.After(purgeRateLimitInterval):
+				rl.purge(time.Now())
+			}
+		}
+	}()
+
+	return rl
+}
+
+// allow returns ErrGRPCRateLimited if the client of ctx exceeds the rate
+// limit of the RPC fullMethod.
+func (rl *rateLimiter) allow(ctx context.Context, fullMethod string) error {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	l := rl.s.AuthStore().RateLimit(method)
+	if l == nil {
+		return nil
+	}
+	kind, id := rateLimitClient(rl.s, ctx, l)
+	client := kind + ":" + id
+
+	now := time.Now()
+	// the RPCs without their own limit share the bucket of the "*" limit
+	k := rateLimitKey{method: l.Method, client: client}
+	rl.mu.Lock()
+	b, ok := rl.buckets[k]
+	if !ok || b.limit.Rate != l.Rate || b.limit.Burst != l.Burst {
+		b = &rateLimitBucket{limit: *l, l: rate.NewLimiter(rate.Limit(l.Rate), int(l.Burst))}
+		rl.buckets[k] = b
+	}
+	b.used = now
+	allowed := b.l.AllowN(now, 1)
+	var label string
+	if !allowed {
+		label = rl.throttledLabel(kind, client)
+	}
+	rl.mu.Unlock()
+
+	if !allowed {
+		throttledRequests.WithLabelValues(method, label).Inc()
+		mlog.MergeWarningf("throttled %s requests of client %s", method, client)
+		return rpctypes.ErrGRPCRateLimited
+	}
+	return nil
+}
+
+// throttledLabel returns the client label of the throttled requests metric
+// for a client of the given kind. The users and tags, usually tenants, are
+// told apart up to maxThrottledClients of them; IPs are not. It must be
+// called holding rl.mu.
+func (rl *rateLimiter) throttledLabel(kind, client string) string {
+	if kind == "ip" {
+		return kind
+	}
+	if _, ok := rl.throttled[client]; !ok {
+		if len(rl.throttled) >= maxThrottledClients {
+			return kind
+		}
+		rl.throttled[client] = struct{}{}
+	}
+	return client
+}
+
+// purge drops the buckets refilled since their last use; they are
+// equivalent to new buckets.
+func (rl *rateLimiter) purge(now time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	for k, b := range rl.buckets {
+		refill := time.Duration(b.limit.Burst) * time.Second / time.Duration(b.limit.Rate)
+		if now.Sub(b.used) > refill {
+			delete(rl.buckets, k)
+		}
+	}
+}
+
+// rateLimitClient identifies the client of ctx by the key of the limit,
+// falling back to its IP. It returns the kind of the identity, "user",
+// "ip" or "tag", and the identity.
+func rateLimitClient(s *etcdserver.EtcdServer, ctx context.Context, l *authpb.RateLimit) (kind, id string) {
+	switch l.Key {
+	case authpb.USER:
+		if ai, err := s.AuthStore().AuthInfoFromCtx(ctx); err == nil && ai != nil {
+			return "user", ai.Username
+		}
+	case authpb.TAG:
+		if md, ok := metadata.FromContext(ctx); ok {
+			if vs := md[l.Tag]; len(vs) > 0 {
+				return "tag", vs[0]
+			}
+		}
+	}
+	return "ip", clientIP(ctx)
+}
+
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		// unix sockets have no port
+		return p.Addr.String()
+	}
+	return host
+}
@@ -28,8 +28,20 @@ var (
 	ErrGRPCFutureRev    = grpc.Errorf(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision")
 	ErrGRPCNoSpace      = grpc.Errorf(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded")
 
+	ErrGRPCValueIndexNotFound = grpc.Errorf(codes.InvalidArgument, "etcdserver: mvcc: value index not found")
+	ErrGRPCValueIndexRev      = grpc.Errorf(codes.InvalidArgument, "etcdserver: mvcc: value index only serves the latest revision")
+
+	ErrGRPCQuotaExceeded = grpc.Errorf(codes.ResourceExhausted, "etcdserver: prefix quota exceeded")
+	ErrGRPCQuotaNotFound = grpc.Errorf(codes.NotFound, "etcdserver: quota not found")
+	ErrGRPCEmptyPrefix   = grpc.Errorf(codes.InvalidArgument, "etcdserver: quota prefix is not provided")
+
+	ErrGRPCNotEncrypted          = grpc.Errorf(codes.InvalidArgument, "etcdserver: prefix is not encrypted")
+	ErrGRPCEncryptedValueCompare = grpc.Errorf(codes.InvalidArgument, "etcdserver: cannot compare encrypted values")
+	ErrGRPCValueDecrypt          = grpc.Errorf(codes.FailedPrecondition, "etcdserver: cannot decrypt value")
+
 	ErrGRPCLeaseNotFound = grpc.Errorf(codes.NotFound, "etcdserver: requested lease not found")
 	ErrGRPCLeaseExist    = grpc.Errorf(codes.FailedPrecondition, "etcdserver: lease already exists")
+	ErrGRPCLeaseReserved = grpc.Errorf(codes.InvalidArgument, "etcdserver: lease id is reserved")
 
 	ErrGRPCMemberExist            = grpc.Errorf(codes.FailedPrecondition, "etcdserver: member ID already exist")
 	ErrGRPCPeerURLExist           = grpc.Errorf(codes.FailedPrecondition, "etcdserver: Peer URLs already exists")
@@ -39,6 +51,7 @@ var (
 
 	ErrGRPCRequestTooLarge        = grpc.Errorf(codes.InvalidArgument, "etcdserver: request is too large")
 	ErrGRPCRequestTooManyRequests = grpc.Errorf(codes.ResourceExhausted, "etcdserver: too many requests")
+	ErrGRPCRateLimited            = grpc.Errorf(codes.ResourceExhausted, "etcdserver: request rate limit exceeded")
 
 	ErrGRPCRootUserNotExist     = grpc.Errorf(codes.FailedPrecondition, "etcdserver: root user does not exist")
 	ErrGRPCRootRoleNotExist     = grpc.Errorf(codes.FailedPrecondition, "etcdserver: root user does not have root role")
@@ -53,6 +66,13 @@ var (
 	ErrGRPCPermissionNotGranted = grpc.Errorf(codes.FailedPrecondition, "etcdserver: permission is not granted to the role")
 	ErrGRPCAuthNotEnabled       = grpc.Errorf(codes.FailedPrecondition, "etcdserver: authentication is not enabled")
 	ErrGRPCInvalidAuthToken     = grpc.Errorf(codes.Unauthenticated, "etcdserver: invalid auth token")
+	ErrGRPCRateLimitNoMethod    = grpc.Errorf(codes.InvalidArgument, "etcdserver: rate limit method is empty")
+	ErrGRPCRateLimitNoTag       = grpc.Errorf(codes.InvalidArgument, "etcdserver: rate limit tag is empty")
+	ErrGRPCNoPasswordUser       = grpc.Errorf(codes.FailedPrecondition, "etcdserver: user has no password")
+	ErrGRPCPasswordTooShort     = grpc.Errorf(codes.InvalidArgument, "etcdserver: password is too short")
+	ErrGRPCUserLockedOut        = grpc.Errorf(codes.FailedPrecondition, "etcdserver: user is locked out after too many failed authentications")
+	ErrGRPCTokenNotFound        = grpc.Errorf(codes.FailedPrecondition, "etcdserver: auth token not found")
+	ErrGRPCInvalidAuthImport    = grpc.Errorf(codes.InvalidArgument, "etcdserver: invalid auth import")
 
 	ErrGRPCNoLeader                   = grpc.Errorf(codes.Unavailable, "etcdserver: no leader")
 	ErrGRPCNotCapable                 = grpc.Errorf(codes.Unavailable, "etcdserver: not capable")
@@ -70,8 +90,20 @@ var (
 		grpc.ErrorDesc(ErrGRPCFutureRev):    ErrGRPCFutureRev,
 		grpc.ErrorDesc(ErrGRPCNoSpace):      ErrGRPCNoSpace,
 
+		grpc.ErrorDesc(ErrGRPCValueIndexNotFound): ErrGRPCValueIndexNotFound,
+		grpc.ErrorDesc(ErrGRPCValueIndexRev):      ErrGRPCValueIndexRev,
+
+		grpc.ErrorDesc(ErrGRPCQuotaExceeded): ErrGRPCQuotaExceeded,
+		grpc.ErrorDesc(ErrGRPCQuotaNotFound): ErrGRPCQuotaNotFound,
+		grpc.ErrorDesc(ErrGRPCEmptyPrefix):   ErrGRPCEmptyPrefix,
+
+		grpc.ErrorDesc(ErrGRPCNotEncrypted):          ErrGRPCNotEncrypted,
+		grpc.ErrorDesc(ErrGRPCEncryptedValueCompare): ErrGRPCEncryptedValueCompare,
+		grpc.ErrorDesc(ErrGRPCValueDecrypt):          ErrGRPCValueDecrypt,
+
 		grpc.ErrorDesc(ErrGRPCLeaseNotFound): ErrGRPCLeaseNotFound,
 		grpc.ErrorDesc(ErrGRPCLeaseExist):    ErrGRPCLeaseExist,
+		grpc.ErrorDesc(ErrGRPCLeaseReserved): ErrGRPCLeaseReserved,
 
 		grpc.ErrorDesc(ErrGRPCMemberExist):            ErrGRPCMemberExist,
 		grpc.ErrorDesc(ErrGRPCPeerURLExist):           ErrGRPCPeerURLExist,
@@ -81,6 +113,7 @@ var (
 
 		grpc.ErrorDesc(ErrGRPCRequestTooLarge):        ErrGRPCRequestTooLarge,
 		grpc.ErrorDesc(ErrGRPCRequestTooManyRequests): ErrGRPCRequestTooManyRequests,
+		grpc.ErrorDesc(ErrGRPCRateLimited):            ErrGRPCRateLimited,
 
 		grpc.ErrorDesc(ErrGRPCRootUserNotExist):     ErrGRPCRootUserNotExist,
 		grpc.ErrorDesc(ErrGRPCRootRoleNotExist):     ErrGRPCRootRoleNotExist,
@@ -95,6 +128,13 @@ var (
 		grpc.ErrorDesc(ErrGRPCPermissionNotGranted): ErrGRPCPermissionNotGranted,
 		grpc.ErrorDesc(ErrGRPCAuthNotEnabled):       ErrGRPCAuthNotEnabled,
 		grpc.ErrorDesc(ErrGRPCInvalidAuthToken):     ErrGRPCInvalidAuthToken,
+		grpc.ErrorDesc(ErrGRPCRateLimitNoMethod):    ErrGRPCRateLimitNoMethod,
+		grpc.ErrorDesc(ErrGRPCRateLimitNoTag):       ErrGRPCRateLimitNoTag,
+		grpc.ErrorDesc(ErrGRPCNoPasswordUser):       ErrGRPCNoPasswordUser,
+		grpc.ErrorDesc(ErrGRPCPasswordTooShort):     ErrGRPCPasswordTooShort,
+		grpc.ErrorDesc(ErrGRPCUserLockedOut):        ErrGRPCUserLockedOut,
+		grpc.ErrorDesc(ErrGRPCTokenNotFound):        ErrGRPCTokenNotFound,
+		grpc.ErrorDesc(ErrGRPCInvalidAuthImport):    ErrGRPCInvalidAuthImport,
 
 		grpc.ErrorDesc(ErrGRPCNoLeader):                   ErrGRPCNoLeader,
 		grpc.ErrorDesc(ErrGRPCNotCapable):                 ErrGRPCNotCapable,
@@ -113,8 +153,20 @@ var (
 	ErrFutureRev    = Error(ErrGRPCFutureRev)
 	ErrNoSpace      = Error(ErrGRPCNoSpace)
 
+	ErrValueIndexNotFound = Error(ErrGRPCValueIndexNotFound)
+	ErrValueIndexRev      = Error(ErrGRPCValueIndexRev)
+
+	ErrQuotaExceeded = Error(ErrGRPCQuotaExceeded)
+	ErrQuotaNotFound = Error(ErrGRPCQuotaNotFound)
+	ErrEmptyPrefix   = Error(ErrGRPCEmptyPrefix)
+
+	ErrNotEncrypted          = Error(ErrGRPCNotEncrypted)
+	ErrEncryptedValueCompare = Error(ErrGRPCEncryptedValueCompare)
+	ErrValueDecrypt          = Error(ErrGRPCValueDecrypt)
+
 	ErrLeaseNotFound = Error(ErrGRPCLeaseNotFound)
 	ErrLeaseExist    = Error(ErrGRPCLeaseExist)
+	ErrLeaseReserved = Error(ErrGRPCLeaseReserved)
 
 	ErrMemberExist            = Error(ErrGRPCMemberExist)
 	ErrPeerURLExist           = Error(ErrGRPCPeerURLExist)
@@ -124,6 +176,7 @@ var (
 
 	ErrRequestTooLarge = Error(ErrGRPCRequestTooLarge)
 	ErrTooManyRequests = Error(ErrGRPCRequestTooManyRequests)
+	ErrRateLimited     = Error(ErrGRPCRateLimited)
 
 	ErrRootUserNotExist     = Error(ErrGRPCRootUserNotExist)
 	ErrRootRoleNotExist     = Error(ErrGRPCRootRoleNotExist)
@@ -138,6 +191,13 @@ var (
 	ErrPermissionNotGranted = Error(ErrGRPCPermissionNotGranted)
 	ErrAuthNotEnabled       = Error(ErrGRPCAuthNotEnabled)
 	ErrInvalidAuthToken     = Error(ErrGRPCInvalidAuthToken)
+	ErrRateLimitNoMethod    = Error(ErrGRPCRateLimitNoMethod)
+	ErrRateLimitNoTag       = Error(ErrGRPCRateLimitNoTag)
+	ErrNoPasswordUser       = Error(ErrGRPCNoPasswordUser)
+	ErrPasswordTooShort     = Error(ErrGRPCPasswordTooShort)
+	ErrUserLockedOut        = Error(ErrGRPCUserLockedOut)
+	ErrTokenNotFound        = Error(ErrGRPCTokenNotFound)
+	ErrInvalidAuthImport    = Error(ErrGRPCInvalidAuthImport)
 
 	ErrNoLeader                   = Error(ErrGRPCNoLeader)
 	ErrNotCapable                 = Error(ErrGRPCNotCapable)
@@ -16,11 +16,14 @@ package v3rpc
 
 import (
 	"etcd/auth"
+	"etcd/envelope"
 	"etcd/etcdserver"
 	"etcd/etcdserver/api/v3rpc/rpctypes"
 	"etcd/etcdserver/membership"
 	"etcd/lease"
 	"etcd/mvcc"
+	"etcd/pkg/encryption"
+	"etcd/quota"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 )
@@ -42,8 +45,24 @@ func togRPCError(err error) error {
 		return rpctypes.ErrGRPCCompacted
 	case mvcc.ErrFutureRev:
 		return rpctypes.ErrGRPCFutureRev
+	case mvcc.ErrValueIndexNotFound:
+		return rpctypes.ErrGRPCValueIndexNotFound
+	case mvcc.ErrValueIndexRev:
+		return rpctypes.ErrGRPCValueIndexRev
 	case lease.ErrLeaseNotFound:
 		return rpctypes.ErrGRPCLeaseNotFound
+	case quota.ErrQuotaExceeded:
+		return rpctypes.ErrGRPCQuotaExceeded
+	case quota.ErrQuotaNotFound:
+		return rpctypes.ErrGRPCQuotaNotFound
+	case quota.ErrEmptyPrefix:
+		return rpctypes.ErrGRPCEmptyPrefix
+	case envelope.ErrNotEncrypted:
+		return rpctypes.ErrGRPCNotEncrypted
+	case envelope.ErrValueCompare:
+		return rpctypes.ErrGRPCEncryptedValueCompare
+	case envelope.ErrNoMasterKey, encryption.ErrKeyNotFound, encryption.ErrDecrypt:
+		return rpctypes.ErrGRPCValueDecrypt
 	case etcdserver.ErrRequestTooLarge:
 		return rpctypes.ErrGRPCRequestTooLarge
 	case etcdserver.ErrNoSpace:
@@ -95,6 +114,20 @@ func togRPCError(err error) error {
 		return rpctypes.ErrGRPCAuthNotEnabled
 	case auth.ErrInvalidAuthToken:
 		return rpctypes.ErrGRPCInvalidAuthToken
+	case auth.ErrRateLimitNoMethod:
+		return rpctypes.ErrGRPCRateLimitNoMethod
+	case auth.ErrRateLimitNoTag:
+		return rpctypes.ErrGRPCRateLimitNoTag
+	case auth.ErrNoPasswordUser:
+		return rpctypes.ErrGRPCNoPasswordUser
+	case auth.ErrPasswordTooShort:
+		return rpctypes.ErrGRPCPasswordTooShort
+	case auth.ErrUserLockedOut:
+		return rpctypes.ErrGRPCUserLockedOut
+	case auth.ErrTokenNotFound:
+		return rpctypes.ErrGRPCTokenNotFound
+	case auth.ErrInvalidAuthImport:
+		return rpctypes.ErrGRPCInvalidAuthImport
 	default:
 		return grpc.Errorf(codes.Unknown, err.Error())
 	}
@@ -20,7 +20,9 @@ import (
 	"time"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 
+	"etcd/auth"
 	"etcd/etcdserver"
 	"etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "etcd/etcdserver/etcdserverpb"
@@ -33,6 +35,15 @@ type watchServer struct {
 	memberID  int64
 	raftTimer etcdserver.RaftTimer
 	watchable mvcc.WatchableKV
+	ag        AuthGetter
+	vd        ValueDecrypter
+}
+
+// ValueDecrypter decrypts the values of the encrypted key prefixes.
+type ValueDecrypter interface {
+	// DecryptKV returns kv with its value decrypted, or kv itself if its
+	// value is not encrypted.
+	DecryptKV(kv *mvccpb.KeyValue) (*mvccpb.KeyValue, error)
 }
 
 func NewWatchServer(s *etcdserver.EtcdServer) pb.WatchServer {
@@ -41,6 +52,8 @@ func NewWatchServer(s *etcdserver.EtcdServer) pb.WatchServer {
 		memberID:  int64(s.ID()),
 		raftTimer: s,
 		watchable: s.Watchable(),
+		ag:        s,
+		vd:        s,
 	}
 }
 
@@ -83,6 +96,8 @@ type serverWatchStream struct {
 	raftTimer etcdserver.RaftTimer
 
 	watchable mvcc.WatchableKV
+	ag        AuthGetter
+	vd        ValueDecrypter
 
 	gRPCStream  pb.Watch_WatchServer
 	watchStream mvcc.WatchStream
@@ -110,6 +125,8 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		raftTimer: ws.raftTimer,
 
 		watchable: ws.watchable,
+		ag:        ws.ag,
+		vd:        ws.vd,
 
 		gRPCStream:  stream,
 		watchStream: ws.watchable.NewWatchStream(),
@@ -171,6 +188,23 @@ func (sws *serverWatchStream) recvLoop() error {
 				// \x00 is the smallest key
 				creq.Key = []byte{0}
 			}
+			// the permission is checked on the watched key, and on the
+			// range end before ">= key" is normalized to the empty one
+			if err := sws.isWatchPermitted(creq); err != nil {
+				wr := &pb.WatchResponse{
+					Header:       sws.newResponseHeader(sws.watchStream.Rev()),
+					WatchId:      -1,
+					Created:      true,
+					Canceled:     true,
+					CancelReason: grpc.ErrorDesc(togRPCError(err)),
+				}
+				select {
+				case sws.ctrlStream <- wr:
+				case <-sws.closec:
+					return nil
+				}
+				break
+			}
 			if len(creq.RangeEnd) == 1 && creq.RangeEnd[0] == 0 {
 				// support  >= key queries
 				creq.RangeEnd = []byte{}
@@ -229,6 +263,21 @@ func (sws *serverWatchStream) recvLoop() error {
 	}
 }
 
+// isWatchPermitted checks that the user of the stream may watch the range
+// of creq.
+func (sws *serverWatchStream) isWatchPermitted(creq *pb.WatchCreateRequest) error {
+	as := sws.ag.AuthStore()
+	authInfo, err := as.AuthInfoFromCtx(sws.gRPCStream.Context())
+	if err != nil {
+		return err
+	}
+	if authInfo == nil {
+		// IsWatchPermitted expects non-nil AuthInfo; use empty credentials
+		authInfo = &auth.AuthInfo{}
+	}
+	return as.IsWatchPermitted(authInfo, creq.Key, creq.RangeEnd)
+}
+
 func (sws *serverWatchStream) sendLoop() {
 	// watch ids that are currently active
 	ids := make(map[mvcc.WatchID]struct{})
@@ -284,6 +333,20 @@ func (sws *serverWatchStream) sendLoop() {
 				Events:          events,
 				CompactRevision: wresp.CompactRevision,
 			}
+			if err := sws.decryptEvents(events); err != nil {
+				// cancel the watcher rather than send encrypted values
+				sws.watchStream.Cancel(wresp.WatchID)
+				sws.mu.Lock()
+				delete(sws.progress, wresp.WatchID)
+				delete(sws.prevKV, wresp.WatchID)
+				sws.mu.Unlock()
+				wr = &pb.WatchResponse{
+					Header:       sws.newResponseHeader(wresp.Revision),
+					WatchId:      int64(wresp.WatchID),
+					Canceled:     true,
+					CancelReason: grpc.ErrorDesc(togRPCError(err)),
+				}
+			}
 
 			if _, hasId := ids[wresp.WatchID]; !hasId {
 				// buffer if id not yet announced
@@ -360,6 +423,20 @@ func (sws *serverWatchStream) newResponseHeader(rev int64) *pb.ResponseHeader {
 	}
 }
 
+// decryptEvents decrypts the encrypted values of the events.
+func (sws *serverWatchStream) decryptEvents(evs []*mvccpb.Event) error {
+	for _, ev := range evs {
+		var err error
+		if ev.Kv, err = sws.vd.DecryptKV(ev.Kv); err != nil {
+			return err
+		}
+		if ev.PrevKv, err = sws.vd.DecryptKV(ev.PrevKv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func filterNoDelete(e mvccpb.Event) bool {
 	return e.Type == mvccpb.DELETE
 }
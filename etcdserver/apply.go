@@ -20,11 +20,13 @@ import (
 	"sort"
 	"time"
 
+	"etcd/envelope"
 	pb "etcd/etcdserver/etcdserverpb"
 	"etcd/lease"
 	"etcd/mvcc"
 	"etcd/mvcc/mvccpb"
 	"etcd/pkg/types"
+	"etcd/quota"
 	"github.com/gogo/protobuf/proto"
 	"golang.org/x/net/context"
 )
@@ -53,6 +55,7 @@ type applierV3 interface {
 
 	Put(txnID int64, p *pb.PutRequest) (*pb.PutResponse, error)
 	Range(txnID int64, r *pb.RangeRequest) (*pb.RangeResponse, error)
+	History(r *pb.HistoryRequest) (*pb.HistoryResponse, error)
 	DeleteRange(txnID int64, dr *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error)
 	Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error)
 	Compaction(compaction *pb.CompactionRequest) (*pb.CompactionResponse, <-chan struct{}, error)
@@ -62,7 +65,12 @@ type applierV3 interface {
 
 	Alarm(*pb.AlarmRequest) (*pb.AlarmResponse, error)
 
+	QuotaSet(*pb.QuotaSetRequest) (*pb.QuotaSetResponse, error)
+
+	ValueKeyAdd(*pb.InternalValueKeyAddRequest) (*pb.ValueKeyRotateResponse, error)
+
 	Authenticate(r *pb.InternalAuthenticateRequest) (*pb.AuthenticateResponse, error)
+	AuthenticateFailed(r *pb.InternalAuthenticateFailedRequest) error
 
 	AuthEnable() (*pb.AuthEnableResponse, error)
 	AuthDisable() (*pb.AuthDisableResponse, error)
@@ -80,6 +88,12 @@ type applierV3 interface {
 	RoleDelete(ua *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
 	UserList(ua *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error)
 	RoleList(ua *pb.AuthRoleListRequest) (*pb.AuthRoleListResponse, error)
+	RateLimitSet(ua *pb.AuthRateLimitSetRequest) (*pb.AuthRateLimitSetResponse, error)
+	RateLimitList(ua *pb.AuthRateLimitListRequest) (*pb.AuthRateLimitListResponse, error)
+	TokenList(ua *pb.AuthTokenListRequest) (*pb.AuthTokenListResponse, error)
+	TokenRevoke(ua *pb.AuthTokenRevokeRequest) (*pb.AuthTokenRevokeResponse, error)
+	AuthExport(ua *pb.AuthExportRequest) (*pb.AuthExportResponse, error)
+	AuthImport(ua *pb.AuthImportRequest) (*pb.AuthImportResponse, error)
 }
 
 type applierV3backend struct {
@@ -90,6 +104,7 @@ func (s *EtcdServer) newApplierV3() applierV3 {
 	return newAuthApplierV3(
 		s.AuthStore(),
 		newQuotaApplierV3(s, &applierV3backend{s}),
+		s.lessor,
 	)
 }
 
@@ -114,8 +129,14 @@ func (a *applierV3backend) Apply(r *pb.InternalRaftRequest) *applyResult {
 		ar.resp, ar.err = a.s.applyV3.LeaseRevoke(r.LeaseRevoke)
 	case r.Alarm != nil:
 		ar.resp, ar.err = a.s.applyV3.Alarm(r.Alarm)
+	case r.QuotaSet != nil:
+		ar.resp, ar.err = a.s.applyV3.QuotaSet(r.QuotaSet)
+	case r.ValueKeyAdd != nil:
+		ar.resp, ar.err = a.s.applyV3.ValueKeyAdd(r.ValueKeyAdd)
 	case r.Authenticate != nil:
 		ar.resp, ar.err = a.s.applyV3.Authenticate(r.Authenticate)
+	case r.AuthenticateFailed != nil:
+		ar.err = a.s.applyV3.AuthenticateFailed(r.AuthenticateFailed)
 	case r.AuthEnable != nil:
 		ar.resp, ar.err = a.s.applyV3.AuthEnable()
 	case r.AuthDisable != nil:
@@ -146,6 +167,18 @@ func (a *applierV3backend) Apply(r *pb.InternalRaftRequest) *applyResult {
 		ar.resp, ar.err = a.s.applyV3.UserList(r.AuthUserList)
 	case r.AuthRoleList != nil:
 		ar.resp, ar.err = a.s.applyV3.RoleList(r.AuthRoleList)
+	case r.AuthRateLimitSet != nil:
+		ar.resp, ar.err = a.s.applyV3.RateLimitSet(r.AuthRateLimitSet)
+	case r.AuthRateLimitList != nil:
+		ar.resp, ar.err = a.s.applyV3.RateLimitList(r.AuthRateLimitList)
+	case r.AuthTokenList != nil:
+		ar.resp, ar.err = a.s.applyV3.TokenList(r.AuthTokenList)
+	case r.AuthTokenRevoke != nil:
+		ar.resp, ar.err = a.s.applyV3.TokenRevoke(r.AuthTokenRevoke)
+	case r.AuthExport != nil:
+		ar.resp, ar.err = a.s.applyV3.AuthExport(r.AuthExport)
+	case r.AuthImport != nil:
+		ar.resp, ar.err = a.s.applyV3.AuthImport(r.AuthImport)
 	default:
 		panic("not implemented")
 	}
@@ -245,14 +278,32 @@ func (a *applierV3backend) DeleteRange(txnID int64, dr *pb.DeleteRangeRequest) (
 }
 
 func (a *applierV3backend) Range(txnID int64, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	var rv kvRanger = a.s.KV()
+	if txnID != noTxn {
+		rv = &txnRanger{a.s.KV(), txnID}
+	}
+	return a.rangeKV(rv, r)
+}
+
+// kvRanger ranges over the keys of a consistent view of the store.
+type kvRanger interface {
+	Range(key, end []byte, ro mvcc.RangeOptions) (*mvcc.RangeResult, error)
+}
+
+// txnRanger ranges over the keys of an ongoing write txn.
+type txnRanger struct {
+	kv    mvcc.KV
+	txnID int64
+}
+
+func (tr *txnRanger) Range(key, end []byte, ro mvcc.RangeOptions) (*mvcc.RangeResult, error) {
+	return tr.kv.TxnRange(tr.txnID, key, end, ro)
+}
+
+func (a *applierV3backend) rangeKV(rv kvRanger, r *pb.RangeRequest) (*pb.RangeResponse, error) {
 	resp := &pb.RangeResponse{}
 	resp.Header = &pb.ResponseHeader{}
 
-	var (
-		rr  *mvcc.RangeResult
-		err error
-	)
-
 	if isGteRange(r.RangeEnd) {
 		r.RangeEnd = []byte{}
 	}
@@ -270,21 +321,16 @@ func (a *applierV3backend) Range(txnID int64, r *pb.RangeRequest) (*pb.RangeResp
 	}
 
 	ro := mvcc.RangeOptions{
-		Limit: limit,
-		Rev:   r.Revision,
-		Count: r.CountOnly,
+		Limit:      limit,
+		Rev:        r.Revision,
+		Count:      r.CountOnly,
+		ValueIndex: r.Index,
+		Value:      r.IndexValue,
 	}
 
-	if txnID != noTxn {
-		rr, err = a.s.KV().TxnRange(txnID, r.Key, r.RangeEnd, ro)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		rr, err = a.s.KV().Range(r.Key, r.RangeEnd, ro)
-		if err != nil {
-			return nil, err
-		}
+	rr, err := rv.Range(r.Key, r.RangeEnd, ro)
+	if err != nil {
+		return nil, err
 	}
 
 	if r.MaxModRevision != 0 {
@@ -349,10 +395,36 @@ func (a *applierV3backend) Range(txnID int64, r *pb.RangeRequest) (*pb.RangeResp
 	return resp, nil
 }
 
+func (a *applierV3backend) History(r *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	if isGteRange(r.RangeEnd) {
+		r.RangeEnd = []byte{}
+	}
+
+	ho := mvcc.HistoryOptions{
+		MinRev: r.MinRevision,
+		MaxRev: r.MaxRevision,
+		Limit:  r.Limit,
+	}
+	hr, err := a.s.KV().History(r.Key, r.RangeEnd, ho)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.HistoryResponse{Header: &pb.ResponseHeader{Revision: hr.Rev}, More: hr.More}
+	for i := range hr.Events {
+		resp.Events = append(resp.Events, &hr.Events[i])
+	}
+	return resp, nil
+}
+
 func (a *applierV3backend) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if isTxnReadonly(rt) {
+		return a.readTxn(rt)
+	}
+
 	ok := true
 	for _, c := range rt.Compare {
-		if _, ok = a.applyCompare(c); !ok {
+		if _, ok = a.applyCompare(a.s.KV(), c); !ok {
 			break
 		}
 	}
@@ -393,11 +465,49 @@ func (a *applierV3backend) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
 	return txnResp, nil
 }
 
+// readTxn applies a txn made only of ranges over a read txn of the store,
+// so it does not block nor get blocked by the writes.
+func (a *applierV3backend) readTxn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
+	txn := a.s.KV().Read()
+	defer txn.End()
+
+	ok := true
+	for _, c := range rt.Compare {
+		if _, ok = a.applyCompare(txn, c); !ok {
+			break
+		}
+	}
+
+	reqs := rt.Success
+	if !ok {
+		reqs = rt.Failure
+	}
+	if err := a.checkRequestRange(reqs); err != nil {
+		return nil, err
+	}
+
+	resps := make([]*pb.ResponseOp, len(reqs))
+	for i := range reqs {
+		resp, err := a.rangeKV(txn, reqs[i].GetRequestRange())
+		if err != nil {
+			return nil, err
+		}
+		resps[i] = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseRange{ResponseRange: resp}}
+	}
+
+	txnResp := &pb.TxnResponse{}
+	txnResp.Header = &pb.ResponseHeader{}
+	txnResp.Header.Revision = txn.Rev()
+	txnResp.Responses = resps
+	txnResp.Succeeded = ok
+	return txnResp, nil
+}
+
 // applyCompare applies the compare request.
 // It returns the revision at which the comparison happens. If the comparison
 // succeeds, the it returns true. Otherwise it returns false.
-func (a *applierV3backend) applyCompare(c *pb.Compare) (int64, bool) {
-	rr, err := a.s.KV().Range(c.Key, nil, mvcc.RangeOptions{})
+func (a *applierV3backend) applyCompare(rv kvRanger, c *pb.Compare) (int64, bool) {
+	rr, err := rv.Range(c.Key, nil, mvcc.RangeOptions{})
 	rev := rr.Rev
 
 	if err != nil {
@@ -579,6 +689,24 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 	return resp, nil
 }
 
+func (a *applierV3backend) QuotaSet(r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error) {
+	if r.Quota == nil {
+		return nil, quota.ErrEmptyPrefix
+	}
+	if err := a.s.quotaStore.Set(r.Quota); err != nil {
+		return nil, err
+	}
+	return &pb.QuotaSetResponse{Header: newHeader(a.s)}, nil
+}
+
+func (a *applierV3backend) ValueKeyAdd(r *pb.InternalValueKeyAddRequest) (*pb.ValueKeyRotateResponse, error) {
+	if r.Key == nil || len(r.Key.Prefix) == 0 {
+		return nil, envelope.ErrNotEncrypted
+	}
+	id := a.s.valueKeys.Add(r.Key, r.Initial)
+	return &pb.ValueKeyRotateResponse{Header: newHeader(a.s), KeyId: id}, nil
+}
+
 type applierV3Capped struct {
 	applierV3
 	q backendQuota
@@ -618,6 +746,7 @@ func (a *applierV3backend) AuthDisable() (*pb.AuthDisableResponse, error) {
 
 func (a *applierV3backend) Authenticate(r *pb.InternalAuthenticateRequest) (*pb.AuthenticateResponse, error) {
 	ctx := context.WithValue(context.WithValue(context.Background(), "index", a.s.consistIndex.ConsistentIndex()), "simpleToken", r.SimpleToken)
+	ctx = context.WithValue(context.WithValue(ctx, "memberID", r.MemberId), "timestamp", r.Timestamp)
 	resp, err := a.s.AuthStore().Authenticate(ctx, r.Name, r.Password)
 	if resp != nil {
 		resp.Header = newHeader(a.s)
@@ -625,6 +754,10 @@ func (a *applierV3backend) Authenticate(r *pb.InternalAuthenticateRequest) (*pb.
 	return resp, err
 }
 
+func (a *applierV3backend) AuthenticateFailed(r *pb.InternalAuthenticateFailedRequest) error {
+	return a.s.AuthStore().AuthenticateFailed(r)
+}
+
 func (a *applierV3backend) UserAdd(r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse, error) {
 	resp, err := a.s.AuthStore().UserAdd(r)
 	if resp != nil {
@@ -729,27 +862,100 @@ func (a *applierV3backend) RoleList(r *pb.AuthRoleListRequest) (*pb.AuthRoleList
 	return resp, err
 }
 
+func (a *applierV3backend) RateLimitSet(r *pb.AuthRateLimitSetRequest) (*pb.AuthRateLimitSetResponse, error) {
+	resp, err := a.s.AuthStore().RateLimitSet(r)
+	if resp != nil {
+		resp.Header = newHeader(a.s)
+	}
+	return resp, err
+}
+
+func (a *applierV3backend) RateLimitList(r *pb.AuthRateLimitListRequest) (*pb.AuthRateLimitListResponse, error) {
+	resp, err := a.s.AuthStore().RateLimitList(r)
+	if resp != nil {
+		resp.Header = newHeader(a.s)
+	}
+	return resp, err
+}
+
+func (a *applierV3backend) TokenList(r *pb.AuthTokenListRequest) (*pb.AuthTokenListResponse, error) {
+	resp, err := a.s.AuthStore().TokenList(r)
+	if resp != nil {
+		resp.Header = newHeader(a.s)
+	}
+	return resp, err
+}
+
+func (a *applierV3backend) TokenRevoke(r *pb.AuthTokenRevokeRequest) (*pb.AuthTokenRevokeResponse, error) {
+	resp, err := a.s.AuthStore().TokenRevoke(r)
+	if resp != nil {
+		resp.Header = newHeader(a.s)
+	}
+	return resp, err
+}
+
+func (a *applierV3backend) AuthExport(r *pb.AuthExportRequest) (*pb.AuthExportResponse, error) {
+	resp, err := a.s.AuthStore().AuthExport(r)
+	if resp != nil {
+		resp.Header = newHeader(a.s)
+	}
+	return resp, err
+}
+
+func (a *applierV3backend) AuthImport(r *pb.AuthImportRequest) (*pb.AuthImportResponse, error) {
+	resp, err := a.s.AuthStore().AuthImport(r)
+	if resp != nil {
+		resp.Header = newHeader(a.s)
+	}
+	return resp, err
+}
+
 type quotaApplierV3 struct {
 	applierV3
-	q Quota
+	q  Quota
+	pq *prefixQuota
 }
 
 func newQuotaApplierV3(s *EtcdServer, app applierV3) applierV3 {
-	return &quotaApplierV3{app, NewBackendQuota(s)}
+	return &quotaApplierV3{app, NewBackendQuota(s), &prefixQuota{s: s}}
 }
 
+// Put and Txn exceeding a prefix quota are rejected, unlike those exceeding
+// the backend quota which are applied before raising the NOSPACE alarm.
 func (a *quotaApplierV3) Put(txnID int64, p *pb.PutRequest) (*pb.PutResponse, error) {
+	if !a.pq.Available(p) {
+		return nil, quota.ErrQuotaExceeded
+	}
 	ok := a.q.Available(p)
 	resp, err := a.applierV3.Put(txnID, p)
+	if err == nil {
+		a.pq.record()
+	}
 	if err == nil && !ok {
 		err = ErrNoSpace
 	}
 	return resp, err
 }
 
+func (a *quotaApplierV3) DeleteRange(txnID int64, dr *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	// deletes always fit in the prefix quotas; only track the usage
+	a.pq.Available(dr)
+	resp, err := a.applierV3.DeleteRange(txnID, dr)
+	if err == nil {
+		a.pq.record()
+	}
+	return resp, err
+}
+
 func (a *quotaApplierV3) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if !a.pq.Available(rt) {
+		return nil, quota.ErrQuotaExceeded
+	}
 	ok := a.q.Available(rt)
 	resp, err := a.applierV3.Txn(rt)
+	if err == nil {
+		a.pq.record()
+	}
 	if err == nil && !ok {
 		err = ErrNoSpace
 	}
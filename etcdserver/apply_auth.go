@@ -19,21 +19,25 @@ import (
 
 	"etcd/auth"
 	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/lease"
 )
 
 type authApplierV3 struct {
 	applierV3
-	as auth.AuthStore
+	as     auth.AuthStore
+	lessor lease.Lessor
 
 	// mu serializes Apply so that user isn't corrupted and so that
 	// serialized requests don't leak data from TOCTOU errors
 	mu sync.Mutex
 
 	authInfo auth.AuthInfo
+	// internal is true while applying a request issued by etcdserver itself
+	internal bool
 }
 
-func newAuthApplierV3(as auth.AuthStore, base applierV3) *authApplierV3 {
-	return &authApplierV3{applierV3: base, as: as}
+func newAuthApplierV3(as auth.AuthStore, base applierV3, lessor lease.Lessor) *authApplierV3 {
+	return &authApplierV3{applierV3: base, as: as, lessor: lessor}
 }
 
 func (aa *authApplierV3) Apply(r *pb.InternalRaftRequest) *applyResult {
@@ -44,17 +48,27 @@ func (aa *authApplierV3) Apply(r *pb.InternalRaftRequest) *applyResult {
 		// does not have header field
 		aa.authInfo.Username = r.Header.Username
 		aa.authInfo.Revision = r.Header.AuthRevision
+		aa.authInfo.Roles = r.Header.Roles
+		aa.internal = r.Header.Internal
 	}
 	if needAdminPermission(r) {
-		if err := aa.as.IsAdminPermitted(&aa.authInfo); err != nil {
+		err := aa.as.IsAdminPermitted(&aa.authInfo)
+		if err != nil && isDelegatedAdminRequest(r) {
+			err = aa.as.IsDelegatedAdminPermitted(&aa.authInfo, r)
+		}
+		if err != nil {
 			aa.authInfo.Username = ""
 			aa.authInfo.Revision = 0
+			aa.authInfo.Roles = nil
+			aa.internal = false
 			return &applyResult{err: err}
 		}
 	}
 	ret := aa.applierV3.Apply(r)
 	aa.authInfo.Username = ""
 	aa.authInfo.Revision = 0
+	aa.authInfo.Roles = nil
+	aa.internal = false
 	return ret
 }
 
@@ -157,10 +171,39 @@ func (aa *authApplierV3) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
 	return aa.applierV3.Txn(rt)
 }
 
+func (aa *authApplierV3) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	if !aa.internal {
+		if err := aa.checkLeaseKeys(lease.LeaseID(lc.ID)); err != nil {
+			return nil, err
+		}
+	}
+	return aa.applierV3.LeaseRevoke(lc)
+}
+
+// checkLeaseKeys checks that the user may delete the keys attached to a
+// lease, which are deleted with the lease.
+func (aa *authApplierV3) checkLeaseKeys(id lease.LeaseID) error {
+	l := aa.lessor.Lookup(id)
+	if l == nil {
+		return nil
+	}
+	for _, key := range l.Keys() {
+		if err := aa.as.IsDeleteRangePermitted(&aa.authInfo, []byte(key), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func needAdminPermission(r *pb.InternalRaftRequest) bool {
 	switch {
 	case r.AuthEnable != nil:
 		return true
+	case r.QuotaSet != nil:
+		return true
+	case r.ValueKeyAdd != nil:
+		// the first data key of a prefix is added by any write to the prefix
+		return !r.ValueKeyAdd.Initial
 	case r.AuthDisable != nil:
 		return true
 	case r.AuthUserAdd != nil:
@@ -189,6 +232,34 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return true
 	case r.AuthRoleList != nil:
 		return true
+	case r.AuthRateLimitSet != nil:
+		return true
+	case r.AuthRateLimitList != nil:
+		return true
+	case r.AuthTokenList != nil:
+		return true
+	case r.AuthTokenRevoke != nil:
+		return true
+	case r.AuthExport != nil:
+		return true
+	case r.AuthImport != nil:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDelegatedAdminRequest returns true if r is a user or role management
+// request that delegated admin roles may issue.
+func isDelegatedAdminRequest(r *pb.InternalRaftRequest) bool {
+	switch {
+	case r.AuthUserAdd != nil, r.AuthUserDelete != nil, r.AuthUserChangePassword != nil,
+		r.AuthUserGrantRole != nil, r.AuthUserGet != nil, r.AuthUserRevokeRole != nil,
+		r.AuthUserList != nil:
+		return true
+	case r.AuthRoleAdd != nil, r.AuthRoleGrantPermission != nil, r.AuthRoleGet != nil,
+		r.AuthRoleRevokePermission != nil, r.AuthRoleDelete != nil, r.AuthRoleList != nil:
+		return true
 	default:
 		return false
 	}
@@ -23,6 +23,11 @@ import (
 
 	"golang.org/x/net/context"
 
+	"etcd/audit"
+	"etcd/auth"
+	"etcd/defragger"
+	"etcd/mvcc"
+	"etcd/pkg/encryption"
 	"etcd/pkg/netutil"
 	"etcd/pkg/transport"
 	"etcd/pkg/types"
@@ -42,6 +47,7 @@ type ServerConfig struct {
 	SnapCount           uint64
 	MaxSnapFiles        uint
 	MaxWALFiles         uint
+	WALArchiveDir       string
 	InitialPeerURLsMap  types.URLsMap
 	InitialClusterToken string
 	NewCluster          bool
@@ -55,10 +61,60 @@ type ServerConfig struct {
 	AutoCompactionRetention int
 	QuotaBackendBytes       int64
 
+	// BackendBatchInterval is the maximum time before committing the
+	// backend batch tx, and BackendBatchLimit the maximum number of puts.
+	// BackendMmapSize is the initial mmap size of the backend in bytes.
+	// 0 means use the backend defaults.
+	BackendBatchInterval time.Duration
+	BackendBatchLimit    int
+	BackendMmapSize      uint64
+
+	// EncryptionCipher encrypts the WAL, the snapshots and the backend at
+	// rest. nil leaves them in plaintext.
+	EncryptionCipher *encryption.Cipher
+
+	// CompactionBatchLimit is the maximum number of revisions compacted
+	// per batch. CompactionSleepInterval is the pause between the batches
+	// and CompactionBatchMaxLatency the maximum duration of a batch.
+	CompactionBatchLimit      int
+	CompactionSleepInterval   time.Duration
+	CompactionBatchMaxLatency time.Duration
+
+	// ValueIndexes are the secondary value indexes maintained by the store.
+	ValueIndexes []mvcc.ValueIndex
+
+	// ValueEncryptionPrefixes are the key prefixes whose values are
+	// encrypted with data keys wrapped by ValueEncryptionKeys.
+	ValueEncryptionPrefixes [][]byte
+	ValueEncryptionKeys     encryption.KeyProvider
+
+	// AutoDefragThreshold is the fragmentation of the backend above which
+	// it is defragmented within AutoDefragWindow. 0 disables it.
+	AutoDefragThreshold float64
+	AutoDefragWindow    defragger.Window
+
 	StrictReconfigCheck bool
 
+	// PeerSnapshotSendRateLimit is the maximum number of bytes per second
+	// sent to each peer when sending database snapshots. 0 means no limit.
+	PeerSnapshotSendRateLimit int64
+	// PeerCompression is the compression used to send raft messages and
+	// snapshots to the peers that support it. Empty disables compression.
+	PeerCompression string
+
 	// ClientCertAuthEnabled is true when cert has been signed by the client CA.
 	ClientCertAuthEnabled bool
+	// ClientCertIdentity maps the verified client certificates of v3
+	// requests to auth users. nil disables it.
+	ClientCertIdentity *auth.CertIdentity
+	// AuthPasswordPolicy is the policy of the passwords of auth users.
+	AuthPasswordPolicy auth.PasswordPolicy
+	// AuthOIDC verifies the OIDC tokens of auth users; nil disables them.
+	AuthOIDC *auth.OIDC
+
+	// Audit records the mutations and the administrative operations issued
+	// to the member. nil disables auditing.
+	Audit *audit.Logger
 }
 
 // VerifyBootstrap sanity-checks the initial config for bootstrap case
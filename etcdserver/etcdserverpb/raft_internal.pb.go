@@ -25,6 +25,16 @@ type RequestHeader struct {
 	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	// auth_revision is a revision number of auth.authStore. It is not related to mvcc
 	AuthRevision uint64 `protobuf:"varint,3,opt,name=auth_revision,json=authRevision,proto3" json:"auth_revision,omitempty"`
+	// timestamp is the unix time in nanoseconds at which the request was proposed
+	// by the member. It is used to replay the log up to a point in time.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// internal is true if the request is issued by etcdserver itself rather than
+	// by a client, like the revoke of an expired lease; it bypasses the
+	// permission checks.
+	Internal bool `protobuf:"varint,5,opt,name=internal,proto3" json:"internal,omitempty"`
+	// roles are the roles the user holds by its external identity, like the
+	// groups of an OIDC token, in addition to the roles granted in etcd.
+	Roles []string `protobuf:"bytes,6,rep,name=roles" json:"roles,omitempty"`
 }
 
 func (m *RequestHeader) Reset()                    { *m = RequestHeader{} }
@@ -35,33 +45,42 @@ func (*RequestHeader) Descriptor() ([]byte, []int) { return fileDescriptorRaftIn
 // An InternalRaftRequest is the union of all requests which can be
 // sent via raft.
 type InternalRaftRequest struct {
-	Header                   *RequestHeader                   `protobuf:"bytes,100,opt,name=header" json:"header,omitempty"`
-	ID                       uint64                           `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	V2                       *Request                         `protobuf:"bytes,2,opt,name=v2" json:"v2,omitempty"`
-	Range                    *RangeRequest                    `protobuf:"bytes,3,opt,name=range" json:"range,omitempty"`
-	Put                      *PutRequest                      `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
-	DeleteRange              *DeleteRangeRequest              `protobuf:"bytes,5,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
-	Txn                      *TxnRequest                      `protobuf:"bytes,6,opt,name=txn" json:"txn,omitempty"`
-	Compaction               *CompactionRequest               `protobuf:"bytes,7,opt,name=compaction" json:"compaction,omitempty"`
-	LeaseGrant               *LeaseGrantRequest               `protobuf:"bytes,8,opt,name=lease_grant,json=leaseGrant" json:"lease_grant,omitempty"`
-	LeaseRevoke              *LeaseRevokeRequest              `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke" json:"lease_revoke,omitempty"`
-	Alarm                    *AlarmRequest                    `protobuf:"bytes,10,opt,name=alarm" json:"alarm,omitempty"`
-	AuthEnable               *AuthEnableRequest               `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable" json:"auth_enable,omitempty"`
-	AuthDisable              *AuthDisableRequest              `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable" json:"auth_disable,omitempty"`
-	Authenticate             *InternalAuthenticateRequest     `protobuf:"bytes,1012,opt,name=authenticate" json:"authenticate,omitempty"`
-	AuthUserAdd              *AuthUserAddRequest              `protobuf:"bytes,1100,opt,name=auth_user_add,json=authUserAdd" json:"auth_user_add,omitempty"`
-	AuthUserDelete           *AuthUserDeleteRequest           `protobuf:"bytes,1101,opt,name=auth_user_delete,json=authUserDelete" json:"auth_user_delete,omitempty"`
-	AuthUserGet              *AuthUserGetRequest              `protobuf:"bytes,1102,opt,name=auth_user_get,json=authUserGet" json:"auth_user_get,omitempty"`
-	AuthUserChangePassword   *AuthUserChangePasswordRequest   `protobuf:"bytes,1103,opt,name=auth_user_change_password,json=authUserChangePassword" json:"auth_user_change_password,omitempty"`
-	AuthUserGrantRole        *AuthUserGrantRoleRequest        `protobuf:"bytes,1104,opt,name=auth_user_grant_role,json=authUserGrantRole" json:"auth_user_grant_role,omitempty"`
-	AuthUserRevokeRole       *AuthUserRevokeRoleRequest       `protobuf:"bytes,1105,opt,name=auth_user_revoke_role,json=authUserRevokeRole" json:"auth_user_revoke_role,omitempty"`
-	AuthUserList             *AuthUserListRequest             `protobuf:"bytes,1106,opt,name=auth_user_list,json=authUserList" json:"auth_user_list,omitempty"`
-	AuthRoleList             *AuthRoleListRequest             `protobuf:"bytes,1107,opt,name=auth_role_list,json=authRoleList" json:"auth_role_list,omitempty"`
-	AuthRoleAdd              *AuthRoleAddRequest              `protobuf:"bytes,1200,opt,name=auth_role_add,json=authRoleAdd" json:"auth_role_add,omitempty"`
-	AuthRoleDelete           *AuthRoleDeleteRequest           `protobuf:"bytes,1201,opt,name=auth_role_delete,json=authRoleDelete" json:"auth_role_delete,omitempty"`
-	AuthRoleGet              *AuthRoleGetRequest              `protobuf:"bytes,1202,opt,name=auth_role_get,json=authRoleGet" json:"auth_role_get,omitempty"`
-	AuthRoleGrantPermission  *AuthRoleGrantPermissionRequest  `protobuf:"bytes,1203,opt,name=auth_role_grant_permission,json=authRoleGrantPermission" json:"auth_role_grant_permission,omitempty"`
-	AuthRoleRevokePermission *AuthRoleRevokePermissionRequest `protobuf:"bytes,1204,opt,name=auth_role_revoke_permission,json=authRoleRevokePermission" json:"auth_role_revoke_permission,omitempty"`
+	Header                   *RequestHeader                     `protobuf:"bytes,100,opt,name=header" json:"header,omitempty"`
+	ID                       uint64                             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	V2                       *Request                           `protobuf:"bytes,2,opt,name=v2" json:"v2,omitempty"`
+	Range                    *RangeRequest                      `protobuf:"bytes,3,opt,name=range" json:"range,omitempty"`
+	Put                      *PutRequest                        `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
+	DeleteRange              *DeleteRangeRequest                `protobuf:"bytes,5,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	Txn                      *TxnRequest                        `protobuf:"bytes,6,opt,name=txn" json:"txn,omitempty"`
+	Compaction               *CompactionRequest                 `protobuf:"bytes,7,opt,name=compaction" json:"compaction,omitempty"`
+	LeaseGrant               *LeaseGrantRequest                 `protobuf:"bytes,8,opt,name=lease_grant,json=leaseGrant" json:"lease_grant,omitempty"`
+	LeaseRevoke              *LeaseRevokeRequest                `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke" json:"lease_revoke,omitempty"`
+	Alarm                    *AlarmRequest                      `protobuf:"bytes,10,opt,name=alarm" json:"alarm,omitempty"`
+	QuotaSet                 *QuotaSetRequest                   `protobuf:"bytes,11,opt,name=quota_set,json=quotaSet" json:"quota_set,omitempty"`
+	AuthEnable               *AuthEnableRequest                 `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable" json:"auth_enable,omitempty"`
+	AuthDisable              *AuthDisableRequest                `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable" json:"auth_disable,omitempty"`
+	Authenticate             *InternalAuthenticateRequest       `protobuf:"bytes,1012,opt,name=authenticate" json:"authenticate,omitempty"`
+	AuthenticateFailed       *InternalAuthenticateFailedRequest `protobuf:"bytes,1013,opt,name=authenticate_failed,json=authenticateFailed" json:"authenticate_failed,omitempty"`
+	AuthUserAdd              *AuthUserAddRequest                `protobuf:"bytes,1100,opt,name=auth_user_add,json=authUserAdd" json:"auth_user_add,omitempty"`
+	AuthUserDelete           *AuthUserDeleteRequest             `protobuf:"bytes,1101,opt,name=auth_user_delete,json=authUserDelete" json:"auth_user_delete,omitempty"`
+	AuthUserGet              *AuthUserGetRequest                `protobuf:"bytes,1102,opt,name=auth_user_get,json=authUserGet" json:"auth_user_get,omitempty"`
+	AuthUserChangePassword   *AuthUserChangePasswordRequest     `protobuf:"bytes,1103,opt,name=auth_user_change_password,json=authUserChangePassword" json:"auth_user_change_password,omitempty"`
+	AuthUserGrantRole        *AuthUserGrantRoleRequest          `protobuf:"bytes,1104,opt,name=auth_user_grant_role,json=authUserGrantRole" json:"auth_user_grant_role,omitempty"`
+	AuthUserRevokeRole       *AuthUserRevokeRoleRequest         `protobuf:"bytes,1105,opt,name=auth_user_revoke_role,json=authUserRevokeRole" json:"auth_user_revoke_role,omitempty"`
+	AuthUserList             *AuthUserListRequest               `protobuf:"bytes,1106,opt,name=auth_user_list,json=authUserList" json:"auth_user_list,omitempty"`
+	AuthRoleList             *AuthRoleListRequest               `protobuf:"bytes,1107,opt,name=auth_role_list,json=authRoleList" json:"auth_role_list,omitempty"`
+	AuthRoleAdd              *AuthRoleAddRequest                `protobuf:"bytes,1200,opt,name=auth_role_add,json=authRoleAdd" json:"auth_role_add,omitempty"`
+	AuthRoleDelete           *AuthRoleDeleteRequest             `protobuf:"bytes,1201,opt,name=auth_role_delete,json=authRoleDelete" json:"auth_role_delete,omitempty"`
+	AuthRoleGet              *AuthRoleGetRequest                `protobuf:"bytes,1202,opt,name=auth_role_get,json=authRoleGet" json:"auth_role_get,omitempty"`
+	AuthRoleGrantPermission  *AuthRoleGrantPermissionRequest    `protobuf:"bytes,1203,opt,name=auth_role_grant_permission,json=authRoleGrantPermission" json:"auth_role_grant_permission,omitempty"`
+	AuthRoleRevokePermission *AuthRoleRevokePermissionRequest   `protobuf:"bytes,1204,opt,name=auth_role_revoke_permission,json=authRoleRevokePermission" json:"auth_role_revoke_permission,omitempty"`
+	AuthRateLimitSet         *AuthRateLimitSetRequest           `protobuf:"bytes,1300,opt,name=auth_rate_limit_set,json=authRateLimitSet" json:"auth_rate_limit_set,omitempty"`
+	AuthRateLimitList        *AuthRateLimitListRequest          `protobuf:"bytes,1301,opt,name=auth_rate_limit_list,json=authRateLimitList" json:"auth_rate_limit_list,omitempty"`
+	AuthTokenList            *AuthTokenListRequest              `protobuf:"bytes,1400,opt,name=auth_token_list,json=authTokenList" json:"auth_token_list,omitempty"`
+	AuthTokenRevoke          *AuthTokenRevokeRequest            `protobuf:"bytes,1401,opt,name=auth_token_revoke,json=authTokenRevoke" json:"auth_token_revoke,omitempty"`
+	AuthExport               *AuthExportRequest                 `protobuf:"bytes,1500,opt,name=auth_export,json=authExport" json:"auth_export,omitempty"`
+	AuthImport               *AuthImportRequest                 `protobuf:"bytes,1501,opt,name=auth_import,json=authImport" json:"auth_import,omitempty"`
+	ValueKeyAdd              *InternalValueKeyAddRequest        `protobuf:"bytes,1600,opt,name=value_key_add,json=valueKeyAdd" json:"value_key_add,omitempty"`
 }
 
 func (m *InternalRaftRequest) Reset()                    { *m = InternalRaftRequest{} }
@@ -85,6 +104,10 @@ type InternalAuthenticateRequest struct {
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	// simple_token is generated in API layer (etcdserver/v3_server.go)
 	SimpleToken string `protobuf:"bytes,3,opt,name=simple_token,json=simpleToken,proto3" json:"simple_token,omitempty"`
+	// member_id is the ID of the member the user authenticated to.
+	MemberId uint64 `protobuf:"varint,4,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// timestamp is the unix time in seconds of the authentication.
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (m *InternalAuthenticateRequest) Reset()         { *m = InternalAuthenticateRequest{} }
@@ -94,11 +117,68 @@ func (*InternalAuthenticateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptorRaftInternal, []int{3}
 }
 
+// InternalAuthenticateFailedRequest records a failed password authentication
+// of a user. The lockout policy of the member checking the password is part
+// of the request, so that all members lock the user out alike.
+type InternalAuthenticateFailedRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// timestamp is the unix time in seconds of the failed authentication.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// max_failed_attempts is the number of consecutive failed authentications
+	// locking the user out.
+	MaxFailedAttempts int64 `protobuf:"varint,3,opt,name=max_failed_attempts,json=maxFailedAttempts,proto3" json:"max_failed_attempts,omitempty"`
+	// lockout_seconds is how long the user is locked out.
+	LockoutSeconds int64 `protobuf:"varint,4,opt,name=lockout_seconds,json=lockoutSeconds,proto3" json:"lockout_seconds,omitempty"`
+}
+
+func (m *InternalAuthenticateFailedRequest) Reset()         { *m = InternalAuthenticateFailedRequest{} }
+func (m *InternalAuthenticateFailedRequest) String() string { return proto.CompactTextString(m) }
+func (*InternalAuthenticateFailedRequest) ProtoMessage()    {}
+func (*InternalAuthenticateFailedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRaftInternal, []int{4}
+}
+
+// ValueKey is a data key encrypting the values of a key prefix. The key is
+// stored wrapped, that is encrypted with a master key of the members.
+type ValueKey struct {
+	// id identifies the key in the values encrypted with it. It is unique
+	// among the data keys of all the prefixes.
+	Id         uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prefix     []byte `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	WrappedKey []byte `protobuf:"bytes,3,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
+}
+
+func (m *ValueKey) Reset()                    { *m = ValueKey{} }
+func (m *ValueKey) String() string            { return proto.CompactTextString(m) }
+func (*ValueKey) ProtoMessage()               {}
+func (*ValueKey) Descriptor() ([]byte, []int) { return fileDescriptorRaftInternal, []int{5} }
+
+// InternalValueKeyAddRequest adds a data key to an encrypted prefix, which
+// becomes the key new values of the prefix are encrypted with. The key is
+// generated and wrapped by the member proposing the request, so that all
+// members store the same key.
+type InternalValueKeyAddRequest struct {
+	Key *ValueKey `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// initial only adds the key if the prefix has no data key yet. It is
+	// proposed by the member writing the first value of the prefix.
+	Initial bool `protobuf:"varint,2,opt,name=initial,proto3" json:"initial,omitempty"`
+}
+
+func (m *InternalValueKeyAddRequest) Reset()         { *m = InternalValueKeyAddRequest{} }
+func (m *InternalValueKeyAddRequest) String() string { return proto.CompactTextString(m) }
+func (*InternalValueKeyAddRequest) ProtoMessage()    {}
+func (*InternalValueKeyAddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRaftInternal, []int{6}
+}
+
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "etcdserverpb.RequestHeader")
 	proto.RegisterType((*InternalRaftRequest)(nil), "etcdserverpb.InternalRaftRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "etcdserverpb.EmptyResponse")
 	proto.RegisterType((*InternalAuthenticateRequest)(nil), "etcdserverpb.InternalAuthenticateRequest")
+	proto.RegisterType((*InternalAuthenticateFailedRequest)(nil), "etcdserverpb.InternalAuthenticateFailedRequest")
+	proto.RegisterType((*ValueKey)(nil), "etcdserverpb.ValueKey")
+	proto.RegisterType((*InternalValueKeyAddRequest)(nil), "etcdserverpb.InternalValueKeyAddRequest")
 }
 func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -131,6 +211,36 @@ func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRevision))
 	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.Internal {
+		dAtA[i] = 0x28
+		i++
+		if m.Internal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -244,17 +354,27 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n9
 	}
+	if m.QuotaSet != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.QuotaSet.Size()))
+		n10, err := m.QuotaSet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
 	if m.Header != nil {
 		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Header.Size()))
-		n10, err := m.Header.MarshalTo(dAtA[i:])
+		n11, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	if m.AuthEnable != nil {
 		dAtA[i] = 0xc2
@@ -262,11 +382,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3e
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthEnable.Size()))
-		n11, err := m.AuthEnable.MarshalTo(dAtA[i:])
+		n12, err := m.AuthEnable.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	if m.AuthDisable != nil {
 		dAtA[i] = 0x9a
@@ -274,11 +394,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3f
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthDisable.Size()))
-		n12, err := m.AuthDisable.MarshalTo(dAtA[i:])
+		n13, err := m.AuthDisable.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if m.Authenticate != nil {
 		dAtA[i] = 0xa2
@@ -286,11 +406,23 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3f
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Authenticate.Size()))
-		n13, err := m.Authenticate.MarshalTo(dAtA[i:])
+		n14, err := m.Authenticate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
+	}
+	if m.AuthenticateFailed != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3f
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthenticateFailed.Size()))
+		n15, err := m.AuthenticateFailed.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
 	}
 	if m.AuthUserAdd != nil {
 		dAtA[i] = 0xe2
@@ -298,11 +430,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x44
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserAdd.Size()))
-		n14, err := m.AuthUserAdd.MarshalTo(dAtA[i:])
+		n16, err := m.AuthUserAdd.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n16
 	}
 	if m.AuthUserDelete != nil {
 		dAtA[i] = 0xea
@@ -310,11 +442,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x44
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserDelete.Size()))
-		n15, err := m.AuthUserDelete.MarshalTo(dAtA[i:])
+		n17, err := m.AuthUserDelete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	if m.AuthUserGet != nil {
 		dAtA[i] = 0xf2
@@ -322,11 +454,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x44
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserGet.Size()))
-		n16, err := m.AuthUserGet.MarshalTo(dAtA[i:])
+		n18, err := m.AuthUserGet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n18
 	}
 	if m.AuthUserChangePassword != nil {
 		dAtA[i] = 0xfa
@@ -334,11 +466,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x44
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserChangePassword.Size()))
-		n17, err := m.AuthUserChangePassword.MarshalTo(dAtA[i:])
+		n19, err := m.AuthUserChangePassword.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n19
 	}
 	if m.AuthUserGrantRole != nil {
 		dAtA[i] = 0x82
@@ -346,11 +478,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x45
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserGrantRole.Size()))
-		n18, err := m.AuthUserGrantRole.MarshalTo(dAtA[i:])
+		n20, err := m.AuthUserGrantRole.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n20
 	}
 	if m.AuthUserRevokeRole != nil {
 		dAtA[i] = 0x8a
@@ -358,11 +490,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x45
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserRevokeRole.Size()))
-		n19, err := m.AuthUserRevokeRole.MarshalTo(dAtA[i:])
+		n21, err := m.AuthUserRevokeRole.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n21
 	}
 	if m.AuthUserList != nil {
 		dAtA[i] = 0x92
@@ -370,11 +502,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x45
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserList.Size()))
-		n20, err := m.AuthUserList.MarshalTo(dAtA[i:])
+		n22, err := m.AuthUserList.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n22
 	}
 	if m.AuthRoleList != nil {
 		dAtA[i] = 0x9a
@@ -382,11 +514,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x45
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleList.Size()))
-		n21, err := m.AuthRoleList.MarshalTo(dAtA[i:])
+		n23, err := m.AuthRoleList.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n23
 	}
 	if m.AuthRoleAdd != nil {
 		dAtA[i] = 0x82
@@ -394,11 +526,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleAdd.Size()))
-		n22, err := m.AuthRoleAdd.MarshalTo(dAtA[i:])
+		n24, err := m.AuthRoleAdd.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n24
 	}
 	if m.AuthRoleDelete != nil {
 		dAtA[i] = 0x8a
@@ -406,11 +538,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleDelete.Size()))
-		n23, err := m.AuthRoleDelete.MarshalTo(dAtA[i:])
+		n25, err := m.AuthRoleDelete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n25
 	}
 	if m.AuthRoleGet != nil {
 		dAtA[i] = 0x92
@@ -418,11 +550,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleGet.Size()))
-		n24, err := m.AuthRoleGet.MarshalTo(dAtA[i:])
+		n26, err := m.AuthRoleGet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n26
 	}
 	if m.AuthRoleGrantPermission != nil {
 		dAtA[i] = 0x9a
@@ -430,11 +562,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleGrantPermission.Size()))
-		n25, err := m.AuthRoleGrantPermission.MarshalTo(dAtA[i:])
+		n27, err := m.AuthRoleGrantPermission.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n27
 	}
 	if m.AuthRoleRevokePermission != nil {
 		dAtA[i] = 0xa2
@@ -442,11 +574,95 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleRevokePermission.Size()))
-		n26, err := m.AuthRoleRevokePermission.MarshalTo(dAtA[i:])
+		n28, err := m.AuthRoleRevokePermission.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n28
+	}
+	if m.AuthRateLimitSet != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x51
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRateLimitSet.Size()))
+		n29, err := m.AuthRateLimitSet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.AuthRateLimitList != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x51
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRateLimitList.Size()))
+		n30, err := m.AuthRateLimitList.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if m.AuthTokenList != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x57
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthTokenList.Size()))
+		n31, err := m.AuthTokenList.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.AuthTokenRevoke != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x57
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthTokenRevoke.Size()))
+		n32, err := m.AuthTokenRevoke.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.AuthExport != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x5d
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthExport.Size()))
+		n33, err := m.AuthExport.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if m.AuthImport != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x5d
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthImport.Size()))
+		n34, err := m.AuthImport.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if m.ValueKeyAdd != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x64
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.ValueKeyAdd.Size()))
+		n35, err := m.ValueKeyAdd.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
 	return i, nil
 }
@@ -502,6 +718,128 @@ func (m *InternalAuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.SimpleToken)))
 		i += copy(dAtA[i:], m.SimpleToken)
 	}
+	if m.MemberId != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.MemberId))
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func (m *InternalAuthenticateFailedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InternalAuthenticateFailedRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.MaxFailedAttempts != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.MaxFailedAttempts))
+	}
+	if m.LockoutSeconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.LockoutSeconds))
+	}
+	return i, nil
+}
+
+func (m *ValueKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Id))
+	}
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if len(m.WrappedKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(len(m.WrappedKey)))
+		i += copy(dAtA[i:], m.WrappedKey)
+	}
+	return i, nil
+}
+
+func (m *InternalValueKeyAddRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InternalValueKeyAddRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Key != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Key.Size()))
+		n36, err := m.Key.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.Initial {
+		dAtA[i] = 0x10
+		i++
+		if m.Initial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -545,6 +883,18 @@ func (m *RequestHeader) Size() (n int) {
 	if m.AuthRevision != 0 {
 		n += 1 + sovRaftInternal(uint64(m.AuthRevision))
 	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Timestamp))
+	}
+	if m.Internal {
+		n += 2
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovRaftInternal(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -590,6 +940,10 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.Alarm.Size()
 		n += 1 + l + sovRaftInternal(uint64(l))
 	}
+	if m.QuotaSet != nil {
+		l = m.QuotaSet.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -606,6 +960,10 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.Authenticate.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
 	}
+	if m.AuthenticateFailed != nil {
+		l = m.AuthenticateFailed.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
 	if m.AuthUserAdd != nil {
 		l = m.AuthUserAdd.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -658,6 +1016,34 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.AuthRoleRevokePermission.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
 	}
+	if m.AuthRateLimitSet != nil {
+		l = m.AuthRateLimitSet.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthRateLimitList != nil {
+		l = m.AuthRateLimitList.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthTokenList != nil {
+		l = m.AuthTokenList.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthTokenRevoke != nil {
+		l = m.AuthTokenRevoke.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthExport != nil {
+		l = m.AuthExport.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthImport != nil {
+		l = m.AuthImport.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.ValueKeyAdd != nil {
+		l = m.ValueKeyAdd.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
 	return n
 }
 
@@ -682,6 +1068,61 @@ func (m *InternalAuthenticateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRaftInternal(uint64(l))
 	}
+	if m.MemberId != 0 {
+		n += 1 + sovRaftInternal(uint64(m.MemberId))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *InternalAuthenticateFailedRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Timestamp))
+	}
+	if m.MaxFailedAttempts != 0 {
+		n += 1 + sovRaftInternal(uint64(m.MaxFailedAttempts))
+	}
+	if m.LockoutSeconds != 0 {
+		n += 1 + sovRaftInternal(uint64(m.LockoutSeconds))
+	}
+	return n
+}
+
+func (m *ValueKey) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Id))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	l = len(m.WrappedKey)
+	if l > 0 {
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	return n
+}
+
+func (m *InternalValueKeyAddRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.Initial {
+		n += 2
+	}
 	return n
 }
 
@@ -794,10 +1235,78 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftInternal(dAtA[iNdEx:])
-			if err != nil {
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Internal = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if skippy < 0 {
@@ -1160,6 +1669,39 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuotaSet == nil {
+				m.QuotaSet = &QuotaSetRequest{}
+			}
+			if err := m.QuotaSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
@@ -1292,6 +1834,39 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 1013:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticateFailed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthenticateFailed == nil {
+				m.AuthenticateFailed = &InternalAuthenticateFailedRequest{}
+			}
+			if err := m.AuthenticateFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuthUserAdd", wireType)
@@ -1721,111 +2296,11 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRaftInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EmptyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmptyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRaftInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InternalAuthenticateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InternalAuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 1300:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthRateLimitSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftInternal
@@ -1835,26 +2310,30 @@ func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.AuthRateLimitSet == nil {
+				m.AuthRateLimitSet = &AuthRateLimitSetRequest{}
+			}
+			if err := m.AuthRateLimitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 1301:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthRateLimitList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftInternal
@@ -1864,26 +2343,30 @@ func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			if m.AuthRateLimitList == nil {
+				m.AuthRateLimitList = &AuthRateLimitListRequest{}
+			}
+			if err := m.AuthRateLimitList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 1400:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SimpleToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthTokenList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftInternal
@@ -1893,12 +2376,452 @@ func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthTokenList == nil {
+				m.AuthTokenList = &AuthTokenListRequest{}
+			}
+			if err := m.AuthTokenList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1401:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthTokenRevoke", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthTokenRevoke == nil {
+				m.AuthTokenRevoke = &AuthTokenRevokeRequest{}
+			}
+			if err := m.AuthTokenRevoke.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1500:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthExport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthExport == nil {
+				m.AuthExport = &AuthExportRequest{}
+			}
+			if err := m.AuthExport.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1501:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthImport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthImport == nil {
+				m.AuthImport = &AuthImportRequest{}
+			}
+			if err := m.AuthImport.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1600:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueKeyAdd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueKeyAdd == nil {
+				m.ValueKeyAdd = &InternalValueKeyAddRequest{}
+			}
+			if err := m.ValueKeyAdd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmptyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmptyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalAuthenticateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalAuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimpleToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SimpleToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+			}
+			m.MemberId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalAuthenticateFailedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalAuthenticateFailedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalAuthenticateFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
 			if intStringLen < 0 {
 				return ErrInvalidLengthRaftInternal
 			}
@@ -1906,8 +2829,299 @@ func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SimpleToken = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailedAttempts", wireType)
+			}
+			m.MaxFailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailedAttempts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockoutSeconds", wireType)
+			}
+			m.LockoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockoutSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrappedKey = append(m.WrappedKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.WrappedKey == nil {
+				m.WrappedKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalValueKeyAddRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalValueKeyAddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalValueKeyAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &ValueKey{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Initial = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftInternal(dAtA[iNdEx:])
@@ -2037,58 +3251,85 @@ var (
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptorRaftInternal) }
 
 var fileDescriptorRaftInternal = []byte{
-	// 837 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x96, 0xdd, 0x52, 0xd3, 0x40,
-	0x14, 0xc7, 0x69, 0xf9, 0xec, 0xb6, 0x54, 0x5c, 0x40, 0xd7, 0x32, 0x53, 0xa1, 0x8c, 0x8a, 0x5f,
-	0xe8, 0x94, 0x07, 0xd0, 0x4a, 0x19, 0x60, 0x86, 0x61, 0x98, 0x0c, 0xce, 0x38, 0xe3, 0x45, 0x5c,
-	0x9a, 0x43, 0x1b, 0x49, 0x93, 0xb8, 0xd9, 0x56, 0x7c, 0x13, 0x1f, 0xc3, 0xaf, 0x87, 0xe0, 0xc2,
-	0x0f, 0xd4, 0x17, 0x50, 0xbc, 0xf1, 0xca, 0x1b, 0x7d, 0x00, 0x67, 0x3f, 0x92, 0x34, 0x6d, 0xca,
-	0x5d, 0x72, 0xce, 0xff, 0xfc, 0xce, 0xd9, 0xec, 0x7f, 0xbb, 0x45, 0xb3, 0x8c, 0x1e, 0x72, 0xd3,
-	0x76, 0x39, 0x30, 0x97, 0x3a, 0xab, 0x3e, 0xf3, 0xb8, 0x87, 0x0b, 0xc0, 0x1b, 0x56, 0x00, 0xac,
-	0x0b, 0xcc, 0x3f, 0x28, 0xcd, 0x35, 0xbd, 0xa6, 0x27, 0x13, 0xf7, 0xc4, 0x93, 0xd2, 0x94, 0x66,
-	0x62, 0x8d, 0x8e, 0xe4, 0x98, 0xdf, 0x50, 0x8f, 0x95, 0x67, 0x68, 0xda, 0x80, 0x17, 0x1d, 0x08,
-	0xf8, 0x16, 0x50, 0x0b, 0x18, 0x2e, 0xa2, 0xec, 0x76, 0x9d, 0x64, 0x16, 0x33, 0x2b, 0x63, 0x46,
-	0x76, 0xbb, 0x8e, 0x4b, 0x68, 0xaa, 0x13, 0x88, 0x96, 0x6d, 0x20, 0xd9, 0xc5, 0xcc, 0x4a, 0xce,
-	0x88, 0xde, 0xf1, 0x32, 0x9a, 0xa6, 0x1d, 0xde, 0x32, 0x19, 0x74, 0xed, 0xc0, 0xf6, 0x5c, 0x32,
-	0x2a, 0xcb, 0x0a, 0x22, 0x68, 0xe8, 0x58, 0xe5, 0x4f, 0x11, 0xcd, 0x6e, 0xeb, 0xa9, 0x0d, 0x7a,
-	0xc8, 0x75, 0xbb, 0x81, 0x46, 0xd7, 0x50, 0xb6, 0x5b, 0x95, 0x2d, 0xf2, 0xd5, 0xf9, 0xd5, 0xde,
-	0x75, 0xad, 0xea, 0x12, 0x23, 0xdb, 0xad, 0xe2, 0xfb, 0x68, 0x9c, 0x51, 0xb7, 0x09, 0xb2, 0x57,
-	0xbe, 0x5a, 0xea, 0x53, 0x8a, 0x54, 0x28, 0x57, 0x42, 0x7c, 0x0b, 0x8d, 0xfa, 0x1d, 0x4e, 0xc6,
-	0xa4, 0x9e, 0x24, 0xf5, 0x7b, 0x9d, 0x70, 0x1e, 0x43, 0x88, 0xf0, 0x3a, 0x2a, 0x58, 0xe0, 0x00,
-	0x07, 0x53, 0x35, 0x19, 0x97, 0x45, 0x8b, 0xc9, 0xa2, 0xba, 0x54, 0x24, 0x5a, 0xe5, 0xad, 0x38,
-	0x26, 0x1a, 0xf2, 0x63, 0x97, 0x4c, 0xa4, 0x35, 0xdc, 0x3f, 0x76, 0xa3, 0x86, 0xfc, 0xd8, 0xc5,
-	0x0f, 0x10, 0x6a, 0x78, 0x6d, 0x9f, 0x36, 0xb8, 0xf8, 0x7e, 0x93, 0xb2, 0xe4, 0x6a, 0xb2, 0x64,
-	0x3d, 0xca, 0x87, 0x95, 0x3d, 0x25, 0xf8, 0x21, 0xca, 0x3b, 0x40, 0x03, 0x30, 0x9b, 0x8c, 0xba,
-	0x9c, 0x4c, 0xa5, 0x11, 0x76, 0x84, 0x60, 0x53, 0xe4, 0x23, 0x82, 0x13, 0x85, 0xc4, 0x9a, 0x15,
-	0x81, 0x41, 0xd7, 0x3b, 0x02, 0x92, 0x4b, 0x5b, 0xb3, 0x44, 0x18, 0x52, 0x10, 0xad, 0xd9, 0x89,
-	0x63, 0x62, 0x5b, 0xa8, 0x43, 0x59, 0x9b, 0xa0, 0xb4, 0x6d, 0xa9, 0x89, 0x54, 0xb4, 0x2d, 0x52,
-	0x88, 0xd7, 0xd0, 0x44, 0x4b, 0x5a, 0x8e, 0x58, 0xb2, 0x64, 0x21, 0x75, 0xcf, 0x95, 0x2b, 0x0d,
-	0x2d, 0xc5, 0x35, 0x94, 0x97, 0x8e, 0x03, 0x97, 0x1e, 0x38, 0x40, 0x7e, 0xa7, 0x7e, 0xb0, 0x5a,
-	0x87, 0xb7, 0x36, 0xa4, 0x20, 0x5a, 0x2e, 0x8d, 0x42, 0xb8, 0x8e, 0xa4, 0x3f, 0x4d, 0xcb, 0x0e,
-	0x24, 0xe3, 0xef, 0x64, 0xda, 0x7a, 0x05, 0xa3, 0xae, 0x14, 0xd1, 0x7a, 0x69, 0x1c, 0xc3, 0xbb,
-	0x8a, 0x02, 0x2e, 0xb7, 0x1b, 0x94, 0x03, 0xf9, 0xa7, 0x28, 0x37, 0x93, 0x94, 0xd0, 0xf7, 0xb5,
-	0x1e, 0x69, 0x88, 0x4b, 0xd4, 0xe3, 0x0d, 0x7d, 0x94, 0xc4, 0xd9, 0x32, 0xa9, 0x65, 0x91, 0x8f,
-	0x53, 0xc3, 0xc6, 0x7a, 0x1c, 0x00, 0xab, 0x59, 0x56, 0x62, 0x2c, 0x1d, 0xc3, 0xbb, 0x68, 0x26,
-	0xc6, 0x28, 0x4f, 0x92, 0x4f, 0x8a, 0xb4, 0x9c, 0x4e, 0xd2, 0x66, 0xd6, 0xb0, 0x22, 0x4d, 0x84,
-	0x93, 0x63, 0x35, 0x81, 0x93, 0xcf, 0xe7, 0x8e, 0xb5, 0x09, 0x7c, 0x60, 0xac, 0x4d, 0xe0, 0xb8,
-	0x89, 0xae, 0xc4, 0x98, 0x46, 0x4b, 0x9c, 0x12, 0xd3, 0xa7, 0x41, 0xf0, 0xd2, 0x63, 0x16, 0xf9,
-	0xa2, 0x90, 0xb7, 0xd3, 0x91, 0xeb, 0x52, 0xbd, 0xa7, 0xc5, 0x21, 0xfd, 0x12, 0x4d, 0x4d, 0xe3,
-	0x27, 0x68, 0xae, 0x67, 0x5e, 0x61, 0x6f, 0x93, 0x79, 0x0e, 0x90, 0x53, 0xd5, 0xe3, 0xfa, 0x90,
-	0xb1, 0xe5, 0xd1, 0xf0, 0xe2, 0xad, 0xbe, 0x48, 0xfb, 0x33, 0xf8, 0x29, 0x9a, 0x8f, 0xc9, 0xea,
-	0xa4, 0x28, 0xf4, 0x57, 0x85, 0xbe, 0x91, 0x8e, 0xd6, 0x47, 0xa6, 0x87, 0x8d, 0xe9, 0x40, 0x0a,
-	0x6f, 0xa1, 0x62, 0x0c, 0x77, 0xec, 0x80, 0x93, 0x6f, 0x8a, 0xba, 0x94, 0x4e, 0xdd, 0xb1, 0x03,
-	0x9e, 0xf0, 0x51, 0x18, 0x8c, 0x48, 0x62, 0x34, 0x45, 0xfa, 0x3e, 0x94, 0x24, 0x5a, 0x0f, 0x90,
-	0xc2, 0x60, 0xb4, 0xf5, 0x92, 0x24, 0x1c, 0xf9, 0x26, 0x37, 0x6c, 0xeb, 0x45, 0x4d, 0xbf, 0x23,
-	0x75, 0x2c, 0x72, 0xa4, 0xc4, 0x68, 0x47, 0xbe, 0xcd, 0x0d, 0x73, 0xa4, 0xa8, 0x4a, 0x71, 0x64,
-	0x1c, 0x4e, 0x8e, 0x25, 0x1c, 0xf9, 0xee, 0xdc, 0xb1, 0xfa, 0x1d, 0xa9, 0x63, 0xf8, 0x39, 0x2a,
-	0xf5, 0x60, 0xa4, 0x51, 0x7c, 0x60, 0x6d, 0x3b, 0x90, 0xf7, 0xd8, 0x7b, 0xc5, 0xbc, 0x33, 0x84,
-	0x29, 0xe4, 0x7b, 0x91, 0x3a, 0xe4, 0x5f, 0xa6, 0xe9, 0x79, 0xdc, 0x46, 0x0b, 0x71, 0x2f, 0x6d,
-	0x9d, 0x9e, 0x66, 0x1f, 0x54, 0xb3, 0xbb, 0xe9, 0xcd, 0x94, 0x4b, 0x06, 0xbb, 0x11, 0x3a, 0x44,
-	0x50, 0xb9, 0x80, 0xa6, 0x37, 0xda, 0x3e, 0x7f, 0x65, 0x40, 0xe0, 0x7b, 0x6e, 0x00, 0x15, 0x1f,
-	0x2d, 0x9c, 0xf3, 0x43, 0x84, 0x31, 0x1a, 0x93, 0xb7, 0x7b, 0x46, 0xde, 0xee, 0xf2, 0x59, 0xdc,
-	0xfa, 0xd1, 0xf9, 0xd4, 0xb7, 0x7e, 0xf8, 0x8e, 0x97, 0x50, 0x21, 0xb0, 0xdb, 0xbe, 0x03, 0x26,
-	0xf7, 0x8e, 0x40, 0x5d, 0xfa, 0x39, 0x23, 0xaf, 0x62, 0xfb, 0x22, 0xf4, 0x68, 0xee, 0xe4, 0x67,
-	0x79, 0xe4, 0xe4, 0xac, 0x9c, 0x39, 0x3d, 0x2b, 0x67, 0x7e, 0x9c, 0x95, 0x33, 0xaf, 0x7f, 0x95,
-	0x47, 0x0e, 0x26, 0xe4, 0x5f, 0x8e, 0xb5, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xff, 0xc9, 0xfc,
-	0x0e, 0xca, 0x08, 0x00, 0x00,
+	// 1268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x97, 0xcb, 0x73, 0x1b, 0xc5,
+	0x13, 0xc7, 0xb3, 0x92, 0xed, 0x48, 0x23, 0xc9, 0x8e, 0xc7, 0x8e, 0x7f, 0xf3, 0x93, 0xc1, 0x91,
+	0x15, 0x42, 0xc4, 0xcb, 0xa1, 0x9c, 0x1b, 0x17, 0x10, 0xb6, 0x71, 0x5c, 0x36, 0x29, 0x67, 0x9d,
+	0x50, 0x54, 0x71, 0x58, 0xc6, 0xda, 0xb6, 0xbc, 0x78, 0x5f, 0xde, 0x1d, 0x29, 0xf2, 0xff, 0x01,
+	0x55, 0xfc, 0x0f, 0x54, 0x51, 0x81, 0xf0, 0x07, 0x70, 0xcc, 0x81, 0x47, 0x80, 0x2b, 0x54, 0x81,
+	0xb9, 0x70, 0x07, 0xaa, 0xe0, 0x46, 0xcd, 0x63, 0x5f, 0xd2, 0xae, 0x73, 0xd3, 0x7e, 0xbb, 0xfb,
+	0xd3, 0x3d, 0x3b, 0xdd, 0x3b, 0x23, 0xb4, 0x10, 0xd0, 0x23, 0x66, 0x58, 0x2e, 0x83, 0xc0, 0xa5,
+	0xf6, 0x9a, 0x1f, 0x78, 0xcc, 0xc3, 0x75, 0x60, 0x3d, 0x33, 0x84, 0x60, 0x08, 0x81, 0x7f, 0xd8,
+	0x5c, 0xec, 0x7b, 0x7d, 0x4f, 0x18, 0x6e, 0xf1, 0x5f, 0xd2, 0xa7, 0x79, 0x25, 0xf1, 0x51, 0x4a,
+	0x35, 0xf0, 0x7b, 0xf2, 0x67, 0xfb, 0x91, 0x86, 0x1a, 0x3a, 0x9c, 0x0e, 0x20, 0x64, 0x77, 0x80,
+	0x9a, 0x10, 0xe0, 0x59, 0x54, 0xda, 0xd9, 0x24, 0x5a, 0x4b, 0xeb, 0x4c, 0xe9, 0xa5, 0x9d, 0x4d,
+	0xdc, 0x44, 0x95, 0x41, 0xc8, 0x73, 0x3a, 0x40, 0x4a, 0x2d, 0xad, 0x53, 0xd5, 0xe3, 0x67, 0x7c,
+	0x1d, 0x35, 0xe8, 0x80, 0x1d, 0x1b, 0x01, 0x0c, 0xad, 0xd0, 0xf2, 0x5c, 0x52, 0x16, 0x61, 0x75,
+	0x2e, 0xea, 0x4a, 0xc3, 0xcf, 0xa1, 0x2a, 0xb3, 0x1c, 0x08, 0x19, 0x75, 0x7c, 0x32, 0xd5, 0xd2,
+	0x3a, 0x65, 0x3d, 0x11, 0x38, 0x3e, 0x5a, 0x13, 0x99, 0x6e, 0x69, 0x9d, 0x8a, 0x1e, 0x3f, 0xe3,
+	0x45, 0x34, 0x1d, 0x78, 0x36, 0x84, 0x64, 0xa6, 0x55, 0xee, 0x54, 0x75, 0xf9, 0xd0, 0x7e, 0xbc,
+	0x88, 0x16, 0x76, 0x94, 0x8b, 0x4e, 0x8f, 0x98, 0x2a, 0x7f, 0xa2, 0xf0, 0x1b, 0xa8, 0x34, 0x5c,
+	0x17, 0x25, 0xd7, 0xd6, 0xaf, 0xae, 0xa5, 0x5f, 0xd4, 0x9a, 0x0a, 0xd1, 0x4b, 0xc3, 0x75, 0xfc,
+	0x3a, 0x9a, 0x0e, 0xa8, 0xdb, 0x07, 0x51, 0x7b, 0x6d, 0xbd, 0x39, 0xe6, 0xc9, 0x4d, 0x91, 0xbb,
+	0x74, 0xc4, 0x2f, 0xa3, 0xb2, 0x3f, 0x60, 0x62, 0x29, 0xb5, 0x75, 0x92, 0xf5, 0xdf, 0x1f, 0x44,
+	0xf5, 0xe8, 0xdc, 0x09, 0x6f, 0xa0, 0xba, 0x09, 0x36, 0x30, 0x30, 0x64, 0x92, 0x69, 0x11, 0xd4,
+	0xca, 0x06, 0x6d, 0x0a, 0x8f, 0x4c, 0xaa, 0x9a, 0x99, 0x68, 0x3c, 0x21, 0x1b, 0xb9, 0x64, 0x26,
+	0x2f, 0xe1, 0xfd, 0x91, 0x1b, 0x27, 0x64, 0x23, 0x17, 0xbf, 0x89, 0x50, 0xcf, 0x73, 0x7c, 0xda,
+	0x63, 0x7c, 0x3f, 0x2e, 0x8b, 0x90, 0x6b, 0xd9, 0x90, 0x8d, 0xd8, 0x1e, 0x45, 0xa6, 0x42, 0xf0,
+	0x5b, 0xa8, 0x66, 0x03, 0x0d, 0xc1, 0xe8, 0x07, 0xd4, 0x65, 0xa4, 0x92, 0x47, 0xd8, 0xe3, 0x0e,
+	0xdb, 0xdc, 0x1e, 0x13, 0xec, 0x58, 0xe2, 0x6b, 0x96, 0x84, 0x00, 0x86, 0xde, 0x09, 0x90, 0x6a,
+	0xde, 0x9a, 0x05, 0x42, 0x17, 0x0e, 0xf1, 0x9a, 0xed, 0x44, 0xe3, 0xdb, 0x42, 0x6d, 0x1a, 0x38,
+	0x04, 0xe5, 0x6d, 0x4b, 0x97, 0x9b, 0xe2, 0x6d, 0x11, 0x8e, 0xf8, 0x0d, 0x54, 0x3d, 0x1d, 0x78,
+	0x8c, 0x1a, 0x21, 0x30, 0x52, 0x13, 0x51, 0xcf, 0x67, 0xa3, 0xee, 0x71, 0xf3, 0x01, 0xc4, 0x45,
+	0x57, 0x4e, 0x95, 0x80, 0x6f, 0xa3, 0x99, 0x63, 0xd1, 0xfe, 0xc4, 0x14, 0x81, 0xcb, 0xb9, 0xfd,
+	0x22, 0x27, 0x44, 0x57, 0xae, 0xb8, 0x8b, 0x6a, 0xa2, 0xfb, 0xc1, 0xa5, 0x87, 0x36, 0x90, 0x3f,
+	0x72, 0x5f, 0x76, 0x77, 0xc0, 0x8e, 0xb7, 0x84, 0x43, 0xfc, 0xaa, 0x68, 0x2c, 0xe1, 0x4d, 0x24,
+	0x66, 0xc5, 0x30, 0xad, 0x50, 0x30, 0xfe, 0xbc, 0x9c, 0xf7, 0xae, 0x38, 0x63, 0xd3, 0x0a, 0xd3,
+	0x90, 0x1a, 0x4d, 0x34, 0x7c, 0x57, 0x52, 0xc0, 0x65, 0x56, 0x8f, 0x32, 0x20, 0x7f, 0x49, 0xca,
+	0x4b, 0x59, 0x4a, 0x34, 0x33, 0xdd, 0x94, 0x6b, 0x84, 0xcb, 0xc4, 0x63, 0x8a, 0x16, 0xd2, 0xcf,
+	0xc6, 0x11, 0xb5, 0x6c, 0x30, 0xc9, 0xdf, 0x12, 0x7b, 0xeb, 0xd9, 0xd8, 0x77, 0x44, 0x40, 0x04,
+	0xc7, 0x74, 0xc2, 0x84, 0xb7, 0xd4, 0x97, 0x63, 0x10, 0x42, 0x60, 0x50, 0xd3, 0x24, 0xdf, 0x54,
+	0x8a, 0x56, 0xfe, 0x20, 0x84, 0xa0, 0x6b, 0x9a, 0x99, 0x95, 0x2b, 0x0d, 0xdf, 0x45, 0x57, 0x12,
+	0x8c, 0x1c, 0x19, 0xf2, 0xad, 0x24, 0x5d, 0xcf, 0x27, 0xa9, 0x59, 0x53, 0xb0, 0x59, 0x9a, 0x91,
+	0xb3, 0x65, 0xf5, 0x81, 0x91, 0xef, 0x2e, 0x2c, 0x6b, 0x1b, 0xd8, 0x44, 0x59, 0xdb, 0xc0, 0x70,
+	0x1f, 0xfd, 0x3f, 0xc1, 0xf4, 0x8e, 0xf9, 0x10, 0x1b, 0x3e, 0x0d, 0xc3, 0x87, 0x5e, 0x60, 0x92,
+	0xef, 0x25, 0xf2, 0x95, 0x7c, 0xe4, 0x86, 0xf0, 0xde, 0x57, 0xce, 0x11, 0x7d, 0x89, 0xe6, 0x9a,
+	0xf1, 0xfb, 0x68, 0x31, 0x55, 0x2f, 0x9f, 0x3e, 0x83, 0x7f, 0x24, 0xc9, 0x53, 0x99, 0xe3, 0xc5,
+	0x82, 0xb2, 0xc5, 0xe4, 0x7a, 0x49, 0x37, 0xcd, 0xd3, 0x71, 0x0b, 0xfe, 0x00, 0x5d, 0x4d, 0xc8,
+	0x72, 0x90, 0x25, 0xfa, 0x07, 0x89, 0xbe, 0x99, 0x8f, 0x56, 0x13, 0x9d, 0x62, 0x63, 0x3a, 0x61,
+	0xc2, 0x77, 0xd0, 0x6c, 0x02, 0xb7, 0xad, 0x90, 0x91, 0x1f, 0x25, 0x75, 0x35, 0x9f, 0xba, 0x67,
+	0x85, 0x2c, 0xd3, 0xaa, 0x91, 0x18, 0x93, 0x78, 0x69, 0x92, 0xf4, 0x53, 0x21, 0x89, 0xa7, 0x9e,
+	0x20, 0x45, 0x62, 0xbc, 0xf5, 0x82, 0xc4, 0x3b, 0xf2, 0x51, 0xb5, 0x68, 0xeb, 0x79, 0xcc, 0x78,
+	0x47, 0x2a, 0x2d, 0xee, 0x48, 0x81, 0x51, 0x1d, 0xf9, 0x45, 0xb5, 0xa8, 0x23, 0x79, 0x54, 0x4e,
+	0x47, 0x26, 0x72, 0xb6, 0x2c, 0xde, 0x91, 0x5f, 0x5e, 0x58, 0xd6, 0x78, 0x47, 0x2a, 0x0d, 0x7f,
+	0x84, 0x9a, 0x29, 0x8c, 0x68, 0x14, 0x1f, 0x02, 0xc7, 0x0a, 0xc5, 0xb1, 0xfd, 0x58, 0x32, 0x5f,
+	0x2d, 0x60, 0x72, 0xf7, 0xfd, 0xd8, 0x3b, 0xe2, 0xff, 0x8f, 0xe6, 0xdb, 0xb1, 0x83, 0x96, 0x93,
+	0x5c, 0xaa, 0x75, 0x52, 0xc9, 0xbe, 0x92, 0xc9, 0x5e, 0xcb, 0x4f, 0x26, 0xbb, 0x64, 0x32, 0x1b,
+	0xa1, 0x05, 0x0e, 0xf8, 0x81, 0xfc, 0x5a, 0x19, 0x01, 0xff, 0x54, 0xd9, 0x96, 0x63, 0x31, 0x71,
+	0x02, 0x7c, 0x2c, 0x0f, 0x8e, 0x1b, 0x39, 0x69, 0x28, 0x83, 0x3d, 0xee, 0x97, 0x3a, 0x0a, 0xae,
+	0xd0, 0x31, 0x43, 0x3c, 0x5a, 0x29, 0xac, 0xe8, 0xaf, 0x4f, 0x50, 0xd1, 0x68, 0xc5, 0xe1, 0xe9,
+	0x26, 0x9b, 0xa7, 0xe3, 0x16, 0xbc, 0x8b, 0xe6, 0x04, 0x99, 0x79, 0x27, 0xe0, 0x4a, 0xe8, 0x3f,
+	0x12, 0xda, 0x9e, 0x84, 0xde, 0xe7, 0x4e, 0x69, 0x60, 0x83, 0xa6, 0x55, 0x7c, 0x0f, 0xcd, 0xa7,
+	0x60, 0xea, 0xc4, 0xfd, 0x57, 0xe2, 0x5e, 0x28, 0xc0, 0x65, 0x8f, 0xdd, 0x39, 0x9a, 0xd5, 0x93,
+	0x73, 0x6d, 0xe4, 0x7b, 0x01, 0x23, 0x3f, 0xd7, 0x0a, 0xcf, 0x35, 0xe1, 0x90, 0x3d, 0xd7, 0x84,
+	0x14, 0x23, 0x2c, 0x47, 0x20, 0x7e, 0x29, 0x44, 0xec, 0x38, 0x13, 0x08, 0x29, 0xe1, 0x77, 0x51,
+	0x63, 0x48, 0xed, 0x01, 0x18, 0x27, 0x70, 0x26, 0xe6, 0xf1, 0xeb, 0xba, 0x80, 0x74, 0xf2, 0x8f,
+	0x9f, 0xf7, 0xb8, 0xef, 0x2e, 0x9c, 0xa5, 0xe7, 0x72, 0x98, 0x68, 0xed, 0x39, 0xd4, 0xd8, 0x72,
+	0x7c, 0x76, 0xa6, 0x43, 0xe8, 0x7b, 0x6e, 0x08, 0xed, 0xcf, 0x34, 0xb4, 0x7c, 0xc1, 0x91, 0x88,
+	0x31, 0x9a, 0x12, 0x77, 0x5e, 0x4d, 0xdc, 0x79, 0xc5, 0x6f, 0x7e, 0x59, 0x8d, 0x3f, 0xe3, 0xea,
+	0x2e, 0x1c, 0x3d, 0xe3, 0x55, 0x54, 0x0f, 0x2d, 0xc7, 0xb7, 0x41, 0x6e, 0x85, 0xb8, 0x4e, 0x56,
+	0xf5, 0x9a, 0xd4, 0xc4, 0xeb, 0xc5, 0xcb, 0xa8, 0xea, 0x80, 0x73, 0x08, 0x81, 0x61, 0x99, 0xe2,
+	0xfa, 0x38, 0xa5, 0x57, 0xa4, 0xb0, 0x63, 0x66, 0xaf, 0xc9, 0xd3, 0x63, 0xd7, 0xe4, 0xf6, 0xe7,
+	0x1a, 0x5a, 0x7d, 0xe6, 0x49, 0x9b, 0x5b, 0x73, 0x86, 0x5b, 0x1a, 0xbf, 0x7e, 0xaf, 0xa1, 0x05,
+	0x87, 0x8e, 0xd4, 0x09, 0x6f, 0x50, 0xc6, 0xc0, 0xf1, 0x59, 0x28, 0x8a, 0x2f, 0xeb, 0xf3, 0x0e,
+	0x1d, 0xc9, 0x04, 0x5d, 0x65, 0xc0, 0x37, 0xd1, 0x9c, 0xed, 0xf5, 0x4e, 0xbc, 0x01, 0x1f, 0xb2,
+	0x9e, 0xe7, 0x9a, 0xa1, 0xba, 0xd2, 0xcf, 0x2a, 0xf9, 0x40, 0xaa, 0xed, 0x03, 0x54, 0x89, 0xb6,
+	0x84, 0xdf, 0xcc, 0x2d, 0x53, 0x14, 0xd5, 0xd0, 0x4b, 0x96, 0x89, 0x97, 0xd0, 0x8c, 0x1f, 0xc0,
+	0x91, 0x35, 0x12, 0xf5, 0xd4, 0x75, 0xf5, 0x84, 0xaf, 0xa1, 0xda, 0xc3, 0x80, 0xfa, 0x3e, 0x98,
+	0x7c, 0xd3, 0x45, 0x11, 0x75, 0x1d, 0x29, 0x69, 0x17, 0xce, 0xda, 0x1f, 0xa2, 0x66, 0xf1, 0x7e,
+	0xe3, 0x0e, 0x2a, 0xf3, 0x30, 0x4d, 0xb4, 0xc9, 0x52, 0xb6, 0x4d, 0x22, 0x77, 0x9d, 0xbb, 0x60,
+	0x82, 0x2e, 0x5b, 0xae, 0xc5, 0x2c, 0x6a, 0x8b, 0x0a, 0x2a, 0x7a, 0xf4, 0xf8, 0xf6, 0xe2, 0x93,
+	0xdf, 0x56, 0x2e, 0x3d, 0x39, 0x5f, 0xd1, 0x9e, 0x9e, 0xaf, 0x68, 0xbf, 0x9e, 0xaf, 0x68, 0x9f,
+	0xfe, 0xbe, 0x72, 0xe9, 0x70, 0x46, 0xfc, 0x59, 0xba, 0xfd, 0xdf, 0x00, 0x7e, 0xbc, 0xeb, 0xef,
+	0x84, 0x0d, 0x00, 0x00,
 }
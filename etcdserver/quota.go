@@ -15,8 +15,12 @@
 package etcdserver
 
 import (
+	"math"
+
 	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc"
 	"etcd/mvcc/backend"
+	"etcd/quota"
 )
 
 // Quota represents an arbitrary quota against arbitrary requests. Each request
@@ -112,3 +116,198 @@ func costTxn(r *pb.TxnRequest) int {
 func (b *backendQuota) Remaining() int64 {
 	return b.maxBackendBytes - b.s.Backend().Size()
 }
+
+// prefixQuota is a Quota enforcing the quotas of the key prefixes in the
+// quota store. It checks requests as they are applied, so that all members
+// reject the same requests.
+type prefixQuota struct {
+	s *EtcdServer
+
+	// rev is the revision of the store when the last request was checked,
+	// and usages the usages of the prefixes after the request.
+	rev    int64
+	usages quota.Usages
+}
+
+func (q *prefixQuota) Available(v interface{}) bool {
+	q.rev, q.usages = q.s.KV().Rev(), nil
+	if q.s.quotaStore.Len() == 0 {
+		return true
+	}
+	u, err := q.s.quotaStore.Check(q.s.KV(), q.changes(v))
+	if err == quota.ErrQuotaExceeded {
+		return false
+	}
+	if err != nil {
+		plog.Panicf("unexpected error checking prefix quotas: %v", err)
+	}
+	q.usages = u
+	return true
+}
+
+func (q *prefixQuota) Cost(v interface{}) int {
+	cost := int64(0)
+	for _, c := range q.changes(v) {
+		cost += keySize(c.NewSize) - keySize(c.OldSize)
+	}
+	return int(cost)
+}
+
+func (q *prefixQuota) Remaining() int64 {
+	rem := int64(math.MaxInt64)
+	for _, pq := range q.s.quotaStore.List() {
+		if pq.MaxBytes <= 0 {
+			continue
+		}
+		u, err := q.s.quotaStore.Usage(q.s.KV(), pq.Prefix)
+		if err != nil {
+			plog.Panicf("unexpected error computing prefix quota usage: %v", err)
+		}
+		if r := pq.MaxBytes - u.Bytes; r < rem {
+			rem = r
+		}
+	}
+	return rem
+}
+
+// record records the usages of the prefixes after the last request found
+// available was applied.
+func (q *prefixQuota) record() {
+	q.s.quotaStore.Update(q.usages, q.rev, q.s.KV().Rev())
+	q.usages = nil
+}
+
+// changes returns the changes to the sizes of the keys the request makes,
+// or nil if the request does not write under a quota prefix.
+func (q *prefixQuota) changes(v interface{}) []quota.Change {
+	qs := q.s.quotaStore
+	cs := &changeSet{kv: q.s.KV(), sizes: make(map[string]int64)}
+	switch r := v.(type) {
+	case *pb.PutRequest:
+		if qs.Covers(r.Key, nil) {
+			cs.put(r)
+		}
+	case *pb.DeleteRangeRequest:
+		if qs.Covers(r.Key, rangeEnd(r.RangeEnd)) {
+			cs.deleteRange(r)
+		}
+	case *pb.TxnRequest:
+		reqs := r.Failure
+		if q.txnSucceeds(r) {
+			reqs = r.Success
+		}
+		covered := false
+		for _, u := range reqs {
+			switch tv := u.Request.(type) {
+			case *pb.RequestOp_RequestPut:
+				covered = covered || qs.Covers(tv.RequestPut.Key, nil)
+			case *pb.RequestOp_RequestDeleteRange:
+				dr := tv.RequestDeleteRange
+				covered = covered || qs.Covers(dr.Key, rangeEnd(dr.RangeEnd))
+			}
+		}
+		if !covered {
+			return nil
+		}
+		for _, u := range reqs {
+			switch tv := u.Request.(type) {
+			case *pb.RequestOp_RequestPut:
+				cs.put(tv.RequestPut)
+			case *pb.RequestOp_RequestDeleteRange:
+				cs.deleteRange(tv.RequestDeleteRange)
+			}
+		}
+	case *pb.LeaseGrantRequest:
+	default:
+		panic("unexpected request")
+	}
+	return cs.changes
+}
+
+func (q *prefixQuota) txnSucceeds(rt *pb.TxnRequest) bool {
+	a := &applierV3backend{q.s}
+	for _, c := range rt.Compare {
+		if _, ok := a.applyCompare(q.s.KV(), c); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// changeSet collects the changes to the sizes of keys made by a sequence
+// of puts and deletes.
+type changeSet struct {
+	kv mvcc.KV
+	// sizes are the sizes of the keys changed so far.
+	sizes   map[string]int64
+	changes []quota.Change
+}
+
+func (cs *changeSet) put(r *pb.PutRequest) {
+	cs.set(r.Key, cs.size(r.Key), int64(len(r.Key)+len(r.Value)))
+}
+
+func (cs *changeSet) deleteRange(r *pb.DeleteRangeRequest) {
+	end := rangeEnd(r.RangeEnd)
+	if end == nil {
+		cs.set(r.Key, cs.size(r.Key), -1)
+		return
+	}
+	rr, err := cs.kv.Range(r.Key, end, mvcc.RangeOptions{})
+	if err != nil {
+		plog.Panicf("unexpected error computing prefix quota usage: %v", err)
+	}
+	deleted := make(map[string]struct{}, len(rr.KVs))
+	for i := range rr.KVs {
+		kv := &rr.KVs[i]
+		deleted[string(kv.Key)] = struct{}{}
+		old, ok := cs.sizes[string(kv.Key)]
+		if !ok {
+			old = int64(len(kv.Key) + len(kv.Value))
+		}
+		cs.set(kv.Key, old, -1)
+	}
+	// keys put earlier in the same txn
+	for k, sz := range cs.sizes {
+		if _, ok := deleted[k]; ok || sz < 0 {
+			continue
+		}
+		if k >= string(r.Key) && (len(end) == 0 || k < string(end)) {
+			cs.set([]byte(k), sz, -1)
+		}
+	}
+}
+
+func (cs *changeSet) size(key []byte) int64 {
+	if sz, ok := cs.sizes[string(key)]; ok {
+		return sz
+	}
+	rr, err := cs.kv.Range(key, nil, mvcc.RangeOptions{})
+	if err != nil {
+		plog.Panicf("unexpected error computing prefix quota usage: %v", err)
+	}
+	if len(rr.KVs) == 0 {
+		return -1
+	}
+	return int64(len(key) + len(rr.KVs[0].Value))
+}
+
+func (cs *changeSet) set(key []byte, old, sz int64) {
+	cs.changes = append(cs.changes, quota.Change{Key: key, OldSize: old, NewSize: sz})
+	cs.sizes[string(key)] = sz
+}
+
+// rangeEnd returns the end of a range as the store expects it.
+func rangeEnd(end []byte) []byte {
+	if isGteRange(end) {
+		return []byte{}
+	}
+	return end
+}
+
+func keySize(sz int64) int64 {
+	if sz < 0 {
+		return 0
+	}
+	return sz
+}
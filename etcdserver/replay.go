@@ -0,0 +1,130 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"fmt"
+	"time"
+
+	"etcd/auth"
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/etcdserver/membership"
+	"etcd/lease"
+	"etcd/mvcc"
+	"etcd/mvcc/backend"
+	"etcd/pkg/pbutil"
+	"etcd/raft/raftpb"
+)
+
+// ReplayConfig holds the point in time a replay stops at.
+type ReplayConfig struct {
+	// ToRevision stops the replay once the keyspace reaches the given
+	// revision. 0 means no limit.
+	ToRevision int64
+	// ToTime stops the replay at the first request proposed after the given
+	// time. Requests proposed by members that do not record the proposal
+	// time are always replayed. The zero time means no limit.
+	ToTime time.Time
+	// MinLeaseTTL is the minimum TTL of the leases granted by the replayed
+	// requests, as set by the members from their election timeout. 0 keeps
+	// the requested TTLs; members starting on the backend raise the TTLs
+	// below their own minimum.
+	MinLeaseTTL int64
+}
+
+// ReplayResult describes the state of a backend after a replay.
+type ReplayResult struct {
+	// Index is the raft index of the last replayed entry.
+	Index uint64
+	// Revision is the revision of the keyspace.
+	Revision int64
+}
+
+// ReplayBackend applies the entries of ents that follow the consistent index
+// of the given backend, through the same v3 appliers a member uses, until the
+// point in time given by cfg is reached.
+// ents must be committed raft log entries in index order, starting at or
+// before the entry following the consistent index of the backend.
+// Backend quotas are not enforced; a request rejected for lack of space is
+// followed in the log by the NOSPACE alarm which is replayed.
+func ReplayBackend(be backend.Backend, ents []raftpb.Entry, cfg ReplayConfig) (ReplayResult, error) {
+	s := &EtcdServer{
+		Cfg:     &ServerConfig{QuotaBackendBytes: -1},
+		cluster: membership.NewCluster(""),
+		be:      be,
+	}
+	// the lessor is never promoted, so it does not expire leases; expired
+	// leases are revoked by the LeaseRevoke requests of the log.
+	s.lessor = lease.NewLessor(be, cfg.MinLeaseTTL)
+	defer s.lessor.Stop()
+	s.kv = mvcc.New(be, s.lessor, &s.consistIndex)
+	defer s.kv.Close()
+	s.consistIndex.setConsistentIndex(s.kv.ConsistentIndex())
+
+	applied := make(chan struct{})
+	close(applied)
+	s.authStore = auth.NewAuthStore(be, func(uint64) <-chan struct{} { return applied })
+	defer s.authStore.Close()
+
+	s.applyV3Base = &applierV3backend{s}
+	if err := s.restoreQuotas(); err != nil {
+		return ReplayResult{}, err
+	}
+	if err := s.restoreValueKeys(); err != nil {
+		return ReplayResult{}, err
+	}
+	if err := s.restoreAlarms(); err != nil {
+		return ReplayResult{}, err
+	}
+
+	res := ReplayResult{Index: s.consistIndex.ConsistentIndex(), Revision: s.kv.Rev()}
+	if cfg.ToRevision > 0 && res.Revision > cfg.ToRevision {
+		return res, fmt.Errorf("etcdserver: backend revision %d is past the replay revision %d", res.Revision, cfg.ToRevision)
+	}
+	for i := range ents {
+		e := &ents[i]
+		if e.Index <= res.Index {
+			continue
+		}
+		if e.Index != res.Index+1 {
+			return res, fmt.Errorf("etcdserver: missing log entries between index %d and %d", res.Index, e.Index)
+		}
+		if cfg.ToRevision > 0 && s.kv.Rev() >= cfg.ToRevision {
+			break
+		}
+
+		var raftReq pb.InternalRaftRequest
+		isV3 := e.Type == raftpb.EntryNormal && len(e.Data) != 0 &&
+			pbutil.MaybeUnmarshal(&raftReq, e.Data) && raftReq.V2 == nil
+		if isV3 && !cfg.ToTime.IsZero() && raftReq.Header != nil && raftReq.Header.Timestamp != 0 &&
+			time.Unix(0, raftReq.Header.Timestamp).After(cfg.ToTime) {
+			break
+		}
+
+		s.consistIndex.setConsistentIndex(e.Index)
+		if isV3 && !noSideEffect(&raftReq) {
+			if raftReq.Txn != nil {
+				removeNeedlessRangeReqs(raftReq.Txn)
+			}
+			ar := s.applyV3.Apply(&raftReq)
+			if ar.physc != nil {
+				<-ar.physc
+			}
+		}
+		res.Index, res.Revision = e.Index, s.kv.Rev()
+	}
+	plog.Infof("replayed log to index %d (revision %d)", res.Index, res.Revision)
+	return res, nil
+}
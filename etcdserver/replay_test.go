@@ -0,0 +1,135 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/lease"
+	"etcd/mvcc"
+	"etcd/mvcc/backend"
+	"etcd/pkg/pbutil"
+	"etcd/raft/raftpb"
+)
+
+func TestReplayBackend(t *testing.T) {
+	base := time.Unix(1500000000, 0)
+	reqs := []pb.InternalRaftRequest{
+		{Put: &pb.PutRequest{Key: []byte("foo/a"), Value: []byte("1")}},
+		{Range: &pb.RangeRequest{Key: []byte("foo/a")}},
+		{Put: &pb.PutRequest{Key: []byte("foo/b"), Value: []byte("2")}},
+		{DeleteRange: &pb.DeleteRangeRequest{Key: []byte("foo/"), RangeEnd: []byte("foo0")}},
+		{Put: &pb.PutRequest{Key: []byte("foo/c"), Value: []byte("3")}},
+	}
+	ents := []raftpb.Entry{{Index: 1, Term: 1}}
+	for i := range reqs {
+		reqs[i].Header = &pb.RequestHeader{ID: uint64(i + 1), Timestamp: base.Add(time.Duration(i) * time.Second).UnixNano()}
+		ents = append(ents, raftpb.Entry{Index: uint64(i + 2), Term: 1, Data: pbutil.MustMarshal(&reqs[i])})
+	}
+
+	tests := []struct {
+		cfg ReplayConfig
+
+		windex uint64
+		wrev   int64
+		wkeys  []string
+	}{
+		{ReplayConfig{}, 6, 5, []string{"foo/c"}},
+		{ReplayConfig{ToRevision: 3}, 4, 3, []string{"foo/a", "foo/b"}},
+		{ReplayConfig{ToTime: base.Add(2500 * time.Millisecond)}, 4, 3, []string{"foo/a", "foo/b"}},
+		{ReplayConfig{ToTime: base.Add(-time.Second)}, 1, 1, nil},
+	}
+	for i, tt := range tests {
+		be, tmpPath := backend.NewDefaultTmpBackend()
+		res, err := ReplayBackend(be, ents, tt.cfg)
+		if err != nil {
+			t.Fatalf("#%d: err = %v", i, err)
+		}
+		if res.Index != tt.windex || res.Revision != tt.wrev {
+			t.Errorf("#%d: result = %+v, want index %d revision %d", i, res, tt.windex, tt.wrev)
+		}
+
+		s := mvcc.NewStore(be, &lease.FakeLessor{}, nil)
+		rr, err := s.Range([]byte("foo/"), []byte("foo0"), mvcc.RangeOptions{})
+		if err != nil {
+			t.Fatalf("#%d: err = %v", i, err)
+		}
+		var keys []string
+		for _, kv := range rr.KVs {
+			keys = append(keys, string(kv.Key))
+		}
+		if len(keys) != len(tt.wkeys) {
+			t.Errorf("#%d: keys = %v, want %v", i, keys, tt.wkeys)
+		}
+		for j := range keys {
+			if j < len(tt.wkeys) && keys[j] != tt.wkeys[j] {
+				t.Errorf("#%d: keys = %v, want %v", i, keys, tt.wkeys)
+			}
+		}
+		s.Close()
+		be.Close()
+		os.Remove(tmpPath)
+	}
+}
+
+func TestReplayBackendMissingEntries(t *testing.T) {
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		be.Close()
+		os.Remove(tmpPath)
+	}()
+
+	ents := []raftpb.Entry{{Index: 2, Term: 1}}
+	if _, err := ReplayBackend(be, ents, ReplayConfig{}); err == nil {
+		t.Fatal("expected error on missing entries")
+	}
+}
+
+func TestReplayBackendLeaseTTL(t *testing.T) {
+	reqs := []pb.InternalRaftRequest{
+		{LeaseGrant: &pb.LeaseGrantRequest{ID: 1, TTL: 60}},
+		{LeaseGrant: &pb.LeaseGrantRequest{ID: 2, TTL: 1}},
+	}
+	ents := []raftpb.Entry{{Index: 1, Term: 1}}
+	for i := range reqs {
+		reqs[i].Header = &pb.RequestHeader{ID: uint64(i + 1)}
+		ents = append(ents, raftpb.Entry{Index: uint64(i + 2), Term: 1, Data: pbutil.MustMarshal(&reqs[i])})
+	}
+
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		be.Close()
+		os.Remove(tmpPath)
+	}()
+	if _, err := ReplayBackend(be, ents, ReplayConfig{MinLeaseTTL: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the granted leases keep their requested TTL, raised to the minimum
+	le := lease.NewLessor(be, 0)
+	defer le.Stop()
+	for id, wttl := range map[lease.LeaseID]int64{1: 60, 2: 5} {
+		l := le.Lookup(id)
+		if l == nil {
+			t.Fatalf("lease %d not found", id)
+		}
+		if l.TTL() != wttl {
+			t.Errorf("ttl of lease %d = %d, want %d", id, l.TTL(), wttl)
+		}
+	}
+}
@@ -32,7 +32,9 @@ import (
 	"etcd/alarm"
 	"etcd/auth"
 	"etcd/compactor"
+	"etcd/defragger"
 	"etcd/discovery"
+	"etcd/envelope"
 	"etcd/etcdserver/api"
 	"etcd/etcdserver/api/v2http/httptypes"
 	pb "etcd/etcdserver/etcdserverpb"
@@ -49,6 +51,7 @@ import (
 	"etcd/pkg/schedule"
 	"etcd/pkg/types"
 	"etcd/pkg/wait"
+	"etcd/quota"
 	"etcd/raft"
 	"etcd/raft/raftpb"
 	"etcd/rafthttp"
@@ -217,6 +220,10 @@ type EtcdServer struct {
 	be         backend.Backend
 	authStore  auth.AuthStore
 	alarmStore *alarm.AlarmStore
+	// quotaStore holds the storage quotas of the key prefixes.
+	quotaStore *quota.QuotaStore
+	// valueKeys holds the data keys of the encrypted key prefixes.
+	valueKeys *envelope.Store
 
 	stats  *stats.ServerStats
 	lstats *stats.LeaderStats
@@ -224,6 +231,8 @@ type EtcdServer struct {
 	SyncTicker <-chan time.Time
 	// compactor is used to auto-compact the KV.
 	compactor *compactor.Periodic
+	// defragger is used to auto-defragment the backend.
+	defragger *defragger.Auto
 
 	// peerRt used to send requests (version, lease) to peers.
 	peerRt   http.RoundTripper
@@ -262,7 +271,7 @@ func NewServer(cfg *ServerConfig) (srv *EtcdServer, err error) {
 	if err = fileutil.TouchDirAll(cfg.SnapDir()); err != nil {
 		plog.Fatalf("create snapshot directory error: %v", err)
 	}
-	ss := snap.New(cfg.SnapDir())
+	ss := snap.NewWithCipher(cfg.SnapDir(), cfg.EncryptionCipher)
 
 	bepath := filepath.Join(cfg.SnapDir(), databaseFilename)
 	beExist := fileutil.Exist(bepath)
@@ -270,7 +279,7 @@ func NewServer(cfg *ServerConfig) (srv *EtcdServer, err error) {
 	var be backend.Backend
 	beOpened := make(chan struct{})
 	go func() {
-		be = backend.NewDefaultBackend(bepath)
+		be = newBackend(cfg, bepath)
 		beOpened <- struct{}{}
 	}()
 
@@ -446,7 +455,12 @@ func NewServer(cfg *ServerConfig) (srv *EtcdServer, err error) {
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
 	// If we recover mvcc.KV first, it will attach the keys to the wrong lessor before it recovers.
 	srv.lessor = lease.NewLessor(srv.be, int64(math.Ceil(minTTL.Seconds())))
-	srv.kv = mvcc.New(srv.be, srv.lessor, &srv.consistIndex)
+	srv.kv = mvcc.NewWithConfig(srv.be, srv.lessor, &srv.consistIndex, mvcc.StoreConfig{
+		CompactionBatchLimit:      cfg.CompactionBatchLimit,
+		CompactionSleepInterval:   cfg.CompactionSleepInterval,
+		CompactionBatchMaxLatency: cfg.CompactionBatchMaxLatency,
+		ValueIndexes:              cfg.ValueIndexes,
+	})
 	if beExist {
 		kvindex := srv.kv.ConsistentIndex()
 		// TODO: remove kvindex != 0 checking when we do not expect users to upgrade
@@ -464,12 +478,25 @@ func NewServer(cfg *ServerConfig) (srv *EtcdServer, err error) {
 		func(index uint64) <-chan struct{} {
 			return srv.applyWait.Wait(index)
 		})
+	srv.authStore.SetCertIdentity(cfg.ClientCertIdentity)
+	srv.authStore.SetPasswordPolicy(cfg.AuthPasswordPolicy)
+	srv.authStore.SetOIDC(cfg.AuthOIDC)
 	if h := cfg.AutoCompactionRetention; h != 0 {
 		srv.compactor = compactor.NewPeriodic(h, srv.kv, srv)
 		srv.compactor.Run()
 	}
+	if t := cfg.AutoDefragThreshold; t != 0 {
+		srv.defragger = defragger.NewAuto(t, cfg.AutoDefragWindow, srv, srv)
+		srv.defragger.Run()
+	}
 
 	srv.applyV3Base = &applierV3backend{srv}
+	if err = srv.restoreQuotas(); err != nil {
+		return nil, err
+	}
+	if err = srv.restoreValueKeys(); err != nil {
+		return nil, err
+	}
 	if err = srv.restoreAlarms(); err != nil {
 		return nil, err
 	}
@@ -486,6 +513,9 @@ func NewServer(cfg *ServerConfig) (srv *EtcdServer, err error) {
 		ServerStats: sstats,
 		LeaderStats: lstats,
 		ErrorC:      srv.errorc,
+
+		SnapshotRateLimit: cfg.PeerSnapshotSendRateLimit,
+		Compression:       cfg.PeerCompression,
 	}
 	if err = tr.Start(); err != nil {
 		return nil, err
@@ -549,7 +579,12 @@ func (s *EtcdServer) purgeFile() {
 		serrc = fileutil.PurgeFile(s.Cfg.SnapDir(), "snap", s.Cfg.MaxSnapFiles, purgeFileInterval, s.done)
 	}
 	if s.Cfg.MaxWALFiles > 0 {
-		werrc = fileutil.PurgeFile(s.Cfg.WALDir(), "wal", s.Cfg.MaxWALFiles, purgeFileInterval, s.done)
+		if s.Cfg.WALArchiveDir != "" {
+			archive := func(p string) error { return wal.ArchiveSegment(s.Cfg.WALArchiveDir, p) }
+			werrc = fileutil.PurgeFileFunc(s.Cfg.WALDir(), "wal", s.Cfg.MaxWALFiles, purgeFileInterval, s.done, archive)
+		} else {
+			werrc = fileutil.PurgeFile(s.Cfg.WALDir(), "wal", s.Cfg.MaxWALFiles, purgeFileInterval, s.done)
+		}
 	}
 	select {
 	case e := <-werrc:
@@ -679,6 +714,12 @@ func (s *EtcdServer) run() {
 
 		sched.Stop()
 
+		// stop before the backend closes; the lock lease expires
+		// if it cannot be revoked anymore
+		if s.defragger != nil {
+			s.defragger.Stop()
+		}
+
 		// wait for gouroutines before closing raft so wal stays open
 		s.wg.Wait()
 
@@ -728,7 +769,9 @@ func (s *EtcdServer) run() {
 					}
 					lid := lease.ID
 					s.goAttach(func() {
-						s.LeaseRevoke(context.TODO(), &pb.LeaseRevokeRequest{ID: int64(lid)})
+						// the expired lease deletes its keys whoever attached them
+						ctx := context.WithValue(context.TODO(), internalRequestKey{}, true)
+						s.LeaseRevoke(ctx, &pb.LeaseRevokeRequest{ID: int64(lid)})
 						<-c
 					})
 				}
@@ -797,7 +840,7 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 		plog.Panicf("rename snapshot file error: %v", err)
 	}
 
-	newbe := backend.NewDefaultBackend(fn)
+	newbe := newBackend(s.Cfg, fn)
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
 	// If we recover mvcc.KV first, it will attach the keys to the wrong lessor before it recovers.
@@ -839,6 +882,18 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 	}
 	plog.Info("finished recovering alarms")
 
+	plog.Info("recovering quotas...")
+	if err := s.restoreQuotas(); err != nil {
+		plog.Panicf("restore quotas error: %v", err)
+	}
+	plog.Info("finished recovering quotas")
+
+	plog.Info("recovering value encryption keys...")
+	if err := s.restoreValueKeys(); err != nil {
+		plog.Panicf("restore value encryption keys error: %v", err)
+	}
+	plog.Info("finished recovering value encryption keys")
+
 	if s.authStore != nil {
 		plog.Info("recovering auth store...")
 		s.authStore.Recover(newbe)
@@ -1611,6 +1666,24 @@ func (s *EtcdServer) restoreAlarms() error {
 	return nil
 }
 
+func (s *EtcdServer) restoreQuotas() error {
+	qs, err := quota.NewQuotaStore(s)
+	if err != nil {
+		return err
+	}
+	s.quotaStore = qs
+	return nil
+}
+
+func (s *EtcdServer) restoreValueKeys() error {
+	vs, err := envelope.NewStore(s, s.Cfg.ValueEncryptionPrefixes, s.Cfg.ValueEncryptionKeys)
+	if err != nil {
+		return err
+	}
+	s.valueKeys = vs
+	return nil
+}
+
 func (s *EtcdServer) getAppliedIndex() uint64 {
 	return atomic.LoadUint64(&s.appliedIndex)
 }
@@ -18,6 +18,7 @@ import (
 	"io"
 
 	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/pkg/encryption"
 	"etcd/pkg/pbutil"
 	"etcd/pkg/types"
 	"etcd/raft/raftpb"
@@ -66,7 +67,7 @@ func (st *storage) SaveSnap(snap raftpb.Snapshot) error {
 	return st.WAL.ReleaseLockTo(snap.Metadata.Index)
 }
 
-func readWAL(waldir string, snap walpb.Snapshot) (w *wal.WAL, id, cid types.ID, st raftpb.HardState, ents []raftpb.Entry) {
+func readWAL(waldir string, snap walpb.Snapshot, c *encryption.Cipher) (w *wal.WAL, id, cid types.ID, st raftpb.HardState, ents []raftpb.Entry) {
 	var (
 		err       error
 		wmetadata []byte
@@ -74,7 +75,7 @@ func readWAL(waldir string, snap walpb.Snapshot) (w *wal.WAL, id, cid types.ID,
 
 	repaired := false
 	for {
-		if w, err = wal.Open(waldir, snap); err != nil {
+		if w, err = wal.OpenWithCipher(waldir, snap, c); err != nil {
 			plog.Fatalf("open wal error: %v", err)
 		}
 		if wmetadata, st, ents, err = w.ReadAll(); err != nil {
@@ -83,7 +84,7 @@ func readWAL(waldir string, snap walpb.Snapshot) (w *wal.WAL, id, cid types.ID,
 			if repaired || err != io.ErrUnexpectedEOF {
 				plog.Fatalf("read wal error (%v) and cannot be repaired", err)
 			}
-			if !wal.Repair(waldir) {
+			if !wal.RepairWithCipher(waldir, c) {
 				plog.Fatalf("WAL error (%v) cannot be repaired", err)
 			} else {
 				plog.Infof("repaired WAL error (%v)", err)
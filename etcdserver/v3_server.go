@@ -25,6 +25,7 @@ import (
 	"etcd/lease"
 	"etcd/lease/leasehttp"
 	"etcd/mvcc"
+	"etcd/quota"
 	"etcd/raft"
 
 	"github.com/coreos/go-semver/semver"
@@ -51,6 +52,7 @@ var (
 
 type RaftKV interface {
 	Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error)
+	History(ctx context.Context, r *pb.HistoryRequest) (*pb.HistoryResponse, error)
 	Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error)
 	DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error)
 	Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error)
@@ -69,6 +71,18 @@ type Lessor interface {
 
 	// LeaseTimeToLive retrieves lease information.
 	LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error)
+
+	// LeaseTimeToLives retrieves information for several leases at once.
+	LeaseTimeToLives(ctx context.Context, r *pb.LeaseTimeToLivesRequest) (*pb.LeaseTimeToLivesResponse, error)
+}
+
+type QuotaManager interface {
+	// QuotaSet sends QuotaSet request to raft and apply it after committed.
+	QuotaSet(ctx context.Context, r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error)
+	// QuotaGet returns the quota of a prefix and the usage of the prefix.
+	QuotaGet(ctx context.Context, r *pb.QuotaGetRequest) (*pb.QuotaGetResponse, error)
+	// QuotaList returns the quotas of all prefixes.
+	QuotaList(ctx context.Context, r *pb.QuotaListRequest) (*pb.QuotaListResponse, error)
 }
 
 type Authenticator interface {
@@ -88,9 +102,35 @@ type Authenticator interface {
 	RoleDelete(ctx context.Context, r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
 	UserList(ctx context.Context, r *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error)
 	RoleList(ctx context.Context, r *pb.AuthRoleListRequest) (*pb.AuthRoleListResponse, error)
+	RateLimitSet(ctx context.Context, r *pb.AuthRateLimitSetRequest) (*pb.AuthRateLimitSetResponse, error)
+	RateLimitList(ctx context.Context, r *pb.AuthRateLimitListRequest) (*pb.AuthRateLimitListResponse, error)
+	TokenList(ctx context.Context, r *pb.AuthTokenListRequest) (*pb.AuthTokenListResponse, error)
+	TokenRevoke(ctx context.Context, r *pb.AuthTokenRevokeRequest) (*pb.AuthTokenRevokeResponse, error)
+	AuthExport(ctx context.Context, r *pb.AuthExportRequest) (*pb.AuthExportResponse, error)
+	AuthImport(ctx context.Context, r *pb.AuthImportRequest) (*pb.AuthImportResponse, error)
 }
 
 func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	if err := s.checkValueRange(r); err != nil {
+		return nil, err
+	}
+	resp, err := s.rangeKVs(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.decryptKVs(resp.Kvs); err != nil {
+		return nil, err
+	}
+	if !r.LeaseTtl {
+		return resp, nil
+	}
+	if err = s.fillLeaseTTLs(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *EtcdServer) rangeKVs(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
 	// TODO: remove this checking when we release etcd 3.2
 	if s.ClusterVersion() == nil || s.ClusterVersion().LessThan(newRangeClusterVersion) {
 		return s.legacyRange(ctx, r)
@@ -114,6 +154,66 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 	return resp, err
 }
 
+// fillLeaseTTLs sets the remaining lease TTL of each key in the range
+// response, looking up all attached leases with a single request.
+func (s *EtcdServer) fillLeaseTTLs(ctx context.Context, resp *pb.RangeResponse) error {
+	var ids []int64
+	seen := make(map[int64]struct{})
+	for _, kv := range resp.Kvs {
+		if kv.Lease == 0 {
+			continue
+		}
+		if _, ok := seen[kv.Lease]; !ok {
+			seen[kv.Lease] = struct{}{}
+			ids = append(ids, kv.Lease)
+		}
+	}
+
+	ttls := make(map[int64]int64, len(ids))
+	if len(ids) > 0 {
+		lresp, err := s.LeaseTimeToLives(ctx, &pb.LeaseTimeToLivesRequest{IDs: ids})
+		if err != nil {
+			return err
+		}
+		for _, l := range lresp.Leases {
+			ttls[l.ID] = l.TTL
+		}
+	}
+
+	resp.LeaseTtls = make([]int64, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		if kv.Lease != 0 {
+			resp.LeaseTtls[i] = ttls[kv.Lease]
+		}
+	}
+	return nil
+}
+
+func (s *EtcdServer) History(ctx context.Context, r *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	if !r.Serializable {
+		err := s.linearizableReadNotify(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var resp *pb.HistoryResponse
+	var err error
+	chk := func(ai *auth.AuthInfo) error {
+		return s.authStore.IsRangePermitted(ai, r.Key, r.RangeEnd)
+	}
+	get := func() { resp, err = s.applyV3Base.History(r) }
+	if serr := s.doSerialize(ctx, chk, get); serr != nil {
+		return nil, serr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = s.decryptEvents(resp.Events); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // TODO: remove this func when we release etcd 3.2
 func (s *EtcdServer) legacyRange(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
 	if r.Serializable {
@@ -139,6 +239,10 @@ func (s *EtcdServer) legacyRange(ctx context.Context, r *pb.RangeRequest) (*pb.R
 }
 
 func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	r, err := s.encryptPut(ctx, r)
+	if err != nil {
+		return nil, err
+	}
 	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{Put: r})
 	if err != nil {
 		return nil, err
@@ -146,7 +250,11 @@ func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse
 	if result.err != nil {
 		return nil, result.err
 	}
-	return result.resp.(*pb.PutResponse), nil
+	resp := result.resp.(*pb.PutResponse)
+	if resp.PrevKv, err = s.DecryptKV(resp.PrevKv); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (s *EtcdServer) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
@@ -157,10 +265,29 @@ func (s *EtcdServer) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest)
 	if result.err != nil {
 		return nil, result.err
 	}
-	return result.resp.(*pb.DeleteRangeResponse), nil
+	resp := result.resp.(*pb.DeleteRangeResponse)
+	if err = s.decryptKVs(resp.PrevKvs); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	r, err := s.encryptTxn(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.txn(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.decryptTxnResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *EtcdServer) txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
 	// TODO: remove this checking when we release etcd 3.2
 	if s.ClusterVersion() == nil || s.ClusterVersion().LessThan(newRangeClusterVersion) {
 		return s.legacyTxn(ctx, r)
@@ -331,6 +458,17 @@ func (s *EtcdServer) LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, e
 }
 
 func (s *EtcdServer) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
+	resp, err := s.leaseTimeToLive(ctx, r)
+	if err != nil || !r.Keys {
+		return resp, err
+	}
+	if err = s.checkLeaseKeys(ctx, resp.Keys); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *EtcdServer) leaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
 	if s.Leader() == s.ID() {
 		// primary; timetolive directly from leader
 		le := s.lessor.Lookup(lease.LeaseID(r.ID))
@@ -373,6 +511,64 @@ func (s *EtcdServer) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveR
 	return nil, ErrTimeout
 }
 
+func (s *EtcdServer) LeaseTimeToLives(ctx context.Context, r *pb.LeaseTimeToLivesRequest) (*pb.LeaseTimeToLivesResponse, error) {
+	resp, err := s.leaseTimeToLives(ctx, r)
+	if err != nil || !r.Keys {
+		return resp, err
+	}
+	for _, l := range resp.Leases {
+		if err = s.checkLeaseKeys(ctx, l.Keys); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *EtcdServer) leaseTimeToLives(ctx context.Context, r *pb.LeaseTimeToLivesRequest) (*pb.LeaseTimeToLivesResponse, error) {
+	if s.Leader() == s.ID() {
+		// primary; timetolive directly from leader
+		return leasehttp.TimeToLives(s.lessor, r), nil
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, s.Cfg.ReqTimeout())
+	defer cancel()
+
+	// forward to leader
+	for cctx.Err() == nil {
+		leader, err := s.waitLeader(cctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, url := range leader.PeerURLs {
+			lurl := url + leasehttp.LeaseInternalPrefix
+			resp, err := leasehttp.TimeToLivesHTTP(cctx, r, lurl, s.peerRt)
+			if err == nil {
+				return resp.LeaseTimeToLivesResponse, nil
+			}
+		}
+	}
+	return nil, ErrTimeout
+}
+
+// checkLeaseKeys checks that the user of ctx may read the keys attached to
+// a lease.
+func (s *EtcdServer) checkLeaseKeys(ctx context.Context, keys [][]byte) error {
+	ai, err := s.AuthStore().AuthInfoFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	if ai == nil {
+		// IsRangePermitted expects non-nil AuthInfo; use empty credentials
+		ai = &auth.AuthInfo{}
+	}
+	for _, key := range keys {
+		if err = s.AuthStore().IsRangePermitted(ai, key, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *EtcdServer) waitLeader(ctx context.Context) (*membership.Member, error) {
 	leader := s.cluster.Member(s.Leader())
 	for leader == nil {
@@ -404,6 +600,64 @@ func (s *EtcdServer) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmRe
 	return result.resp.(*pb.AlarmResponse), nil
 }
 
+func (s *EtcdServer) QuotaSet(ctx context.Context, r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error) {
+	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{QuotaSet: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.QuotaSetResponse), nil
+}
+
+// ValueKeyRotate adds a new data key to an encrypted prefix. The values of
+// the prefix written afterwards are encrypted with the new key.
+func (s *EtcdServer) ValueKeyRotate(ctx context.Context, r *pb.ValueKeyRotateRequest) (*pb.ValueKeyRotateResponse, error) {
+	k, err := s.valueKeys.NewKey(r.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	return s.addValueKey(ctx, &pb.InternalValueKeyAddRequest{Key: k})
+}
+
+func (s *EtcdServer) QuotaGet(ctx context.Context, r *pb.QuotaGetRequest) (*pb.QuotaGetResponse, error) {
+	if err := s.linearizableReadNotify(ctx); err != nil {
+		return nil, err
+	}
+	var resp *pb.QuotaGetResponse
+	var err error
+	get := func() {
+		q := s.quotaStore.Get(r.Prefix)
+		if q == nil {
+			resp, err = nil, quota.ErrQuotaNotFound
+			return
+		}
+		var u pb.QuotaUsage
+		if u, err = s.quotaStore.Usage(s.KV(), r.Prefix); err != nil {
+			resp = nil
+			return
+		}
+		resp = &pb.QuotaGetResponse{Header: newHeader(s), Quota: q, Usage: &u}
+	}
+	if serr := s.doSerialize(ctx, s.authStore.IsAdminPermitted, get); serr != nil {
+		return nil, serr
+	}
+	return resp, err
+}
+
+func (s *EtcdServer) QuotaList(ctx context.Context, r *pb.QuotaListRequest) (*pb.QuotaListResponse, error) {
+	if err := s.linearizableReadNotify(ctx); err != nil {
+		return nil, err
+	}
+	var resp *pb.QuotaListResponse
+	get := func() { resp = &pb.QuotaListResponse{Header: newHeader(s), Quotas: s.quotaStore.List()} }
+	if serr := s.doSerialize(ctx, s.authStore.IsAdminPermitted, get); serr != nil {
+		return nil, serr
+	}
+	return resp, nil
+}
+
 func (s *EtcdServer) AuthEnable(ctx context.Context, r *pb.AuthEnableRequest) (*pb.AuthEnableResponse, error) {
 	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{AuthEnable: r})
 	if err != nil {
@@ -438,6 +692,9 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 		checkedRevision, err := s.AuthStore().CheckPassword(r.Name, r.Password)
 		if err != nil {
 			plog.Errorf("invalid authentication request to user %s was issued", r.Name)
+			if err == auth.ErrAuthFailed {
+				s.authenticateFailed(ctx, r.Name)
+			}
 			return nil, err
 		}
 
@@ -450,6 +707,8 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 			Name:        r.Name,
 			Password:    r.Password,
 			SimpleToken: st,
+			MemberId:    uint64(s.ID()),
+			Timestamp:   time.Now().Unix(),
 		}
 
 		result, err = s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{Authenticate: internalReq})
@@ -471,7 +730,36 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 	return result.resp.(*pb.AuthenticateResponse), nil
 }
 
+// authenticateFailed records a failed password authentication of user
+// if the password policy locks users out.
+func (s *EtcdServer) authenticateFailed(ctx context.Context, user string) {
+	p := s.AuthStore().PasswordPolicy()
+	if p.MaxFailedAttempts <= 0 {
+		return
+	}
+	if _, err := s.AuthStore().UserGet(&pb.AuthUserGetRequest{Name: user}); err != nil {
+		// unknown users are not recorded
+		return
+	}
+	r := &pb.InternalAuthenticateFailedRequest{
+		Name:              user,
+		Timestamp:         time.Now().Unix(),
+		MaxFailedAttempts: int64(p.MaxFailedAttempts),
+		LockoutSeconds:    int64(p.LockoutDuration / time.Second),
+	}
+	if _, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{AuthenticateFailed: r}); err != nil {
+		plog.Warningf("failed to record the failed authentication of user %s (%v)", user, err)
+	}
+}
+
 func (s *EtcdServer) UserAdd(ctx context.Context, r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse, error) {
+	if r.Options == nil || !r.Options.NoPassword {
+		if err := s.AuthStore().CheckPasswordPolicy(r.Password); err != nil {
+			return nil, err
+		}
+	}
+	// the members apply the same password change time
+	r.Timestamp = time.Now().Unix()
 	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthUserAdd: r})
 	if err != nil {
 		return nil, err
@@ -494,6 +782,10 @@ func (s *EtcdServer) UserDelete(ctx context.Context, r *pb.AuthUserDeleteRequest
 }
 
 func (s *EtcdServer) UserChangePassword(ctx context.Context, r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
+	if err := s.AuthStore().CheckPasswordPolicy(r.Password); err != nil {
+		return nil, err
+	}
+	r.Timestamp = time.Now().Unix()
 	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthUserChangePassword: r})
 	if err != nil {
 		return nil, err
@@ -592,6 +884,80 @@ func (s *EtcdServer) RoleList(ctx context.Context, r *pb.AuthRoleListRequest) (*
 	return result.resp.(*pb.AuthRoleListResponse), nil
 }
 
+func (s *EtcdServer) RateLimitSet(ctx context.Context, r *pb.AuthRateLimitSetRequest) (*pb.AuthRateLimitSetResponse, error) {
+	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthRateLimitSet: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.AuthRateLimitSetResponse), nil
+}
+
+func (s *EtcdServer) RateLimitList(ctx context.Context, r *pb.AuthRateLimitListRequest) (*pb.AuthRateLimitListResponse, error) {
+	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthRateLimitList: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.AuthRateLimitListResponse), nil
+}
+
+func (s *EtcdServer) TokenList(ctx context.Context, r *pb.AuthTokenListRequest) (*pb.AuthTokenListResponse, error) {
+	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthTokenList: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.AuthTokenListResponse), nil
+}
+
+func (s *EtcdServer) TokenRevoke(ctx context.Context, r *pb.AuthTokenRevokeRequest) (*pb.AuthTokenRevokeResponse, error) {
+	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthTokenRevoke: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.AuthTokenRevokeResponse), nil
+}
+
+func (s *EtcdServer) AuthExport(ctx context.Context, r *pb.AuthExportRequest) (*pb.AuthExportResponse, error) {
+	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthExport: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.AuthExportResponse), nil
+}
+
+func (s *EtcdServer) AuthImport(ctx context.Context, r *pb.AuthImportRequest) (*pb.AuthImportResponse, error) {
+	// the members apply the same password change time to the imported
+	// passwords without one
+	now := time.Now().Unix()
+	for _, u := range r.Users {
+		if u != nil && len(u.Password) != 0 && u.PasswordChanged == 0 {
+			u.PasswordChanged = now
+		}
+	}
+	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthImport: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.AuthImportResponse), nil
+}
+
 func (s *EtcdServer) RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error) {
 	result, err := s.processInternalRaftRequest(ctx, pb.InternalRaftRequest{AuthRoleRevokePermission: r})
 	if err != nil {
@@ -614,6 +980,10 @@ func (s *EtcdServer) RoleDelete(ctx context.Context, r *pb.AuthRoleDeleteRequest
 	return result.resp.(*pb.AuthRoleDeleteResponse), nil
 }
 
+// internalRequestKey marks the context of a request issued by etcdserver
+// itself; the request bypasses the permission checks.
+type internalRequestKey struct{}
+
 // doSerialize handles the auth logic, with permissions checked by "chk", for a serialized request "get". Returns a non-nil error on authentication failure.
 func (s *EtcdServer) doSerialize(ctx context.Context, chk func(*auth.AuthInfo) error, get func()) error {
 	for {
@@ -649,7 +1019,8 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	}
 
 	r.Header = &pb.RequestHeader{
-		ID: s.reqIDGen.Next(),
+		ID:        s.reqIDGen.Next(),
+		Timestamp: time.Now().UnixNano(),
 	}
 
 	authInfo, err := s.AuthStore().AuthInfoFromCtx(ctx)
@@ -659,6 +1030,10 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	if authInfo != nil {
 		r.Header.Username = authInfo.Username
 		r.Header.AuthRevision = authInfo.Revision
+		r.Header.Roles = authInfo.Roles
+	}
+	if ctx.Value(internalRequestKey{}) != nil {
+		r.Header.Internal = true
 	}
 
 	data, err := r.Marshal()
@@ -0,0 +1,213 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"etcd/auth"
+	"etcd/envelope"
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc/mvccpb"
+	"golang.org/x/net/context"
+)
+
+// The values of the encrypted prefixes are encrypted by the member proposing
+// the write, before the request is proposed: the WAL, the backend and the
+// snapshots of every member only hold the encrypted values, and all members
+// apply the same encrypted values. The values are decrypted once the
+// permissions of the reading user are checked.
+
+// encryptPut returns r with its value encrypted if its key is in an
+// encrypted prefix, or r itself otherwise.
+func (s *EtcdServer) encryptPut(ctx context.Context, r *pb.PutRequest) (*pb.PutRequest, error) {
+	p := s.valueKeys.Prefix(r.Key)
+	if p == nil {
+		return r, nil
+	}
+	if _, ok := s.valueKeys.ActiveKey(p); !ok {
+		// the data key is added before the put is proposed, so only the
+		// users that may put the key add it
+		if err := s.checkPutPermitted(ctx, r.Key); err != nil {
+			return nil, err
+		}
+		if err := s.addInitialValueKey(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+	v, err := s.valueKeys.Encrypt(r.Key, r.Value)
+	if err != nil {
+		return nil, err
+	}
+	nr := *r
+	nr.Value = v
+	return &nr, nil
+}
+
+// checkValueRange rejects sorting by value or looking up a value index
+// over a range holding encrypted values, since the applier would sort and
+// look up the encrypted values.
+func (s *EtcdServer) checkValueRange(r *pb.RangeRequest) error {
+	if r.SortTarget != pb.RangeRequest_VALUE && r.Index == "" {
+		return nil
+	}
+	if s.valueKeys.EncryptedRange(r.Key, r.RangeEnd) {
+		return envelope.ErrValueCompare
+	}
+	return nil
+}
+
+// encryptTxn returns r with the values of its puts encrypted.
+func (s *EtcdServer) encryptTxn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnRequest, error) {
+	for _, c := range r.Compare {
+		// encrypted values never compare equal
+		if c.Target == pb.Compare_VALUE && s.valueKeys.Encrypted(c.Key) {
+			return nil, envelope.ErrValueCompare
+		}
+	}
+	for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, op := range ops {
+			if rr := op.GetRequestRange(); rr != nil {
+				if err := s.checkValueRange(rr); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if isTxnReadonly(r) {
+		return r, nil
+	}
+	nr := *r
+	var err error
+	if nr.Success, err = s.encryptOps(ctx, r.Success); err != nil {
+		return nil, err
+	}
+	if nr.Failure, err = s.encryptOps(ctx, r.Failure); err != nil {
+		return nil, err
+	}
+	return &nr, nil
+}
+
+func (s *EtcdServer) encryptOps(ctx context.Context, ops []*pb.RequestOp) ([]*pb.RequestOp, error) {
+	ret := make([]*pb.RequestOp, len(ops))
+	for i, op := range ops {
+		ret[i] = op
+		p := op.GetRequestPut()
+		if p == nil {
+			continue
+		}
+		np, err := s.encryptPut(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if np != p {
+			ret[i] = &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: np}}
+		}
+	}
+	return ret, nil
+}
+
+// checkPutPermitted checks that the user of ctx may put key.
+func (s *EtcdServer) checkPutPermitted(ctx context.Context, key []byte) error {
+	ai, err := s.AuthStore().AuthInfoFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	if ai == nil {
+		// IsPutPermitted expects non-nil AuthInfo; use empty credentials
+		ai = &auth.AuthInfo{}
+	}
+	return s.AuthStore().IsPutPermitted(ai, key)
+}
+
+// addInitialValueKey adds the first data key of an encrypted prefix. The
+// request is idempotent, so members writing to the prefix at the same time
+// agree on the key.
+func (s *EtcdServer) addInitialValueKey(ctx context.Context, prefix []byte) error {
+	k, err := s.valueKeys.NewKey(prefix)
+	if err != nil {
+		return err
+	}
+	_, err = s.addValueKey(ctx, &pb.InternalValueKeyAddRequest{Key: k, Initial: true})
+	return err
+}
+
+func (s *EtcdServer) addValueKey(ctx context.Context, r *pb.InternalValueKeyAddRequest) (*pb.ValueKeyRotateResponse, error) {
+	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{ValueKeyAdd: r})
+	if err != nil {
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.resp.(*pb.ValueKeyRotateResponse), nil
+}
+
+// DecryptKV returns kv with its value decrypted, or kv itself if its value
+// is not encrypted. kv is not modified.
+func (s *EtcdServer) DecryptKV(kv *mvccpb.KeyValue) (*mvccpb.KeyValue, error) {
+	if kv == nil || len(kv.Value) == 0 || !s.valueKeys.Encrypted(kv.Key) {
+		return kv, nil
+	}
+	v, err := s.valueKeys.Decrypt(kv.Key, kv.Value)
+	if err != nil {
+		plog.Warningf("cannot decrypt the value of key %q (%v)", kv.Key, err)
+		return nil, err
+	}
+	nkv := *kv
+	nkv.Value = v
+	return &nkv, nil
+}
+
+func (s *EtcdServer) decryptKVs(kvs []*mvccpb.KeyValue) error {
+	for i, kv := range kvs {
+		dkv, err := s.DecryptKV(kv)
+		if err != nil {
+			return err
+		}
+		kvs[i] = dkv
+	}
+	return nil
+}
+
+func (s *EtcdServer) decryptTxnResponse(resp *pb.TxnResponse) error {
+	for _, r := range resp.Responses {
+		var err error
+		switch {
+		case r.GetResponseRange() != nil:
+			err = s.decryptKVs(r.GetResponseRange().Kvs)
+		case r.GetResponsePut() != nil:
+			rp := r.GetResponsePut()
+			rp.PrevKv, err = s.DecryptKV(rp.PrevKv)
+		case r.GetResponseDeleteRange() != nil:
+			err = s.decryptKVs(r.GetResponseDeleteRange().PrevKvs)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *EtcdServer) decryptEvents(evs []*mvccpb.Event) error {
+	for _, ev := range evs {
+		var err error
+		if ev.Kv, err = s.DecryptKV(ev.Kv); err != nil {
+			return err
+		}
+		if ev.PrevKv, err = s.DecryptKV(ev.PrevKv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -34,6 +34,8 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
+	"etcd/audit"
+	"etcd/auth"
 	"etcd/client"
 	"etcd/clientv3"
 	"etcd/etcdserver"
@@ -41,6 +43,8 @@ import (
 	"etcd/etcdserver/api/v2http"
 	"etcd/etcdserver/api/v3rpc"
 	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc"
+	"etcd/pkg/encryption"
 	"etcd/pkg/testutil"
 	"etcd/pkg/transport"
 	"etcd/pkg/types"
@@ -82,6 +86,19 @@ type ClusterConfig struct {
 	DiscoveryURL      string
 	UseGRPC           bool
 	QuotaBackendBytes int64
+	ValueIndexes      []mvcc.ValueIndex
+	// ClientCertIdentity maps the client certificates to auth users
+	ClientCertIdentity *auth.CertIdentity
+	// PasswordPolicy is the policy of the passwords of auth users
+	PasswordPolicy auth.PasswordPolicy
+	// AuthOIDC verifies the OIDC tokens of auth users
+	AuthOIDC *auth.OIDC
+	// ValueEncryptionPrefixes are the prefixes whose values are encrypted
+	// with data keys wrapped with ValueEncryptionKeys
+	ValueEncryptionPrefixes [][]byte
+	ValueEncryptionKeys     encryption.KeyProvider
+	// AuditSink stores the audit records of the members
+	AuditSink audit.Sink
 }
 
 type cluster struct {
@@ -214,6 +231,13 @@ func (c *cluster) mustNewMember(t *testing.T) *member {
 			peerTLS:           c.cfg.PeerTLS,
 			clientTLS:         c.cfg.ClientTLS,
 			quotaBackendBytes: c.cfg.QuotaBackendBytes,
+			valueIndexes:      c.cfg.ValueIndexes,
+			certIdentity:      c.cfg.ClientCertIdentity,
+			passwordPolicy:    c.cfg.PasswordPolicy,
+			oidc:              c.cfg.AuthOIDC,
+			auditSink:         c.cfg.AuditSink,
+			valueEncPrefixes:  c.cfg.ValueEncryptionPrefixes,
+			valueEncKeys:      c.cfg.ValueEncryptionKeys,
 		})
 	m.DiscoveryURL = c.cfg.DiscoveryURL
 	if c.cfg.UseGRPC {
@@ -460,6 +484,13 @@ type memberConfig struct {
 	peerTLS           *transport.TLSInfo
 	clientTLS         *transport.TLSInfo
 	quotaBackendBytes int64
+	valueIndexes      []mvcc.ValueIndex
+	certIdentity      *auth.CertIdentity
+	passwordPolicy    auth.PasswordPolicy
+	oidc              *auth.OIDC
+	auditSink         audit.Sink
+	valueEncPrefixes  [][]byte
+	valueEncKeys      encryption.KeyProvider
 }
 
 // mustNewMember return an inited member with the given name. If peerTLS is
@@ -507,6 +538,15 @@ func mustNewMember(t *testing.T, mcfg memberConfig) *member {
 	m.ElectionTicks = electionTicks
 	m.TickMs = uint(tickDuration / time.Millisecond)
 	m.QuotaBackendBytes = mcfg.quotaBackendBytes
+	m.ValueIndexes = mcfg.valueIndexes
+	m.ClientCertIdentity = mcfg.certIdentity
+	m.AuthPasswordPolicy = mcfg.passwordPolicy
+	m.AuthOIDC = mcfg.oidc
+	m.ValueEncryptionPrefixes = mcfg.valueEncPrefixes
+	m.ValueEncryptionKeys = mcfg.valueEncKeys
+	if mcfg.auditSink != nil {
+		m.Audit = audit.NewLogger(mcfg.auditSink, audit.Rules{})
+	}
 	return m
 }
 
@@ -0,0 +1,115 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"sync"
+	"testing"
+
+	"etcd/audit"
+	"etcd/auth/authpb"
+	"etcd/clientv3"
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/pkg/testutil"
+	"golang.org/x/net/context"
+)
+
+type auditRecorder struct {
+	mu   sync.Mutex
+	recs []audit.Record
+}
+
+func (ar *auditRecorder) Write(r *audit.Record) error {
+	ar.mu.Lock()
+	ar.recs = append(ar.recs, *r)
+	ar.mu.Unlock()
+	return nil
+}
+
+func (ar *auditRecorder) Close() error { return nil }
+
+func (ar *auditRecorder) records() []audit.Record {
+	ar.mu.Lock()
+	defer ar.mu.Unlock()
+	return append([]audit.Record(nil), ar.recs...)
+}
+
+// TestV3AuditLog ensures the member serving mutations records them with
+// their user and outcome.
+func TestV3AuditLog(t *testing.T) {
+	defer testutil.AfterTest(t)
+	ar := &auditRecorder{}
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, AuditSink: ar})
+	defer clus.Terminate(t)
+
+	api := toGRPC(clus.Client(0))
+	ctx := context.TODO()
+	if _, err := api.Auth.UserAdd(ctx, &pb.AuthUserAddRequest{Name: "alice", Password: "123"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Auth.RoleAdd(ctx, &pb.AuthRoleAddRequest{Name: "writer"}); err != nil {
+		t.Fatal(err)
+	}
+	perm := &authpb.Permission{PermType: authpb.WRITE, Key: []byte("foo")}
+	if _, err := api.Auth.RoleGrantPermission(ctx, &pb.AuthRoleGrantPermissionRequest{Name: "writer", Perm: perm}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Auth.UserGrantRole(ctx, &pb.AuthUserGrantRoleRequest{User: "alice", Role: "writer"}); err != nil {
+		t.Fatal(err)
+	}
+	authSetupRoot(t, api.Auth)
+
+	c, cerr := clientv3.New(clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "alice", Password: "123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer c.Close()
+	if _, err := c.Put(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Put(ctx, "bar", "foo"); err == nil {
+		t.Fatal("expected permission denied")
+	}
+	// reads are not audited
+	if _, err := c.Get(ctx, "foo"); err == nil {
+		t.Fatal("expected permission denied")
+	}
+
+	recs := ar.records()
+	// the put of alice and the denied put, after its authentication
+	if len(recs) < 3 {
+		t.Fatalf("len(records) = %d, want >= 3", len(recs))
+	}
+	put, denied := recs[len(recs)-2], recs[len(recs)-1]
+	if put.Method != "/etcdserverpb.KV/Put" || put.User != "alice" || put.Outcome != audit.OutcomeSuccess {
+		t.Fatalf("unexpected put record %+v", put)
+	}
+	if len(put.Ranges) != 1 || put.Ranges[0].Key != "foo" || put.Revision == 0 || put.Source == "" {
+		t.Fatalf("unexpected put record %+v", put)
+	}
+	if denied.Ranges[0].Key != "bar" || denied.Outcome != audit.OutcomeFailure || denied.Error == "" {
+		t.Fatalf("unexpected denied put record %+v", denied)
+	}
+
+	methods := make(map[string]bool)
+	for _, r := range recs {
+		methods[r.Method] = true
+	}
+	for _, m := range []string{"/etcdserverpb.Auth/UserAdd", "/etcdserverpb.Auth/RoleGrantPermission", "/etcdserverpb.Auth/AuthEnable", "/etcdserverpb.Auth/Authenticate"} {
+		if !methods[m] {
+			t.Errorf("no record of %s", m)
+		}
+	}
+}
@@ -15,11 +15,21 @@
 package integration
 
 import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"golang.org/x/net/context"
 
+	"etcd/auth"
+	"etcd/auth/authpb"
 	"etcd/clientv3"
 	"etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "etcd/etcdserver/etcdserverpb"
@@ -118,3 +128,559 @@ func authSetupRoot(t *testing.T, auth pb.AuthClient) {
 		t.Fatal(err)
 	}
 }
+
+// TestV3AuthClientCertIdentity ensures that a client authenticated by its
+// certificate acts as the user of the certificate without a token.
+func TestV3AuthClientCertIdentity(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, ClientTLS: &testTLSInfo, ClientCertIdentity: &auth.CertIdentity{}})
+	defer clus.Terminate(t)
+
+	api := toGRPC(clus.Client(0))
+	ctx := context.TODO()
+	// the CommonName of the client certificate
+	if _, err := api.Auth.UserAdd(ctx, &pb.AuthUserAddRequest{Name: "example.com", Password: "123"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Auth.RoleAdd(ctx, &pb.AuthRoleAddRequest{Name: "reader"}); err != nil {
+		t.Fatal(err)
+	}
+	perm := &authpb.Permission{PermType: authpb.READ, Key: []byte("foo")}
+	if _, err := api.Auth.RoleGrantPermission(ctx, &pb.AuthRoleGrantPermissionRequest{Name: "reader", Perm: perm}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Auth.UserGrantRole(ctx, &pb.AuthUserGrantRoleRequest{User: "example.com", Role: "reader"}); err != nil {
+		t.Fatal(err)
+	}
+	authSetupRoot(t, api.Auth)
+
+	if _, err := api.KV.Range(ctx, &pb.RangeRequest{Key: []byte("foo")}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := api.KV.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+
+	// a token takes precedence over the certificate
+	cfg := clientv3.Config{
+		Endpoints:   clus.Client(0).Endpoints(),
+		DialTimeout: 5 * time.Second,
+		Username:    "root",
+		Password:    "123",
+	}
+	tls, cerr := testTLSInfo.ClientConfig()
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	cfg.TLS = tls
+	rc, cerr := clientv3.New(cfg)
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer rc.Close()
+	if _, err = rc.Put(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestV3AuthPasswordPolicy ensures the password policy is enforced and
+// locks out users after consecutive failed authentications.
+func TestV3AuthPasswordPolicy(t *testing.T) {
+	defer testutil.AfterTest(t)
+	policy := auth.PasswordPolicy{MinLength: 3, MaxFailedAttempts: 2, LockoutDuration: time.Minute}
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, PasswordPolicy: policy})
+	defer clus.Terminate(t)
+
+	api := toGRPC(clus.Client(0))
+	ctx := context.TODO()
+	_, err := api.Auth.UserAdd(ctx, &pb.AuthUserAddRequest{Name: "foo", Password: "ba"})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPasswordTooShort) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPasswordTooShort)
+	}
+	if _, err = api.Auth.UserAdd(ctx, &pb.AuthUserAddRequest{Name: "foo", Password: "bar"}); err != nil {
+		t.Fatal(err)
+	}
+	nopass := &pb.AuthUserAddRequest{Name: "nopass", Options: &authpb.UserAddOptions{NoPassword: true}}
+	if _, err = api.Auth.UserAdd(ctx, nopass); err != nil {
+		t.Fatal(err)
+	}
+	authSetupRoot(t, api.Auth)
+
+	_, err = api.Auth.Authenticate(ctx, &pb.AuthenticateRequest{Name: "nopass", Password: ""})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCAuthFailed) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCAuthFailed)
+	}
+
+	for i := 0; i < policy.MaxFailedAttempts; i++ {
+		_, err = api.Auth.Authenticate(ctx, &pb.AuthenticateRequest{Name: "foo", Password: "baz"})
+		if !eqErrGRPC(err, rpctypes.ErrGRPCAuthFailed) {
+			t.Fatalf("#%d: got %v, expected %v", i, err, rpctypes.ErrGRPCAuthFailed)
+		}
+	}
+	_, err = api.Auth.Authenticate(ctx, &pb.AuthenticateRequest{Name: "foo", Password: "bar"})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCUserLockedOut) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCUserLockedOut)
+	}
+}
+
+// TestV3AuthDelegatedAdmin ensures that a delegated admin manages the users
+// and roles of its prefix only, and that denied permissions take precedence.
+func TestV3AuthDelegatedAdmin(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.Client(0)
+	if _, err := cli.RoleAddWithOptions(ctx, "tenant-admin", &clientv3.RoleAddOptions{Admin: true, AdminPrefix: []byte("/tenant/")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.UserAdd(ctx, "admin", "admin"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.UserGrantRole(ctx, "admin", "tenant-admin"); err != nil {
+		t.Fatal(err)
+	}
+	authSetupRoot(t, toGRPC(cli).Auth)
+
+	ac, err := clientv3.New(clientv3.Config{Endpoints: cli.Endpoints(), Username: "admin", Password: "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ac.Close()
+
+	if _, err = ac.RoleList(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ac.RoleAdd(ctx, "app"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ac.RoleGrantPermission(ctx, "app", "/tenant/", clientv3.GetPrefixRangeEnd("/tenant/"), clientv3.PermissionType(clientv3.PermReadWrite)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ac.RoleDenyPermission(ctx, "app", "/tenant/secret", "", clientv3.PermissionType(clientv3.PermRead)); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ac.RoleGrantPermission(ctx, "app", "/other/", "", clientv3.PermissionType(clientv3.PermRead))
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+	if _, err = ac.UserAdd(ctx, "app", "app"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ac.UserGrantRole(ctx, "app", "app"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ac.UserGrantRole(ctx, "app", "root")
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+	_, err = ac.AuthDisable(ctx)
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+
+	uc, err := clientv3.New(clientv3.Config{Endpoints: cli.Endpoints(), Username: "app", Password: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer uc.Close()
+
+	if _, err = uc.Put(ctx, "/tenant/secret", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = uc.Get(ctx, "/tenant/config"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = uc.Get(ctx, "/tenant/secret")
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+	_, err = uc.Get(ctx, "/tenant/", clientv3.WithPrefix())
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+}
+
+// TestV3AuthLeaseKeys ensures that revoking a lease and listing its keys
+// require the permissions on the keys attached to the lease, and that
+// expired leases still delete their keys.
+func TestV3AuthLeaseKeys(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.Client(0)
+	authSetupUserPrefix(t, cli, "alice", "a/", clientv3.PermissionType(clientv3.PermReadWrite))
+	authSetupUserPrefix(t, cli, "bob", "b/", clientv3.PermissionType(clientv3.PermReadWrite))
+	authSetupRoot(t, toGRPC(cli).Auth)
+
+	ac := authClient(t, clus, "alice")
+	defer ac.Close()
+	bc := authClient(t, clus, "bob")
+	defer bc.Close()
+
+	lresp, err := ac.Grant(ctx, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ac.Put(ctx, "a/1", "v", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = bc.TimeToLive(ctx, lresp.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = bc.TimeToLive(ctx, lresp.ID, clientv3.WithAttachedKeys())
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+	_, err = bc.Revoke(ctx, lresp.ID)
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+	if _, err = ac.TimeToLive(ctx, lresp.ID, clientv3.WithAttachedKeys()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ac.Revoke(ctx, lresp.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// the expired lease of alice deletes her key
+	lresp, err = ac.Grant(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ac.Put(ctx, "a/2", "v", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; ; i++ {
+		gresp, gerr := ac.Get(ctx, "a/2")
+		if gerr != nil {
+			t.Fatal(gerr)
+		}
+		if len(gresp.Kvs) == 0 {
+			break
+		}
+		if i == 100 {
+			t.Fatal("expired lease did not delete its key")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// TestV3AuthWatch ensures that watching requires the READ or the WATCH
+// permission on the watched range.
+func TestV3AuthWatch(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.Client(0)
+	authSetupUserPrefix(t, cli, "alice", "a/", clientv3.PermissionType(clientv3.PermReadWrite))
+	if _, err := cli.RoleGrantPermission(ctx, "alice", "w/", clientv3.GetPrefixRangeEnd("w/"), clientv3.PermissionType(clientv3.PermWatch)); err != nil {
+		t.Fatal(err)
+	}
+	// the empty key watches the smallest key, \x00
+	if _, err := cli.RoleGrantPermission(ctx, "alice", "\x00", "", clientv3.PermissionType(clientv3.PermWatch)); err != nil {
+		t.Fatal(err)
+	}
+	authSetupRoot(t, toGRPC(cli).Auth)
+
+	ac := authClient(t, clus, "alice")
+	defer ac.Close()
+
+	_, err := ac.Get(ctx, "w/1")
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+
+	for _, prefix := range []string{"a/", "w/"} {
+		wctx, cancel := context.WithCancel(ctx)
+		wch := ac.Watch(wctx, prefix, clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+		if wresp := <-wch; !wresp.Created || wresp.Err() != nil {
+			t.Fatalf("%s: expected created watcher, got %+v", prefix, wresp)
+		}
+		cancel()
+	}
+	wctx, cancel := context.WithCancel(ctx)
+	if wresp := <-ac.Watch(wctx, "", clientv3.WithCreatedNotify()); !wresp.Created || wresp.Err() != nil {
+		t.Fatalf("expected created watcher on the empty key, got %+v", wresp)
+	}
+	cancel()
+
+	wch := ac.Watch(ctx, "b/", clientv3.WithPrefix())
+	wresp, ok := <-wch
+	if !ok || !wresp.Canceled {
+		t.Fatalf("expected canceled watcher, got %+v", wresp)
+	}
+	if wresp.Err() != rpctypes.ErrPermissionDenied {
+		t.Fatalf("got %v, expected %v", wresp.Err(), rpctypes.ErrPermissionDenied)
+	}
+	if _, ok = <-wch; ok {
+		t.Fatal("expected closed watch channel")
+	}
+}
+
+func TestV3AuthOIDC(t *testing.T) {
+	defer testutil.AfterTest(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oidc := &auth.OIDC{
+		Issuer:         "https://sso.example.com",
+		UsernamePrefix: "sso:",
+		GroupsClaim:    "groups",
+		GroupsPrefix:   "sso-",
+		Keys:           map[string]crypto.PublicKey{"k1": &key.PublicKey},
+	}
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3, AuthOIDC: oidc})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.Client(0)
+	for role, prefix := range map[string]string{"sso-readers": "r/", "sso-writers": "w/"} {
+		if _, err = cli.RoleAdd(ctx, role); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = cli.RoleGrantPermission(ctx, role, prefix, clientv3.GetPrefixRangeEnd(prefix), clientv3.PermissionType(clientv3.PermReadWrite)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	authSetupRoot(t, toGRPC(cli).Auth)
+
+	token := func(exp time.Time, groups ...string) string {
+		return signOIDCToken(t, key, map[string]interface{}{
+			"iss":    oidc.Issuer,
+			"sub":    "alice",
+			"exp":    exp.Unix(),
+			"groups": groups,
+		})
+	}
+	tc, err := clientv3.New(clientv3.Config{
+		Endpoints: clus.Client(1).Endpoints(),
+		Token:     token(time.Now().Add(time.Hour), "readers"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tc.Close()
+
+	if _, err = tc.Get(ctx, "r/1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tc.Put(ctx, "w/1", "v"); !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+
+	// the roles of the token are checked by all members applying the put
+	wc, err := clientv3.New(clientv3.Config{
+		Endpoints: clus.Client(2).Endpoints(),
+		Token:     token(time.Now().Add(time.Hour), "readers", "writers"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wc.Close()
+	if _, err = wc.Get(ctx, "w/1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = wc.Put(ctx, "w/1", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = wc.RoleList(ctx); !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+
+	ec, err := clientv3.New(clientv3.Config{
+		Endpoints: clus.Client(1).Endpoints(),
+		Token:     token(time.Now().Add(-time.Minute), "readers"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ec.Close()
+	if _, err = ec.Get(ctx, "r/1"); !eqErrGRPC(err, rpctypes.ErrGRPCInvalidAuthToken) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCInvalidAuthToken)
+	}
+}
+
+// signOIDCToken signs a JWT with the claims by RS256 with the key "k1".
+func signOIDCToken(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	enc := base64.RawURLEncoding.EncodeToString
+	c, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := enc([]byte(`{"alg":"RS256","kid":"k1"}`)) + "." + enc(c)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + enc(sig)
+}
+
+// authSetupUserPrefix adds a user and a role of the same name granted perm
+// on the keys with prefix.
+func authSetupUserPrefix(t *testing.T, cli *clientv3.Client, name, prefix string, perm clientv3.PermissionType) {
+	ctx := context.TODO()
+	if _, err := cli.UserAdd(ctx, name, name); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.RoleAdd(ctx, name); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.RoleGrantPermission(ctx, name, prefix, clientv3.GetPrefixRangeEnd(prefix), perm); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.UserGrantRole(ctx, name, name); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// authClient returns a client of clus authenticated as the user name,
+// whose password is its name.
+func authClient(t *testing.T, clus *ClusterV3, name string) *clientv3.Client {
+	cfg := clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: name, Password: name}
+	c, err := clientv3.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// TestV3AuthTokenListRevoke ensures that the tokens are listed with their
+// issuing members and that a revoked token is rejected by all members.
+func TestV3AuthTokenListRevoke(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.Client(0)
+	authSetupUserPrefix(t, cli, "alice", "a/", clientv3.PermissionType(clientv3.PermReadWrite))
+	authSetupRoot(t, toGRPC(cli).Auth)
+
+	aresp, err := toGRPC(clus.Client(1)).Auth.Authenticate(ctx, &pb.AuthenticateRequest{Name: "alice", Password: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc, err := clientv3.New(clientv3.Config{Endpoints: clus.Client(2).Endpoints(), Token: aresp.Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tc.Close()
+	if _, err = tc.Get(ctx, "a/1"); err != nil {
+		t.Fatal(err)
+	}
+
+	rc, err := clientv3.New(clientv3.Config{Endpoints: cli.Endpoints(), Username: "root", Password: "123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	lresp, err := rc.TokenList(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var token *pb.AuthToken
+	for _, tk := range lresp.Tokens {
+		if tk.User == "alice" {
+			token = tk
+		}
+	}
+	if token == nil {
+		t.Fatalf("token of alice not in %+v", lresp.Tokens)
+	}
+	if id := uint64(clus.Members[1].s.ID()); token.MemberId != id || token.Created == 0 {
+		t.Fatalf("token = %+v, want issued by %x", token, id)
+	}
+	if !strings.HasSuffix(aresp.Token, fmt.Sprintf(".%d", token.ID)) {
+		t.Fatalf("token ID %d does not match token %q", token.ID, aresp.Token)
+	}
+
+	if _, err = rc.TokenRevoke(ctx, token.ID); err != nil {
+		t.Fatal(err)
+	}
+	// the revocation may not be applied yet by the member serving alice
+	for i := 0; ; i++ {
+		_, err = tc.Get(ctx, "a/1")
+		if eqErrGRPC(err, rpctypes.ErrGRPCInvalidAuthToken) {
+			break
+		}
+		if i == 10 {
+			t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCInvalidAuthToken)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if _, err = rc.TokenRevoke(ctx, token.ID); !eqErrGRPC(err, rpctypes.ErrGRPCTokenNotFound) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCTokenNotFound)
+	}
+}
+
+// TestV3AuthExportImport ensures that the users and roles exported from a
+// cluster are imported by another cluster, whose members all authenticate
+// the imported users.
+func TestV3AuthExportImport(t *testing.T) {
+	defer testutil.AfterTest(t)
+	src := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer src.Terminate(t)
+
+	ctx := context.TODO()
+	authSetupUserPrefix(t, src.Client(0), "alice", "a/", clientv3.PermissionType(clientv3.PermReadWrite))
+	authSetupRoot(t, toGRPC(src.Client(0)).Auth)
+
+	rc, err := clientv3.New(clientv3.Config{Endpoints: src.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	eresp, err := rc.AuthExport(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := NewClusterV3(t, &ClusterConfig{Size: 3})
+	defer dst.Terminate(t)
+
+	cli := dst.Client(0)
+	iresp, err := cli.AuthImport(ctx, eresp.Users, eresp.Roles, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(iresp.Changes) != 4 {
+		t.Fatalf("changes = %+v, want 2 users and 2 roles", iresp.Changes)
+	}
+	lresp, err := cli.RoleList(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lresp.Roles) != 0 {
+		t.Fatalf("roles = %v after a dry run, want none", lresp.Roles)
+	}
+
+	if _, err = cli.AuthImport(ctx, eresp.Users, eresp.Roles, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.AuthEnable(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	ac, err := clientv3.New(clientv3.Config{Endpoints: dst.Client(2).Endpoints(), Username: "alice", Password: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ac.Close()
+	if _, err = ac.Put(ctx, "a/1", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ac.Put(ctx, "b/1", "v"); !eqErrGRPC(err, rpctypes.ErrGRPCPermissionDenied) {
+		t.Fatalf("got %v, expected %v", err, rpctypes.ErrGRPCPermissionDenied)
+	}
+}
@@ -22,12 +22,14 @@ import (
 	"testing"
 	"time"
 
+	"etcd/auth/authpb"
 	"etcd/etcdserver/api/v3rpc"
 	"etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "etcd/etcdserver/etcdserverpb"
 	"etcd/pkg/testutil"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -1180,6 +1182,59 @@ func TestGRPCStreamRequireLeader(t *testing.T) {
 	}
 }
 
+// TestGRPCRateLimit ensures the requests of a client over the rate limit
+// of an RPC are rejected, without affecting other clients and RPCs.
+func TestGRPCRateLimit(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	api := toGRPC(clus.Client(0))
+	limit := &authpb.RateLimit{Method: "Range", Key: authpb.TAG, Tag: "tenant", Rate: 1, Burst: 2}
+	if _, err := api.Auth.RateLimitSet(context.TODO(), &pb.AuthRateLimitSetRequest{Limit: limit}); err != nil {
+		t.Fatal(err)
+	}
+
+	tenant := func(name string) context.Context {
+		return metadata.NewContext(context.Background(), metadata.Pairs("tenant", name))
+	}
+	req := &pb.RangeRequest{Key: []byte("foo")}
+	for i := 0; i < 2; i++ {
+		if _, err := api.KV.Range(tenant("a"), req); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+	_, err := api.KV.Range(tenant("a"), req)
+	if !eqErrGRPC(err, rpctypes.ErrGRPCRateLimited) || grpc.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCRateLimited)
+	}
+
+	// the throttled tenant is counted apart
+	v, err := clus.Members[0].Metric(`etcd_server_throttled_requests_total{client="tag:a",method="Range"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "1" {
+		t.Fatalf("throttled requests of tenant a = %q, want 1", v)
+	}
+
+	// other clients and RPCs are not limited
+	if _, err = api.KV.Range(tenant("b"), req); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = api.KV.Put(tenant("a"), &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := api.Auth.RateLimitList(context.TODO(), &pb.AuthRateLimitListRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Limits) != 1 || !reflect.DeepEqual(*resp.Limits[0], *limit) {
+		t.Fatalf("limits = %+v, want %+v", resp.Limits, limit)
+	}
+}
+
 func eqErrGRPC(err1 error, err2 error) bool {
 	return !(err1 == nil && err2 != nil) || err1.Error() == err2.Error()
 }
@@ -22,8 +22,10 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/metadata"
 
+	"etcd/defragger"
 	"etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/lease"
 	"etcd/mvcc/mvccpb"
 	"etcd/pkg/testutil"
 )
@@ -209,6 +211,73 @@ func TestV3LeaseKeepAlive(t *testing.T) {
 	})
 }
 
+// TestV3LeaseReservedID ensures clients cannot use the id of the
+// auto-defragmentation lock lease.
+func TestV3LeaseReservedID(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	id := int64(defragger.LockLeaseID)
+	lc := toGRPC(clus.RandClient()).Lease
+	_, err := lc.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{ID: id, TTL: 60})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCLeaseReserved) {
+		t.Errorf("grant err = %v, want %v", err, rpctypes.ErrGRPCLeaseReserved)
+	}
+	_, err = lc.LeaseRevoke(context.TODO(), &pb.LeaseRevokeRequest{ID: id})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCLeaseReserved) {
+		t.Errorf("revoke err = %v, want %v", err, rpctypes.ErrGRPCLeaseReserved)
+	}
+	_, err = toGRPC(clus.RandClient()).KV.Put(context.TODO(), &pb.PutRequest{Key: []byte("foo"), Lease: id})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCLeaseReserved) {
+		t.Errorf("put err = %v, want %v", err, rpctypes.ErrGRPCLeaseReserved)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lac, err := lc.LeaseKeepAlive(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = lac.Send(&pb.LeaseKeepAliveRequest{ID: id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = lac.Recv(); !eqErrGRPC(err, rpctypes.ErrGRPCLeaseReserved) {
+		t.Errorf("keepalive err = %v, want %v", err, rpctypes.ErrGRPCLeaseReserved)
+	}
+}
+
+// TestV3LeaseDefragLockRecover ensures a restarted member recovers the
+// auto-defragmentation lock lease, so granting it still fails on every member.
+func TestV3LeaseDefragLockRecover(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	req := &pb.LeaseGrantRequest{ID: int64(defragger.LockLeaseID), TTL: 60}
+	if _, err := clus.Members[0].s.LeaseGrant(context.TODO(), req); err != nil {
+		t.Fatal(err)
+	}
+	// persist a consistent index past the grant so the restarted member
+	// does not apply it again from its wal
+	if _, err := toGRPC(clus.Client(1)).KV.Put(context.TODO(), &pb.PutRequest{Key: []byte("foo")}); err != nil {
+		t.Fatal(err)
+	}
+
+	clus.Members[1].Stop(t)
+	clus.Members[1].Restart(t)
+	clus.waitLeader(t, clus.Members)
+
+	for i, m := range clus.Members {
+		if m.s.Lessor().Lookup(defragger.LockLeaseID) == nil {
+			t.Errorf("#%d: lock lease not found", i)
+		}
+	}
+	if _, err := clus.Members[1].s.LeaseGrant(context.TODO(), req); err != lease.ErrLeaseExists {
+		t.Fatalf("err = %v, want %v", err, lease.ErrLeaseExists)
+	}
+}
+
 // TestV3LeaseExists creates a lease on a random client and confirms it exists in the cluster.
 func TestV3LeaseExists(t *testing.T) {
 	defer testutil.AfterTest(t)
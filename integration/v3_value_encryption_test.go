@@ -0,0 +1,219 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"etcd/clientv3"
+	"etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc"
+	"etcd/pkg/encryption"
+	"etcd/pkg/testutil"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// TestV3ValueEncryption ensures the values of an encrypted prefix are
+// stored encrypted and returned in plaintext, across data key rotations.
+func TestV3ValueEncryption(t *testing.T) {
+	defer testutil.AfterTest(t)
+	masters := encryption.NewKeyProvider(encryption.Key{ID: 1, Secret: bytes.Repeat([]byte{1}, 32)})
+	clus := NewClusterV3(t, &ClusterConfig{
+		Size:                    3,
+		ValueEncryptionPrefixes: [][]byte{[]byte("/secret/")},
+		ValueEncryptionKeys:     masters,
+	})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	kvc := toGRPC(cli).KV
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	wch := cli.Watch(ctx, "/secret/", clientv3.WithPrefix())
+
+	if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("/secret/a"), Value: []byte("v1")}, grpc.FailFast(false)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("/plain/a"), Value: []byte("v1")}); err != nil {
+		t.Fatal(err)
+	}
+
+	// every member stores the encrypted value
+	clus.WaitLeader(t)
+	for i, m := range clus.Members {
+		var raw []byte
+		for j := 0; j < 10; j++ {
+			rr, err := m.s.KV().Range([]byte("/secret/a"), nil, mvcc.RangeOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rr.KVs) == 1 {
+				raw = rr.KVs[0].Value
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		if id, ok := encryption.KeyID(raw); !ok || id != 1 {
+			t.Fatalf("#%d: stored value %q is not encrypted with data key 1", i, raw)
+		}
+	}
+
+	resp, err := cli.ValueKeyRotate(ctx, "/secret/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.KeyId != 2 {
+		t.Fatalf("key id = %d, want 2", resp.KeyId)
+	}
+	if _, err = kvc.Put(ctx, &pb.PutRequest{Key: []byte("/secret/b"), Value: []byte("v2")}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the values encrypted with both data keys are readable
+	rr, err := kvc.Range(ctx, &pb.RangeRequest{Key: []byte("/secret/"), RangeEnd: []byte("/secret0")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rr.Kvs) != 2 || string(rr.Kvs[0].Value) != "v1" || string(rr.Kvs[1].Value) != "v2" {
+		t.Fatalf("kvs = %+v, want v1 and v2", rr.Kvs)
+	}
+
+	for _, want := range []string{"v1", "v2"} {
+		select {
+		case wresp := <-wch:
+			if len(wresp.Events) != 1 || string(wresp.Events[0].Kv.Value) != want {
+				t.Fatalf("events = %+v, want value %q", wresp.Events, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the event of value %q", want)
+		}
+	}
+
+	txn := &pb.TxnRequest{Compare: []*pb.Compare{{
+		Key:         []byte("/secret/a"),
+		Target:      pb.Compare_VALUE,
+		Result:      pb.Compare_EQUAL,
+		TargetUnion: &pb.Compare_Value{Value: []byte("v1")},
+	}}}
+	if _, err = kvc.Txn(ctx, txn); rpctypes.Error(err) != rpctypes.ErrEncryptedValueCompare {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrEncryptedValueCompare)
+	}
+
+	// the encrypted values cannot be sorted
+	sr := &pb.RangeRequest{Key: []byte("/"), RangeEnd: []byte("0"), SortTarget: pb.RangeRequest_VALUE}
+	if _, err = kvc.Range(ctx, sr); rpctypes.Error(err) != rpctypes.ErrEncryptedValueCompare {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrEncryptedValueCompare)
+	}
+	txn = &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: sr}}}}
+	if _, err = kvc.Txn(ctx, txn); rpctypes.Error(err) != rpctypes.ErrEncryptedValueCompare {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrEncryptedValueCompare)
+	}
+	sr = &pb.RangeRequest{Key: []byte("/plain/"), RangeEnd: []byte("/plain0"), SortTarget: pb.RangeRequest_VALUE}
+	if _, err = kvc.Range(ctx, sr); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = cli.ValueKeyRotate(ctx, "/plain/"); err != rpctypes.ErrNotEncrypted {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrNotEncrypted)
+	}
+}
+
+// TestV3ValueEncryptionIndex ensures a value index lookup over encrypted
+// values is rejected instead of finding no keys.
+func TestV3ValueEncryptionIndex(t *testing.T) {
+	defer testutil.AfterTest(t)
+	masters := encryption.NewKeyProvider(encryption.Key{ID: 1, Secret: bytes.Repeat([]byte{1}, 32)})
+	clus := NewClusterV3(t, &ClusterConfig{
+		Size:                    1,
+		ValueIndexes:            []mvcc.ValueIndex{{Name: "all", Prefix: "/"}},
+		ValueEncryptionPrefixes: [][]byte{[]byte("/secret/")},
+		ValueEncryptionKeys:     masters,
+	})
+	defer clus.Terminate(t)
+
+	kvc := toGRPC(clus.RandClient()).KV
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, k := range []string{"/secret/a", "/plain/a"} {
+		if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte(k), Value: []byte("v")}, grpc.FailFast(false)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ir := &pb.RangeRequest{Key: []byte("/secret/"), RangeEnd: []byte("/secret0"), Index: "all", IndexValue: []byte("v")}
+	if _, err := kvc.Range(ctx, ir); rpctypes.Error(err) != rpctypes.ErrEncryptedValueCompare {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrEncryptedValueCompare)
+	}
+	txn := &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: ir}}}}
+	if _, err := kvc.Txn(ctx, txn); rpctypes.Error(err) != rpctypes.ErrEncryptedValueCompare {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrEncryptedValueCompare)
+	}
+
+	ir = &pb.RangeRequest{Key: []byte("/plain/"), RangeEnd: []byte("/plain0"), Index: "all", IndexValue: []byte("v")}
+	rr, err := kvc.Range(ctx, ir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rr.Kvs) != 1 || string(rr.Kvs[0].Key) != "/plain/a" {
+		t.Fatalf("kvs = %+v, want /plain/a", rr.Kvs)
+	}
+}
+
+// TestV3ValueEncryptionAuth ensures that a user that may not put a key of
+// an encrypted prefix does not add the data key of the prefix.
+func TestV3ValueEncryptionAuth(t *testing.T) {
+	defer testutil.AfterTest(t)
+	masters := encryption.NewKeyProvider(encryption.Key{ID: 1, Secret: bytes.Repeat([]byte{1}, 32)})
+	clus := NewClusterV3(t, &ClusterConfig{
+		Size:                    1,
+		ValueEncryptionPrefixes: [][]byte{[]byte("/secret/")},
+		ValueEncryptionKeys:     masters,
+	})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.Client(0)
+	authSetupUserPrefix(t, cli, "alice", "/plain/", clientv3.PermissionType(clientv3.PermReadWrite))
+	authSetupRoot(t, toGRPC(cli).Auth)
+
+	ac := authClient(t, clus, "alice")
+	defer ac.Close()
+	if _, err := ac.Put(ctx, "/secret/a", "v"); err != rpctypes.ErrPermissionDenied {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrPermissionDenied)
+	}
+
+	rc, err := clientv3.New(clientv3.Config{Endpoints: cli.Endpoints(), Username: "root", Password: "123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	// the maintenance RPCs fail fast; wait for the connection
+	if _, err = rc.Get(ctx, "/secret/a"); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rc.ValueKeyRotate(ctx, "/secret/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.KeyId != 1 {
+		t.Fatalf("key id = %d, want 1", resp.KeyId)
+	}
+}
@@ -102,6 +102,21 @@ func (h *leaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, ErrLeaseHTTPTimeout.Error(), http.StatusRequestTimeout)
 			return
 		}
+		if lreq.LeaseTimeToLivesRequest != nil {
+			resp := &leasepb.LeaseInternalResponse{
+				LeaseTimeToLivesResponse: TimeToLives(h.l, lreq.LeaseTimeToLivesRequest),
+			}
+			v, err = resp.Marshal()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			break
+		}
+		if lreq.LeaseTimeToLiveRequest == nil {
+			http.Error(w, "empty lease internal request", http.StatusBadRequest)
+			return
+		}
 		l := h.l.Lookup(lease.LeaseID(lreq.LeaseTimeToLiveRequest.ID))
 		if l == nil {
 			http.Error(w, lease.ErrLeaseNotFound.Error(), http.StatusNotFound)
@@ -190,8 +205,65 @@ func RenewHTTP(ctx context.Context, id lease.LeaseID, url string, rt http.RoundT
 
 // TimeToLiveHTTP retrieves lease information of the given lease ID.
 func TimeToLiveHTTP(ctx context.Context, id lease.LeaseID, keys bool, url string, rt http.RoundTripper) (*leasepb.LeaseInternalResponse, error) {
+	lreq := &leasepb.LeaseInternalRequest{
+		LeaseTimeToLiveRequest: &pb.LeaseTimeToLiveRequest{ID: int64(id), Keys: keys},
+	}
+	lresp, err := postInternal(ctx, lreq, url, rt)
+	if err != nil {
+		return nil, err
+	}
+	if lresp.LeaseTimeToLiveResponse == nil || lresp.LeaseTimeToLiveResponse.ID != int64(id) {
+		return nil, fmt.Errorf("lease: renew id mismatch")
+	}
+	return lresp, nil
+}
+
+// TimeToLivesHTTP retrieves lease information of the given lease IDs at once.
+func TimeToLivesHTTP(ctx context.Context, r *pb.LeaseTimeToLivesRequest, url string, rt http.RoundTripper) (*leasepb.LeaseInternalResponse, error) {
+	lresp, err := postInternal(ctx, &leasepb.LeaseInternalRequest{LeaseTimeToLivesRequest: r}, url, rt)
+	if err != nil {
+		return nil, err
+	}
+	if lresp.LeaseTimeToLivesResponse == nil || len(lresp.LeaseTimeToLivesResponse.Leases) != len(r.IDs) {
+		return nil, fmt.Errorf("lease: timetolives response mismatch")
+	}
+	return lresp, nil
+}
+
+// TimeToLives looks up the given leases in the lessor. Leases
+// that do not exist are reported with a TTL of -1.
+func TimeToLives(le lease.Lessor, r *pb.LeaseTimeToLivesRequest) *pb.LeaseTimeToLivesResponse {
+	// TODO: fill out ResponseHeader
+	resp := &pb.LeaseTimeToLivesResponse{
+		Header: &pb.ResponseHeader{},
+		Leases: make([]*pb.LeaseTimeToLiveResponse, len(r.IDs)),
+	}
+	for i, id := range r.IDs {
+		lr := &pb.LeaseTimeToLiveResponse{ID: id, TTL: -1}
+		if l := le.Lookup(lease.LeaseID(id)); l != nil {
+			lr.TTL = int64(l.Remaining().Seconds())
+			if lr.TTL < 0 {
+				lr.TTL = -1
+			}
+			lr.GrantedTTL = l.TTL()
+			if r.Keys {
+				ks := l.Keys()
+				kbs := make([][]byte, len(ks))
+				for i := range ks {
+					kbs[i] = []byte(ks[i])
+				}
+				lr.Keys = kbs
+			}
+		}
+		resp.Leases[i] = lr
+	}
+	return resp
+}
+
+// postInternal posts a lease internal request to the primary.
+func postInternal(ctx context.Context, r *leasepb.LeaseInternalRequest, url string, rt http.RoundTripper) (*leasepb.LeaseInternalResponse, error) {
 	// will post lreq protobuf to leader
-	lreq, err := (&leasepb.LeaseInternalRequest{&pb.LeaseTimeToLiveRequest{ID: int64(id), Keys: keys}}).Marshal()
+	lreq, err := r.Marshal()
 	if err != nil {
 		return nil, err
 	}
@@ -247,9 +319,6 @@ func TimeToLiveHTTP(ctx context.Context, id lease.LeaseID, keys bool, url string
 	if err := lresp.Unmarshal(b); err != nil {
 		return nil, fmt.Errorf(`lease: %v. data = "%s"`, err, string(b))
 	}
-	if lresp.LeaseTimeToLiveResponse.ID != int64(id) {
-		return nil, fmt.Errorf("lease: renew id mismatch")
-	}
 	return lresp, nil
 }
 
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	pb "etcd/etcdserver/etcdserverpb"
 	"etcd/lease"
 	"etcd/mvcc/backend"
 
@@ -79,6 +80,34 @@ func TestTimeToLiveHTTP(t *testing.T) {
 	}
 }
 
+func TestTimeToLivesHTTP(t *testing.T) {
+	be, tmpPath := backend.NewTmpBackend(time.Hour, 10000)
+	defer os.Remove(tmpPath)
+	defer be.Close()
+
+	le := lease.NewLessor(be, int64(5))
+	le.Promote(time.Second)
+	if _, err := le.Grant(1, int64(5)); err != nil {
+		t.Fatalf("failed to create lease: %v", err)
+	}
+
+	ts := httptest.NewServer(NewHandler(le, waitReady))
+	defer ts.Close()
+
+	req := &pb.LeaseTimeToLivesRequest{IDs: []int64{1, 2}}
+	resp, err := TimeToLivesHTTP(context.TODO(), req, ts.URL+LeaseInternalPrefix, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ls := resp.LeaseTimeToLivesResponse.Leases
+	if ls[0].ID != 1 || ls[0].GrantedTTL != 5 || ls[0].TTL <= 0 {
+		t.Fatalf("unexpected lease 1 response %+v", ls[0])
+	}
+	if ls[1].ID != 2 || ls[1].TTL != -1 {
+		t.Fatalf("unexpected lease 2 response %+v", ls[1])
+	}
+}
+
 func TestRenewHTTPTimeout(t *testing.T) {
 	testApplyTimeout(t, func(l *lease.Lease, serverURL string) error {
 		_, err := RenewHTTP(context.TODO(), l.ID, serverURL+LeasePrefix, http.DefaultTransport)
@@ -49,7 +49,8 @@ func (*Lease) ProtoMessage()               {}
 func (*Lease) Descriptor() ([]byte, []int) { return fileDescriptorLease, []int{0} }
 
 type LeaseInternalRequest struct {
-	LeaseTimeToLiveRequest *etcdserverpb.LeaseTimeToLiveRequest `protobuf:"bytes,1,opt,name=LeaseTimeToLiveRequest" json:"LeaseTimeToLiveRequest,omitempty"`
+	LeaseTimeToLiveRequest  *etcdserverpb.LeaseTimeToLiveRequest  `protobuf:"bytes,1,opt,name=LeaseTimeToLiveRequest" json:"LeaseTimeToLiveRequest,omitempty"`
+	LeaseTimeToLivesRequest *etcdserverpb.LeaseTimeToLivesRequest `protobuf:"bytes,2,opt,name=LeaseTimeToLivesRequest" json:"LeaseTimeToLivesRequest,omitempty"`
 }
 
 func (m *LeaseInternalRequest) Reset()                    { *m = LeaseInternalRequest{} }
@@ -58,7 +59,8 @@ func (*LeaseInternalRequest) ProtoMessage()               {}
 func (*LeaseInternalRequest) Descriptor() ([]byte, []int) { return fileDescriptorLease, []int{1} }
 
 type LeaseInternalResponse struct {
-	LeaseTimeToLiveResponse *etcdserverpb.LeaseTimeToLiveResponse `protobuf:"bytes,1,opt,name=LeaseTimeToLiveResponse" json:"LeaseTimeToLiveResponse,omitempty"`
+	LeaseTimeToLiveResponse  *etcdserverpb.LeaseTimeToLiveResponse  `protobuf:"bytes,1,opt,name=LeaseTimeToLiveResponse" json:"LeaseTimeToLiveResponse,omitempty"`
+	LeaseTimeToLivesResponse *etcdserverpb.LeaseTimeToLivesResponse `protobuf:"bytes,2,opt,name=LeaseTimeToLivesResponse" json:"LeaseTimeToLivesResponse,omitempty"`
 }
 
 func (m *LeaseInternalResponse) Reset()                    { *m = LeaseInternalResponse{} }
@@ -124,6 +126,16 @@ func (m *LeaseInternalRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if m.LeaseTimeToLivesRequest != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintLease(dAtA, i, uint64(m.LeaseTimeToLivesRequest.Size()))
+		n2, err := m.LeaseTimeToLivesRequest.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	return i, nil
 }
 
@@ -146,11 +158,21 @@ func (m *LeaseInternalResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintLease(dAtA, i, uint64(m.LeaseTimeToLiveResponse.Size()))
-		n2, err := m.LeaseTimeToLiveResponse.MarshalTo(dAtA[i:])
+		n3, err := m.LeaseTimeToLiveResponse.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
+	}
+	if m.LeaseTimeToLivesResponse != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintLease(dAtA, i, uint64(m.LeaseTimeToLivesResponse.Size()))
+		n4, err := m.LeaseTimeToLivesResponse.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
 	return i, nil
 }
@@ -201,6 +223,10 @@ func (m *LeaseInternalRequest) Size() (n int) {
 		l = m.LeaseTimeToLiveRequest.Size()
 		n += 1 + l + sovLease(uint64(l))
 	}
+	if m.LeaseTimeToLivesRequest != nil {
+		l = m.LeaseTimeToLivesRequest.Size()
+		n += 1 + l + sovLease(uint64(l))
+	}
 	return n
 }
 
@@ -211,6 +237,10 @@ func (m *LeaseInternalResponse) Size() (n int) {
 		l = m.LeaseTimeToLiveResponse.Size()
 		n += 1 + l + sovLease(uint64(l))
 	}
+	if m.LeaseTimeToLivesResponse != nil {
+		l = m.LeaseTimeToLivesResponse.Size()
+		n += 1 + l + sovLease(uint64(l))
+	}
 	return n
 }
 
@@ -377,6 +407,39 @@ func (m *LeaseInternalRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseTimeToLivesRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLease
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseTimeToLivesRequest == nil {
+				m.LeaseTimeToLivesRequest = &etcdserverpb.LeaseTimeToLivesRequest{}
+			}
+			if err := m.LeaseTimeToLivesRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLease(dAtA[iNdEx:])
@@ -460,6 +523,39 @@ func (m *LeaseInternalResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseTimeToLivesResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLease
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLease
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseTimeToLivesResponse == nil {
+				m.LeaseTimeToLivesResponse = &etcdserverpb.LeaseTimeToLivesResponse{}
+			}
+			if err := m.LeaseTimeToLivesResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLease(dAtA[iNdEx:])
@@ -589,20 +685,22 @@ var (
 func init() { proto.RegisterFile("lease.proto", fileDescriptorLease) }
 
 var fileDescriptorLease = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0xce, 0x49, 0x4d, 0x2c,
+	// 268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xce, 0x49, 0x4d, 0x2c,
 	0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x07, 0x73, 0x0a, 0x92, 0xa4, 0x44, 0xd2,
 	0xf3, 0xd3, 0xf3, 0xc1, 0x62, 0xfa, 0x20, 0x16, 0x44, 0x5a, 0x4a, 0x2d, 0xb5, 0x24, 0x39, 0x45,
 	0x1f, 0x44, 0x14, 0xa7, 0x16, 0x95, 0xa5, 0x16, 0x21, 0x31, 0x0b, 0x92, 0xf4, 0x8b, 0x0a, 0x92,
 	0x21, 0xea, 0x94, 0x34, 0xb9, 0x58, 0x7d, 0x40, 0x06, 0x09, 0xf1, 0x71, 0x31, 0x79, 0xba, 0x48,
 	0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0x31, 0x79, 0xba, 0x08, 0x09, 0x70, 0x31, 0x87, 0x84, 0xf8,
-	0x48, 0x30, 0x81, 0x05, 0x40, 0x4c, 0xa5, 0x12, 0x2e, 0x11, 0xb0, 0x52, 0xcf, 0xbc, 0x92, 0xd4,
-	0xa2, 0xbc, 0xc4, 0x9c, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0xa1, 0x18, 0x2e, 0x31, 0xb0,
-	0x78, 0x48, 0x66, 0x6e, 0x6a, 0x48, 0xbe, 0x4f, 0x66, 0x59, 0x2a, 0x54, 0x06, 0x6c, 0x1a, 0xb7,
-	0x91, 0x8a, 0x1e, 0xb2, 0xdd, 0x7a, 0xd8, 0xd5, 0x06, 0xe1, 0x30, 0x43, 0xa9, 0x82, 0x4b, 0x14,
-	0xcd, 0xd6, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa1, 0x78, 0x2e, 0x71, 0x0c, 0x2d, 0x10, 0x29,
-	0xa8, 0xbd, 0xaa, 0x04, 0xec, 0x85, 0x28, 0x0e, 0xc2, 0x65, 0x8a, 0x93, 0xc4, 0x89, 0x87, 0x72,
-	0x0c, 0x17, 0x1e, 0xca, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0xc3, 0xce, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x9f, 0xf2, 0x42, 0xe0, 0x91, 0x01, 0x00, 0x00,
+	0x48, 0x30, 0x81, 0x05, 0x40, 0x4c, 0xa5, 0xab, 0x8c, 0x5c, 0x22, 0x60, 0xb5, 0x9e, 0x79, 0x25,
+	0xa9, 0x45, 0x79, 0x89, 0x39, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x31, 0x5c, 0x62,
+	0x60, 0xf1, 0x90, 0xcc, 0xdc, 0xd4, 0x90, 0x7c, 0x9f, 0xcc, 0xb2, 0x54, 0xa8, 0x0c, 0xd8, 0x38,
+	0x6e, 0x23, 0x15, 0x3d, 0x64, 0xcb, 0xf5, 0xb0, 0xab, 0x0d, 0xc2, 0x61, 0x86, 0x50, 0x3c, 0x97,
+	0x38, 0x9a, 0x4c, 0x31, 0xcc, 0x78, 0x26, 0xb0, 0xf1, 0xaa, 0x78, 0x8d, 0x87, 0x29, 0x0e, 0xc2,
+	0x65, 0x8a, 0xd2, 0x1d, 0x46, 0x2e, 0x51, 0x34, 0x7f, 0x15, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x62,
+	0xb1, 0x1a, 0x26, 0x25, 0xc1, 0x48, 0x84, 0xd5, 0x30, 0xc5, 0x41, 0xb8, 0x4c, 0x11, 0x4a, 0xe2,
+	0x92, 0xc0, 0x74, 0x15, 0xd4, 0x06, 0x88, 0xe7, 0xd4, 0x08, 0x79, 0x0e, 0x6a, 0x05, 0x4e, 0x73,
+	0x9c, 0x24, 0x4e, 0x3c, 0x94, 0x63, 0xb8, 0xf0, 0x50, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
+	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf1, 0x58, 0x8e, 0x21, 0x89, 0x0d, 0x9c, 0x04,
+	0x8c, 0x01, 0x03, 0x00, 0x4c, 0x35, 0xfa, 0xcd, 0x58, 0x02, 0x00, 0x00,
 }
@@ -15,6 +15,7 @@
 package backend
 
 import (
+	"bytes"
 	"fmt"
 	"hash/crc32"
 	"io"
@@ -25,6 +26,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"etcd/pkg/encryption"
 	"github.com/boltdb/bolt"
 	"github.com/coreos/pkg/capnslog"
 )
@@ -53,12 +55,19 @@ const (
 )
 
 type Backend interface {
+	ReadTx() ReadTx
 	BatchTx() BatchTx
+
 	Snapshot() Snapshot
 	Hash(ignores map[IgnoreKey]struct{}) (uint32, error)
 	// Size returns the current size of the backend.
 	Size() int64
+	// SizeInUse returns the current size of the backend actually used by
+	// data, excluding the free pages which defragmentation reclaims.
+	SizeInUse() int64
 	Defrag() error
+	// DefragStatus returns the progress of the ongoing defragmentation.
+	DefragStatus() DefragStatus
 	ForceCommit()
 	Close() error
 }
@@ -78,44 +87,134 @@ type backend struct {
 
 	// size is the number of bytes in the backend
 	size int64
+	// sizeInUse is the number of bytes actually used in the backend
+	sizeInUse int64
 	// commits counts number of commits since start
 	commits int64
+	// defragCopied, defragTotal and defragLogged are the progress of
+	// the ongoing defragmentation
+	defragCopied int64
+	defragTotal  int64
+	defragLogged int64
+	// defragRunning is 1 while a defragmentation runs
+	defragRunning int32
 
 	mu sync.RWMutex
 	db *bolt.DB
+	// bopts are the options the db is opened with
+	bopts *bolt.Options
 
 	batchInterval time.Duration
 	batchLimit    int
-	batchTx       *batchTx
+	batchTx       *batchTxBuffered
+
+	readTx *readTx
+
+	vc *valueCipher
+
+	// defragMu serializes the defragmentations.
+	defragMu sync.Mutex
+	// defragLog records the writes made while a defragmentation copies
+	// the db. It is guarded by the lock of the batch tx.
+	defragLog *defragLog
 
 	stopc chan struct{}
 	donec chan struct{}
 }
 
+// BackendConfig configures a backend.
+type BackendConfig struct {
+	// Path is the file path to the backend file.
+	Path string
+	// BatchInterval is the maximum time before committing the batch tx.
+	// 0 means use the default.
+	BatchInterval time.Duration
+	// BatchLimit is the maximum number of puts before committing the
+	// batch tx. 0 means use the default.
+	BatchLimit int
+	// MmapSize is the initial number of bytes mmapped for the backend.
+	// Setting it larger than the potential max db size prevents the
+	// writer from blocking the readers when the db grows.
+	// 0 means use the default.
+	MmapSize uint64
+	// Cipher encrypts the values at rest. nil leaves new values in
+	// plaintext.
+	Cipher *encryption.Cipher
+}
+
+// DefaultBackendConfig returns the configuration of the default backend.
+func DefaultBackendConfig() BackendConfig {
+	return BackendConfig{
+		BatchInterval: defaultBatchInterval,
+		BatchLimit:    defaultBatchLimit,
+		MmapSize:      defaultMmapSize(),
+	}
+}
+
 func New(path string, d time.Duration, limit int) Backend {
-	return newBackend(path, d, limit)
+	bcfg := DefaultBackendConfig()
+	bcfg.Path, bcfg.BatchInterval, bcfg.BatchLimit = path, d, limit
+	return newBackend(bcfg)
+}
+
+// NewWithConfig returns a backend configured by bcfg.
+func NewWithConfig(bcfg BackendConfig) Backend {
+	return newBackend(bcfg)
 }
 
 func NewDefaultBackend(path string) Backend {
-	return newBackend(path, defaultBatchInterval, defaultBatchLimit)
+	bcfg := DefaultBackendConfig()
+	bcfg.Path = path
+	return newBackend(bcfg)
 }
 
-func newBackend(path string, d time.Duration, limit int) *backend {
-	db, err := bolt.Open(path, 0600, boltOpenOptions)
+func newBackend(bcfg BackendConfig) *backend {
+	def := DefaultBackendConfig()
+	if bcfg.BatchInterval <= 0 {
+		bcfg.BatchInterval = def.BatchInterval
+	}
+	if bcfg.BatchLimit <= 0 {
+		bcfg.BatchLimit = def.BatchLimit
+	}
+	if bcfg.MmapSize == 0 {
+		bcfg.MmapSize = def.MmapSize
+	}
+
+	bopts := &bolt.Options{}
+	if boltOpenOptions != nil {
+		*bopts = *boltOpenOptions
+	}
+	bopts.InitialMmapSize = int(bcfg.MmapSize)
+
+	db, err := bolt.Open(bcfg.Path, 0600, bopts)
 	if err != nil {
-		plog.Panicf("cannot open database at %s (%v)", path, err)
+		plog.Panicf("cannot open database at %s (%v)", bcfg.Path, err)
+	}
+
+	vc := &valueCipher{c: bcfg.Cipher}
+	if err = vc.load(db); err != nil {
+		plog.Panicf("cannot load the encryption state of %s (%v)", bcfg.Path, err)
 	}
 
 	b := &backend{
-		db: db,
+		db:    db,
+		bopts: bopts,
+		vc:    vc,
 
-		batchInterval: d,
-		batchLimit:    limit,
+		batchInterval: bcfg.BatchInterval,
+		batchLimit:    bcfg.BatchLimit,
+
+		readTx: &readTx{
+			buf: txReadBuffer{
+				txBuffer{make(map[string]*bucketBuffer)},
+			},
+			vc: vc,
+		},
 
 		stopc: make(chan struct{}),
 		donec: make(chan struct{}),
 	}
-	b.batchTx = newBatchTx(b)
+	b.batchTx = newBatchTxBuffered(b)
 	go b.run()
 	return b
 }
@@ -127,6 +226,10 @@ func (b *backend) BatchTx() BatchTx {
 	return b.batchTx
 }
 
+// ReadTx returns the read tx of the backend. Unlike the batch tx, it can be
+// locked by many readers at once, which do not block the batch tx either.
+func (b *backend) ReadTx() ReadTx { return b.readTx }
+
 // ForceCommit forces the current batching tx to commit.
 func (b *backend) ForceCommit() {
 	b.batchTx.Commit()
@@ -135,15 +238,10 @@ func (b *backend) ForceCommit() {
 func (b *backend) Snapshot() Snapshot {
 	b.batchTx.Commit()
 
-	b.mu.RLock()
-	defer b.mu.RUnlock()
-	tx, err := b.db.Begin(false)
-	if err != nil {
-		plog.Fatalf("cannot begin tx (%s)", err)
-	}
-	return &snapshot{tx}
+	return &snapshot{b.begin(false)}
 }
 
+// IgnoreKey is a key ignored by Hash. An empty Key ignores the whole bucket.
 type IgnoreKey struct {
 	Bucket string
 	Key    string
@@ -151,6 +249,7 @@ type IgnoreKey struct {
 
 func (b *backend) Hash(ignores map[IgnoreKey]struct{}) (uint32, error) {
 	h := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	vc := b.vc
 
 	b.mu.RLock()
 	defer b.mu.RUnlock()
@@ -161,15 +260,29 @@ func (b *backend) Hash(ignores map[IgnoreKey]struct{}) (uint32, error) {
 			if b == nil {
 				return fmt.Errorf("cannot get hash of bucket %s", string(next))
 			}
+			if _, ok := ignores[IgnoreKey{Bucket: string(next)}]; ok {
+				continue
+			}
+			if bytes.Equal(next, encryptionBucketName) {
+				continue
+			}
 			h.Write(next)
-			b.ForEach(func(k, v []byte) error {
+			if err := b.ForEach(func(k, v []byte) error {
 				bk := IgnoreKey{Bucket: string(next), Key: string(k)}
 				if _, ok := ignores[bk]; !ok {
+					// hash the plaintext so members encrypting with
+					// different nonces or keys have the same hash.
+					dv, err := vc.tryDecrypt(v)
+					if err != nil {
+						return err
+					}
 					h.Write(k)
-					h.Write(v)
+					h.Write(dv)
 				}
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		}
 		return nil
 	})
@@ -185,6 +298,10 @@ func (b *backend) Size() int64 {
 	return atomic.LoadInt64(&b.size)
 }
 
+func (b *backend) SizeInUse() int64 {
+	return atomic.LoadInt64(&b.sizeInUse)
+}
+
 func (b *backend) run() {
 	defer close(b.donec)
 	t := time.NewTimer(b.batchInterval)
@@ -224,30 +341,73 @@ func (b *backend) Defrag() error {
 	return nil
 }
 
+// defrag copies the db into a compacted file while the backend keeps
+// serving reads and writes. The copy is made from a read tx of the committed
+// db and the writes made meanwhile are logged. They are replayed into the
+// copy, which then replaces the db, holding the locks only for the replay
+// and the swap.
 func (b *backend) defrag() error {
-	// TODO: make this non-blocking?
-	// lock batchTx to ensure nobody is using previous tx, and then
-	// close previous ongoing tx.
+	b.defragMu.Lock()
+	defer b.defragMu.Unlock()
+
+	atomic.StoreInt64(&b.defragCopied, 0)
+	atomic.StoreInt64(&b.defragTotal, 0)
+	atomic.StoreInt32(&b.defragRunning, 1)
+	defer atomic.StoreInt32(&b.defragRunning, 0)
+
+	tmpdb, err := bolt.Open(b.db.Path()+".tmp", 0600, b.bopts)
+	if err != nil {
+		return err
+	}
+
+	// commit the pending writes, so the read tx sees all the writes made
+	// before the log starts.
 	b.batchTx.Lock()
-	defer b.batchTx.Unlock()
+	b.batchTx.commit(false)
+	// a long running read tx blocks the commits which need to grow the
+	// mmap, so the mmap size should be larger than the db.
+	tx := b.begin(false)
+	b.defragLog = newDefragLog(&b.defragLogged)
+	b.batchTx.Unlock()
+
+	total := int64(0)
+	tx.ForEach(func(_ []byte, bk *bolt.Bucket) error {
+		total += int64(bk.Stats().KeyN)
+		return nil
+	})
+	atomic.StoreInt64(&b.defragTotal, total)
 
-	// lock database after lock tx to avoid deadlock.
-	b.mu.Lock()
-	defer b.mu.Unlock()
+	err = defragdb(tx, tmpdb, defragLimit, &b.defragCopied, b.vc)
+	tx.Rollback()
 
-	b.batchTx.commit(true)
-	b.batchTx.tx = nil
+	b.batchTx.Lock()
+	defer b.batchTx.Unlock()
 
-	tmpdb, err := bolt.Open(b.db.Path()+".tmp", 0600, boltOpenOptions)
+	dlog := b.defragLog
+	b.defragLog = nil
 	if err != nil {
+		tmpdb.Close()
+		os.RemoveAll(tmpdb.Path())
 		return err
 	}
 
-	err = defragdb(b.db, tmpdb, defragLimit)
+	// lock database after lock tx to avoid deadlock.
+	b.mu.Lock()
+	defer b.mu.Unlock()
 
-	if err != nil {
+	// block concurrent read requests while resetting tx
+	b.readTx.mu.Lock()
+	defer b.readTx.mu.Unlock()
+
+	b.batchTx.unsafeCommit(true)
+	b.batchTx.tx = nil
+
+	if err = dlog.replay(tmpdb); err != nil {
 		tmpdb.Close()
 		os.RemoveAll(tmpdb.Path())
+		// keep serving the old database
+		b.batchTx.tx = b.unsafeBegin(true)
+		b.readTx.tx = b.unsafeBegin(false)
 		return err
 	}
 
@@ -267,31 +427,43 @@ func (b *backend) defrag() error {
 		plog.Fatalf("cannot rename database (%s)", err)
 	}
 
-	b.db, err = bolt.Open(dbp, 0600, boltOpenOptions)
+	b.db, err = bolt.Open(dbp, 0600, b.bopts)
 	if err != nil {
 		plog.Panicf("cannot open database at %s (%v)", dbp, err)
 	}
-	b.batchTx.tx, err = b.db.Begin(true)
-	if err != nil {
-		plog.Fatalf("cannot begin tx (%s)", err)
+	b.batchTx.tx = b.unsafeBegin(true)
+	b.readTx.tx = b.unsafeBegin(false)
+	if b.vc.c.Enabled() {
+		// all the values were encrypted by the copy
+		atomic.StoreInt32(&b.vc.strict, 1)
 	}
 
+	plog.Infof("defragmented the backend (%d keys copied, %d writes replayed)", atomic.LoadInt64(&b.defragCopied), len(dlog.ops))
 	return nil
 }
 
-func defragdb(odb, tmpdb *bolt.DB, limit int) error {
-	// open a tx on tmpdb for writes
-	tmptx, err := tmpdb.Begin(true)
+func (b *backend) begin(write bool) *bolt.Tx {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.unsafeBegin(write)
+}
+
+func (b *backend) unsafeBegin(write bool) *bolt.Tx {
+	tx, err := b.db.Begin(write)
 	if err != nil {
-		return err
+		plog.Fatalf("cannot begin tx (%s)", err)
 	}
+	return tx
+}
 
-	// open a tx on old db for read
-	tx, err := odb.Begin(false)
+// defragdb copies the buckets read by tx into tmpdb, counting the copied
+// keys into copied. The values are encrypted with the active key of vc.
+func defragdb(tx *bolt.Tx, tmpdb *bolt.DB, limit int, copied *int64, vc *valueCipher) error {
+	// open a tx on tmpdb for writes
+	tmptx, err := tmpdb.Begin(true)
 	if err != nil {
 		return err
 	}
-	defer tx.Rollback()
 
 	c := tx.Cursor()
 
@@ -301,6 +473,9 @@ func defragdb(odb, tmpdb *bolt.DB, limit int) error {
 		if b == nil {
 			return fmt.Errorf("backend: cannot defrag bucket %s", string(next))
 		}
+		if bytes.Equal(next, encryptionBucketName) {
+			continue
+		}
 
 		tmpb, berr := tmptx.CreateBucketIfNotExists(next)
 		tmpb.FillPercent = 0.9 // for seq write in for each
@@ -308,7 +483,7 @@ func defragdb(odb, tmpdb *bolt.DB, limit int) error {
 			return berr
 		}
 
-		b.ForEach(func(k, v []byte) error {
+		err = b.ForEach(func(k, v []byte) error {
 			count++
 			if count > limit {
 				err = tmptx.Commit()
@@ -324,10 +499,27 @@ func defragdb(odb, tmpdb *bolt.DB, limit int) error {
 
 				count = 0
 			}
-			return tmpb.Put(k, v)
+			// re-encrypt with the active key so defrag
+			// completes a key rotation.
+			ev, rerr := vc.reencrypt(v)
+			if rerr != nil {
+				return rerr
+			}
+			atomic.AddInt64(copied, 1)
+			return tmpb.Put(k, ev)
 		})
+		if err != nil {
+			tmptx.Rollback()
+			return err
+		}
 	}
 
+	if vc.c.Enabled() {
+		if err = vc.markStrict(tmptx); err != nil {
+			tmptx.Rollback()
+			return err
+		}
+	}
 	return tmptx.Commit()
 }
 
@@ -338,7 +530,9 @@ func NewTmpBackend(batchInterval time.Duration, batchLimit int) (*backend, strin
 		plog.Fatal(err)
 	}
 	tmpPath := filepath.Join(dir, "database")
-	return newBackend(tmpPath, batchInterval, batchLimit), tmpPath
+	bcfg := DefaultBackendConfig()
+	bcfg.Path, bcfg.BatchInterval, bcfg.BatchLimit = tmpPath, batchInterval, batchLimit
+	return newBackend(bcfg), tmpPath
 }
 
 func NewDefaultTmpBackend() (*backend, string) {
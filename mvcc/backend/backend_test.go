@@ -15,12 +15,15 @@
 package backend
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
+	"etcd/pkg/encryption"
 	"github.com/boltdb/bolt"
 )
 
@@ -116,6 +119,42 @@ func TestBackendBatchIntervalCommit(t *testing.T) {
 	})
 }
 
+func TestBackendConfig(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "etcd_backend_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	b := NewWithConfig(BackendConfig{
+		Path:       dir + "/database",
+		BatchLimit: 1,
+		MmapSize:   1024 * 1024,
+	}).(*backend)
+	defer b.Close()
+
+	if b.batchLimit != 1 {
+		t.Errorf("batchLimit = %d, want 1", b.batchLimit)
+	}
+	if b.batchInterval != defaultBatchInterval {
+		t.Errorf("batchInterval = %v, want %v", b.batchInterval, defaultBatchInterval)
+	}
+	if b.bopts.InitialMmapSize != 1024*1024 {
+		t.Errorf("InitialMmapSize = %d, want %d", b.bopts.InitialMmapSize, 1024*1024)
+	}
+
+	// a batch limit of 1 commits on every unlock after a put
+	pc := b.Commits()
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	if b.Commits() != pc+1 {
+		t.Errorf("commits = %d, want %d", b.Commits(), pc+1)
+	}
+}
+
 func TestBackendDefrag(t *testing.T) {
 	b, tmpPath := NewDefaultTmpBackend()
 	defer cleanup(b, tmpPath)
@@ -173,6 +212,283 @@ func TestBackendDefrag(t *testing.T) {
 	b.ForceCommit()
 }
 
+func TestBackendSizeInUse(t *testing.T) {
+	b, tmpPath := NewDefaultTmpBackend()
+	defer cleanup(b, tmpPath)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	for i := 0; i < defragLimit; i++ {
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), bytes.Repeat([]byte("a"), 100))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	if b.SizeInUse() > b.Size() {
+		t.Fatalf("size in use = %d, want <= size %d", b.SizeInUse(), b.Size())
+	}
+	inUse := b.SizeInUse()
+
+	tx.Lock()
+	for i := 0; i < defragLimit; i++ {
+		tx.UnsafeDelete([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+	// the pages freed by a commit are released by the next write tx
+	// once no read tx uses them
+	for i := 0; i < 2; i++ {
+		tx.Lock()
+		tx.UnsafePut([]byte("test"), []byte("bar"), []byte("bar"))
+		tx.Unlock()
+		b.ForceCommit()
+	}
+
+	if b.SizeInUse() >= inUse {
+		t.Errorf("size in use = %d after deletes, want < %d", b.SizeInUse(), inUse)
+	}
+	if err := b.Defrag(); err != nil {
+		t.Fatal(err)
+	}
+	if b.Size() >= inUse {
+		t.Errorf("size = %d after defrag, want < %d", b.Size(), inUse)
+	}
+}
+
+// TestBackendDefragOnline ensures the writes made while defrag copies
+// the db are kept in the defragmented db.
+func TestBackendDefragOnline(t *testing.T) {
+	b, tmpPath := NewDefaultTmpBackend()
+	defer cleanup(b, tmpPath)
+
+	n := 2 * defragLimit
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	for i := 0; i < n; i++ {
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	donec := make(chan error)
+	go func() { donec <- b.Defrag() }()
+
+	// write until defrag is done; the writes must not wait for the copy
+	writes := 0
+	for done := false; !done; {
+		tx.Lock()
+		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("new_%d", writes)), []byte("bar"))
+		tx.UnsafeDelete([]byte("test"), []byte(fmt.Sprintf("foo_%d", writes%n)))
+		tx.Unlock()
+		writes++
+		select {
+		case err := <-donec:
+			if err != nil {
+				t.Fatal(err)
+			}
+			done = true
+		default:
+		}
+	}
+
+	st := b.DefragStatus()
+	if st.InProgress {
+		t.Errorf("defrag in progress after defrag returned")
+	}
+	if st.TotalKeys != int64(n) || st.CopiedKeys != st.TotalKeys {
+		t.Errorf("copied %d of %d keys, want %d of %d", st.CopiedKeys, st.TotalKeys, n, n)
+	}
+
+	b.ForceCommit()
+	tx.Lock()
+	defer tx.Unlock()
+	keys, _ := tx.UnsafeRange([]byte("test"), []byte("new_"), []byte("new`"), 0)
+	if len(keys) != writes {
+		t.Errorf("len(new keys) = %d, want %d", len(keys), writes)
+	}
+	keys, _ = tx.UnsafeRange([]byte("test"), []byte("foo_"), []byte("foo`"), 0)
+	if wn := n - writes; wn > 0 && len(keys) != wn {
+		t.Errorf("len(old keys) = %d, want %d", len(keys), wn)
+	}
+}
+
+func newEncryptedTmpBackend(t *testing.T, path string, keys ...encryption.Key) *backend {
+	bcfg := DefaultBackendConfig()
+	bcfg.Path = path
+	if len(keys) != 0 {
+		bcfg.Cipher = encryption.NewCipher(encryption.NewKeyProvider(keys...))
+	}
+	return newBackend(bcfg)
+}
+
+func TestBackendDefragReencrypt(t *testing.T) {
+	k1 := encryption.Key{ID: 1, Secret: bytes.Repeat([]byte{'a'}, 32)}
+	k2 := encryption.Key{ID: 2, Secret: bytes.Repeat([]byte{'b'}, 32)}
+
+	b, tmpPath := NewDefaultTmpBackend()
+	b.Close()
+	b = newEncryptedTmpBackend(t, tmpPath, k1)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	oh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+
+	// rotate the key; defrag re-encrypts the values with the new key
+	b = newEncryptedTmpBackend(t, tmpPath, k1, k2)
+	defer cleanup(b, tmpPath)
+	if err = b.Defrag(); err != nil {
+		t.Fatal(err)
+	}
+
+	nh, err := b.Hash(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oh != nh {
+		t.Errorf("hash = %v, want %v", nh, oh)
+	}
+
+	tx = b.BatchTx()
+	tx.Lock()
+	_, vals := tx.UnsafeRange([]byte("test"), []byte("foo"), nil, 0)
+	tx.Unlock()
+	if len(vals) != 1 || string(vals[0]) != "bar" {
+		t.Fatalf("vals = %q, want [bar]", vals)
+	}
+	b.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket([]byte("test")).Get([]byte("foo"))
+		if id, ok := encryption.KeyID(v); !ok || id != 2 {
+			t.Errorf("key id = %d, %v, want 2, true", id, ok)
+		}
+		return nil
+	})
+}
+
+func TestBackendEncryptionStrict(t *testing.T) {
+	k1 := encryption.Key{ID: 1, Secret: bytes.Repeat([]byte{'a'}, 32)}
+
+	// plaintext written before encryption is enabled stays readable
+	b, tmpPath := NewDefaultTmpBackend()
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("test"))
+	tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	b.Close()
+
+	b = newEncryptedTmpBackend(t, tmpPath, k1)
+	if b.vc.isStrict() {
+		t.Fatal("backend holding plaintext values is strict")
+	}
+	if _, err := b.Hash(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// once defrag encrypts all the values, plaintext values are rejected
+	if err := b.Defrag(); err != nil {
+		t.Fatal(err)
+	}
+	if !b.vc.isStrict() {
+		t.Fatal("defragmented backend is not strict")
+	}
+	b.Close()
+
+	// the state is kept by the db
+	db, err := bolt.Open(tmpPath, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte("test")).Put([]byte("baz"), []byte("plaintext"))
+	})
+	db.Close()
+	b = newEncryptedTmpBackend(t, tmpPath, k1)
+	defer cleanup(b, tmpPath)
+	if _, err = b.Hash(nil); err != encryption.ErrNotEncrypted {
+		t.Fatalf("err = %v, want %v", err, encryption.ErrNotEncrypted)
+	}
+
+	// a new backend only holds encrypted values
+	nb, npath := NewDefaultTmpBackend()
+	nb.Close()
+	os.Remove(npath)
+	nb = newEncryptedTmpBackend(t, npath, k1)
+	defer cleanup(nb, npath)
+	if !nb.vc.isStrict() {
+		t.Fatal("new encrypted backend is not strict")
+	}
+}
+
+func TestBackendReadTx(t *testing.T) {
+	b, tmpPath := NewTmpBackend(time.Hour, 10000)
+	defer cleanup(b, tmpPath)
+
+	bucket := []byte("test")
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(bucket)
+	tx.UnsafePut(bucket, []byte("a"), []byte("1"))
+	tx.UnsafePut(bucket, []byte("c"), []byte("3"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	rtx := b.ReadTx()
+	check := func(i int, wkvs map[string]string) {
+		rtx.Lock()
+		defer rtx.Unlock()
+		keys, vals := rtx.UnsafeRange(bucket, []byte("a"), []byte("z"), 0)
+		kvs := make(map[string]string)
+		for j := range keys {
+			kvs[string(keys[j])] = string(vals[j])
+		}
+		if !reflect.DeepEqual(kvs, wkvs) {
+			t.Errorf("#%d: range = %v, want %v", i, kvs, wkvs)
+		}
+		// ranges are sorted and limited
+		wkeys := [][]byte{[]byte("a"), []byte("b")}
+		if _, ok := wkvs["b"]; !ok {
+			wkeys = [][]byte{[]byte("a"), []byte("c")}
+		}
+		if keys, _ = rtx.UnsafeRange(bucket, []byte("a"), []byte("z"), 2); !reflect.DeepEqual(keys, wkeys) {
+			t.Errorf("#%d: range with limit = %q, want %q", i, keys, wkeys)
+		}
+		_, vals = rtx.UnsafeRange(bucket, []byte("c"), nil, 0)
+		if len(vals) != 1 || string(vals[0]) != wkvs["c"] {
+			t.Errorf("#%d: get c = %q, want [%s]", i, vals, wkvs["c"])
+		}
+		kvs = make(map[string]string)
+		rtx.UnsafeForEach(bucket, func(k, v []byte) error {
+			kvs[string(k)] = string(v)
+			return nil
+		})
+		if !reflect.DeepEqual(kvs, wkvs) {
+			t.Errorf("#%d: for each = %v, want %v", i, kvs, wkvs)
+		}
+	}
+
+	tx.Lock()
+	tx.UnsafePut(bucket, []byte("c"), []byte("4"))
+	tx.UnsafePut(bucket, []byte("b"), []byte("2"))
+	// the puts are not seen until the batch tx unlocks
+	check(0, map[string]string{"a": "1", "c": "3"})
+	tx.Unlock()
+	// the puts are buffered
+	check(1, map[string]string{"a": "1", "b": "2", "c": "4"})
+	b.ForceCommit()
+	check(2, map[string]string{"a": "1", "b": "2", "c": "4"})
+}
+
 func cleanup(b Backend, path string) {
 	b.Close()
 	os.Remove(path)
@@ -15,7 +15,6 @@
 package backend
 
 import (
-	"bytes"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -24,14 +23,11 @@ import (
 )
 
 type BatchTx interface {
-	Lock()
-	Unlock()
+	ReadTx
 	UnsafeCreateBucket(name []byte)
 	UnsafePut(bucketName []byte, key []byte, value []byte)
 	UnsafeSeqPut(bucketName []byte, key []byte, value []byte)
-	UnsafeRange(bucketName []byte, key, endKey []byte, limit int64) (keys [][]byte, vals [][]byte)
 	UnsafeDelete(bucketName []byte, key []byte)
-	UnsafeForEach(bucketName []byte, visitor func(k, v []byte) error) error
 	Commit()
 	CommitAndStop()
 }
@@ -43,17 +39,14 @@ type batchTx struct {
 	pending int
 }
 
-func newBatchTx(backend *backend) *batchTx {
-	tx := &batchTx{backend: backend}
-	tx.Commit()
-	return tx
-}
-
 func (t *batchTx) UnsafeCreateBucket(name []byte) {
 	_, err := t.tx.CreateBucket(name)
 	if err != nil && err != bolt.ErrBucketExists {
 		plog.Fatalf("cannot create bucket %s (%v)", name, err)
 	}
+	if t.backend.defragLog != nil {
+		t.backend.defragLog.createBucket(name)
+	}
 	t.pending++
 }
 
@@ -77,9 +70,13 @@ func (t *batchTx) unsafePut(bucketName []byte, key []byte, value []byte, seq boo
 		// this can delay the page split and reduce space usage.
 		bucket.FillPercent = 0.9
 	}
-	if err := bucket.Put(key, value); err != nil {
+	ev := t.backend.vc.encrypt(value)
+	if err := bucket.Put(key, ev); err != nil {
 		plog.Fatalf("cannot put key into bucket (%v)", err)
 	}
+	if t.backend.defragLog != nil {
+		t.backend.defragLog.put(bucketName, key, ev)
+	}
 	t.pending++
 }
 
@@ -89,25 +86,7 @@ func (t *batchTx) UnsafeRange(bucketName []byte, key, endKey []byte, limit int64
 	if bucket == nil {
 		plog.Fatalf("bucket %s does not exist", bucketName)
 	}
-
-	if len(endKey) == 0 {
-		if v := bucket.Get(key); v == nil {
-			return keys, vs
-		} else {
-			return append(keys, key), append(vs, v)
-		}
-	}
-
-	c := bucket.Cursor()
-	for ck, cv := c.Seek(key); ck != nil && bytes.Compare(ck, endKey) < 0; ck, cv = c.Next() {
-		vs = append(vs, cv)
-		keys = append(keys, ck)
-		if limit > 0 && limit == int64(len(keys)) {
-			break
-		}
-	}
-
-	return keys, vs
+	return unsafeRange(bucket.Cursor(), key, endKey, limit, t.backend.vc)
 }
 
 // UnsafeDelete must be called holding the lock on the tx.
@@ -120,6 +99,9 @@ func (t *batchTx) UnsafeDelete(bucketName []byte, key []byte) {
 	if err != nil {
 		plog.Fatalf("cannot delete key from bucket (%v)", err)
 	}
+	if t.backend.defragLog != nil {
+		t.backend.defragLog.delete(bucketName, key)
+	}
 	t.pending++
 }
 
@@ -130,7 +112,7 @@ func (t *batchTx) UnsafeForEach(bucketName []byte, visitor func(k, v []byte) err
 		// bucket does not exist
 		return nil
 	}
-	return b.ForEach(visitor)
+	return b.ForEach(func(k, v []byte) error { return visitor(k, t.backend.vc.decrypt(v)) })
 }
 
 // Commit commits a previous tx and begins a new writable one.
@@ -168,13 +150,13 @@ func (t *batchTx) commit(stop bool) {
 			// and subsequent *bolt.Tx.Size() call panics.
 			//
 			// This nil pointer reference panic happens when:
-			//   1. batchTx.commit(false) from newBatchTx
+			//   1. batchTx.commit(false) from newBatchTxBuffered
 			//   2. batchTx.commit(true) from stopping backend
 			//   3. batchTx.commit(false) from inflight mvcc Hash call
 			//
 			// Check if db is nil to prevent this panic
 			if t.tx.DB() != nil {
-				atomic.StoreInt64(&t.backend.size, t.tx.Size())
+				t.updateSize()
 			}
 			return
 		}
@@ -195,12 +177,90 @@ func (t *batchTx) commit(stop bool) {
 		return
 	}
 
-	t.backend.mu.RLock()
-	defer t.backend.mu.RUnlock()
 	// begin a new tx
-	t.tx, err = t.backend.db.Begin(true)
-	if err != nil {
-		plog.Fatalf("cannot begin tx (%s)", err)
+	t.tx = t.backend.begin(true)
+	t.updateSize()
+}
+
+// updateSize records the size of the db and the size of its pages in use.
+func (t *batchTx) updateSize() {
+	size := t.tx.Size()
+	db := t.tx.DB()
+	atomic.StoreInt64(&t.backend.size, size)
+	atomic.StoreInt64(&t.backend.sizeInUse, size-int64(db.Stats().FreePageN)*int64(db.Info().PageSize))
+}
+
+// batchTxBuffered is a batch tx which also buffers its puts for the
+// read tx of the backend until they are committed.
+type batchTxBuffered struct {
+	batchTx
+	buf txWriteBuffer
+}
+
+func newBatchTxBuffered(backend *backend) *batchTxBuffered {
+	tx := &batchTxBuffered{
+		batchTx: batchTx{backend: backend},
+		buf: txWriteBuffer{
+			txBuffer: txBuffer{make(map[string]*bucketBuffer)},
+		},
+	}
+	tx.Commit()
+	return tx
+}
+
+func (t *batchTxBuffered) Unlock() {
+	if t.pending != 0 {
+		t.backend.readTx.mu.Lock()
+		t.buf.writeback(&t.backend.readTx.buf)
+		t.backend.readTx.mu.Unlock()
+		if t.pending >= t.backend.batchLimit {
+			t.commit(false)
+		}
 	}
-	atomic.StoreInt64(&t.backend.size, t.tx.Size())
+	t.batchTx.Unlock()
+}
+
+func (t *batchTxBuffered) Commit() {
+	t.Lock()
+	defer t.Unlock()
+	t.commit(false)
+}
+
+func (t *batchTxBuffered) CommitAndStop() {
+	t.Lock()
+	defer t.Unlock()
+	t.commit(true)
+}
+
+func (t *batchTxBuffered) commit(stop bool) {
+	// all read txs must be closed to acquire boltdb commit rwlock
+	t.backend.readTx.mu.Lock()
+	defer t.backend.readTx.mu.Unlock()
+	t.unsafeCommit(stop)
+}
+
+func (t *batchTxBuffered) unsafeCommit(stop bool) {
+	if t.backend.readTx.tx != nil {
+		if err := t.backend.readTx.tx.Rollback(); err != nil {
+			plog.Fatalf("cannot rollback tx (%s)", err)
+		}
+		t.backend.readTx.reset()
+	}
+
+	t.batchTx.commit(stop)
+
+	// no new batch tx begins once the backend is stopped
+	if !stop && t.tx.DB() != nil {
+		t.backend.readTx.tx = t.backend.begin(false)
+	}
+}
+
+func (t *batchTxBuffered) UnsafePut(bucketName []byte, key []byte, value []byte) {
+	t.batchTx.UnsafePut(bucketName, key, value)
+	t.buf.put(bucketName, key, value)
+}
+
+func (t *batchTxBuffered) UnsafeSeqPut(bucketName []byte, key []byte, value []byte) {
+	t.batchTx.UnsafeSeqPut(bucketName, key, value)
+	t.buf.put(bucketName, key, value)
 }
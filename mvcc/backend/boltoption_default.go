@@ -19,3 +19,5 @@ package backend
 import "github.com/boltdb/bolt"
 
 var boltOpenOptions *bolt.Options = nil
+
+func defaultMmapSize() uint64 { return 0 }
@@ -0,0 +1,131 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"sync/atomic"
+
+	"github.com/boltdb/bolt"
+)
+
+// DefragStatus is the progress of the ongoing defragmentation of a backend.
+type DefragStatus struct {
+	// InProgress is true while a defragmentation runs.
+	InProgress bool
+	// CopiedKeys is the number of keys copied into the defragmented db.
+	CopiedKeys int64
+	// TotalKeys is the number of keys to copy.
+	TotalKeys int64
+	// LoggedWrites is the number of writes made during the copy. They are
+	// replayed into the defragmented db before it replaces the db.
+	LoggedWrites int64
+}
+
+func (b *backend) DefragStatus() DefragStatus {
+	return DefragStatus{
+		InProgress:   atomic.LoadInt32(&b.defragRunning) == 1,
+		CopiedKeys:   atomic.LoadInt64(&b.defragCopied),
+		TotalKeys:    atomic.LoadInt64(&b.defragTotal),
+		LoggedWrites: atomic.LoadInt64(&b.defragLogged),
+	}
+}
+
+type defragOpType int
+
+const (
+	defragOpCreateBucket defragOpType = iota
+	defragOpPut
+	defragOpDelete
+)
+
+type defragOp struct {
+	typ    defragOpType
+	bucket []byte
+	key    []byte
+	value  []byte
+}
+
+// defragLog records the writes of the batch tx while an online
+// defragmentation copies the db, so they can be replayed into the copy.
+// It must be accessed holding the lock of the batch tx.
+type defragLog struct {
+	ops []defragOp
+	// logged counts the ops for the defrag status. Accessed atomically.
+	logged *int64
+}
+
+func newDefragLog(logged *int64) *defragLog {
+	atomic.StoreInt64(logged, 0)
+	return &defragLog{logged: logged}
+}
+
+func (l *defragLog) createBucket(name []byte) {
+	l.add(defragOp{typ: defragOpCreateBucket, bucket: name})
+}
+
+func (l *defragLog) put(bucket, key, value []byte) {
+	l.add(defragOp{typ: defragOpPut, bucket: bucket, key: key, value: value})
+}
+
+func (l *defragLog) delete(bucket, key []byte) {
+	l.add(defragOp{typ: defragOpDelete, bucket: bucket, key: key})
+}
+
+func (l *defragLog) add(op defragOp) {
+	// the slices may point into the mmapped db or be reused by the caller
+	op.bucket = copyBytes(op.bucket)
+	op.key = copyBytes(op.key)
+	op.value = copyBytes(op.value)
+	l.ops = append(l.ops, op)
+	atomic.AddInt64(l.logged, 1)
+}
+
+// replay applies the logged writes to db.
+func (l *defragLog) replay(db *bolt.DB) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		for _, op := range l.ops {
+			switch op.typ {
+			case defragOpCreateBucket:
+				if _, err := tx.CreateBucketIfNotExists(op.bucket); err != nil {
+					return err
+				}
+			case defragOpPut:
+				b, err := tx.CreateBucketIfNotExists(op.bucket)
+				if err != nil {
+					return err
+				}
+				if err = b.Put(op.key, op.value); err != nil {
+					return err
+				}
+			case defragOpDelete:
+				if b := tx.Bucket(op.bucket); b != nil {
+					if err := b.Delete(op.key); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
@@ -0,0 +1,110 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"sync/atomic"
+
+	"etcd/pkg/encryption"
+	"github.com/boltdb/bolt"
+)
+
+var (
+	// encryptionBucketName holds the encryption state of the backend. Its
+	// values are never encrypted and it is not part of the hash.
+	encryptionBucketName = []byte("encryption")
+	// strictKeyName is set once all the values of the backend are
+	// encrypted, so plaintext values are rejected rather than trusted.
+	strictKeyName = []byte("strict")
+)
+
+// valueCipher encrypts the values written to the backend and decrypts the
+// values read from it.
+type valueCipher struct {
+	c *encryption.Cipher
+	// strict is 1 once all the values are encrypted.
+	strict int32
+}
+
+// load reads the encryption state of db. A new db written with a cipher
+// only ever holds encrypted values, so it is marked strict.
+func (vc *valueCipher) load(db *bolt.DB) error {
+	if !vc.c.Enabled() {
+		return nil
+	}
+	return db.Update(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(encryptionBucketName); b != nil && b.Get(strictKeyName) != nil {
+			atomic.StoreInt32(&vc.strict, 1)
+			return nil
+		}
+		empty := true
+		tx.ForEach(func(_ []byte, _ *bolt.Bucket) error {
+			empty = false
+			return nil
+		})
+		if !empty {
+			return nil
+		}
+		atomic.StoreInt32(&vc.strict, 1)
+		return vc.markStrict(tx)
+	})
+}
+
+// markStrict records in tx that all the values are encrypted.
+func (vc *valueCipher) markStrict(tx *bolt.Tx) error {
+	b, err := tx.CreateBucketIfNotExists(encryptionBucketName)
+	if err != nil {
+		return err
+	}
+	return b.Put(strictKeyName, []byte{1})
+}
+
+func (vc *valueCipher) isStrict() bool { return atomic.LoadInt32(&vc.strict) == 1 }
+
+// encrypt encrypts a value written to the backend if encryption at rest is
+// enabled.
+func (vc *valueCipher) encrypt(v []byte) []byte {
+	ev, err := vc.c.Encrypt(v)
+	if err != nil {
+		plog.Fatalf("cannot encrypt value (%v)", err)
+	}
+	return ev
+}
+
+// decrypt decrypts a value read from the backend.
+func (vc *valueCipher) decrypt(v []byte) []byte {
+	dv, err := vc.tryDecrypt(v)
+	if err != nil {
+		plog.Fatalf("cannot decrypt value (%v)", err)
+	}
+	return dv
+}
+
+func (vc *valueCipher) tryDecrypt(v []byte) ([]byte, error) {
+	if vc.isStrict() {
+		return vc.c.DecryptStrict(v)
+	}
+	return vc.c.Decrypt(v)
+}
+
+// reencrypt encrypts v with the active key, or decrypts it if encryption is
+// disabled.
+func (vc *valueCipher) reencrypt(v []byte) ([]byte, error) {
+	dv, err := vc.tryDecrypt(v)
+	if err != nil {
+		return nil, err
+	}
+	return vc.c.Encrypt(dv)
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bytes"
+	"math"
+	"sync"
+
+	"github.com/boltdb/bolt"
+)
+
+// ReadTx reads the backend without taking the lock of the batch tx, so many
+// readers can run concurrently with each other and with the batch tx.
+// A ReadTx sees the committed data and the puts of the batch tx made before
+// it was locked. Deletes are only seen once the batch tx commits.
+type ReadTx interface {
+	Lock()
+	Unlock()
+
+	UnsafeRange(bucketName []byte, key, endKey []byte, limit int64) (keys [][]byte, vals [][]byte)
+	UnsafeForEach(bucketName []byte, visitor func(k, v []byte) error) error
+}
+
+type readTx struct {
+	// mu protects accesses to the txReadBuffer
+	mu  sync.RWMutex
+	buf txReadBuffer
+
+	// txmu protects accesses to the Tx on Range requests
+	txmu sync.Mutex
+	tx   *bolt.Tx
+
+	vc *valueCipher
+}
+
+func (rt *readTx) Lock()   { rt.mu.RLock() }
+func (rt *readTx) Unlock() { rt.mu.RUnlock() }
+
+func (rt *readTx) UnsafeRange(bucketName, key, endKey []byte, limit int64) ([][]byte, [][]byte) {
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	bkeys, bvals := rt.buf.Range(bucketName, key, endKey, limit)
+	if len(endKey) == 0 && len(bkeys) != 0 {
+		// the buffered put is newer than the committed one
+		return bkeys, bvals
+	}
+
+	rt.txmu.Lock()
+	var keys, vals [][]byte
+	// the bucket may have been created by the batch tx and not committed yet
+	if bucket := rt.tx.Bucket(bucketName); bucket != nil {
+		keys, vals = unsafeRange(bucket.Cursor(), key, endKey, limit, rt.vc)
+	}
+	rt.txmu.Unlock()
+
+	if len(bkeys) == 0 {
+		return keys, vals
+	}
+	return mergeRange(keys, vals, bkeys, bvals, limit)
+}
+
+func (rt *readTx) UnsafeForEach(bucketName []byte, visitor func(k, v []byte) error) error {
+	dups := make(map[string]struct{})
+	if err := rt.buf.ForEach(bucketName, func(k, v []byte) error {
+		dups[string(k)] = struct{}{}
+		return visitor(k, v)
+	}); err != nil {
+		return err
+	}
+
+	rt.txmu.Lock()
+	defer rt.txmu.Unlock()
+	b := rt.tx.Bucket(bucketName)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, v []byte) error {
+		if _, ok := dups[string(k)]; ok {
+			return nil
+		}
+		return visitor(k, rt.vc.decrypt(v))
+	})
+}
+
+func (rt *readTx) reset() {
+	rt.buf.reset()
+	rt.tx = nil
+}
+
+// unsafeRange returns the keys in [key, endKey), or key alone if endKey
+// is empty, up to limit keys if limit is positive, with their values
+// decrypted by vc.
+func unsafeRange(c *bolt.Cursor, key, endKey []byte, limit int64, vc *valueCipher) (keys [][]byte, vs [][]byte) {
+	var isMatch func(b []byte) bool
+	if len(endKey) > 0 {
+		isMatch = func(b []byte) bool { return bytes.Compare(b, endKey) < 0 }
+	} else {
+		isMatch = func(b []byte) bool { return bytes.Equal(b, key) }
+		limit = 1
+	}
+	for ck, cv := c.Seek(key); ck != nil && isMatch(ck); ck, cv = c.Next() {
+		vs = append(vs, vc.decrypt(cv))
+		keys = append(keys, ck)
+		if limit > 0 && limit == int64(len(keys)) {
+			break
+		}
+	}
+	return keys, vs
+}
+
+// mergeRange merges the sorted committed and buffered ranges, keeping the
+// buffered value of the keys in both, up to limit keys.
+func mergeRange(keys, vals, bkeys, bvals [][]byte, limit int64) ([][]byte, [][]byte) {
+	mkeys := make([][]byte, 0, len(keys)+len(bkeys))
+	mvals := make([][]byte, 0, len(keys)+len(bkeys))
+	i, j := 0, 0
+	for int64(len(mkeys)) < limit && (i < len(keys) || j < len(bkeys)) {
+		switch {
+		case j == len(bkeys):
+			mkeys, mvals = append(mkeys, keys[i]), append(mvals, vals[i])
+			i++
+		case i == len(keys):
+			mkeys, mvals = append(mkeys, bkeys[j]), append(mvals, bvals[j])
+			j++
+		default:
+			c := bytes.Compare(keys[i], bkeys[j])
+			if c < 0 {
+				mkeys, mvals = append(mkeys, keys[i]), append(mvals, vals[i])
+				i++
+				continue
+			}
+			if c == 0 {
+				i++
+			}
+			mkeys, mvals = append(mkeys, bkeys[j]), append(mvals, bvals[j])
+			j++
+		}
+	}
+	return mkeys, mvals
+}
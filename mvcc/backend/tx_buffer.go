@@ -0,0 +1,178 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bytes"
+	"sort"
+)
+
+// txBuffer handles functionality shared between txWriteBuffer and txReadBuffer.
+type txBuffer struct {
+	buckets map[string]*bucketBuffer
+}
+
+func (txb *txBuffer) reset() {
+	for k, v := range txb.buckets {
+		if v.used == 0 {
+			// demote
+			delete(txb.buckets, k)
+		}
+		v.used = 0
+	}
+}
+
+// txWriteBuffer buffers the puts of the ongoing batch tx until it unlocks.
+type txWriteBuffer struct {
+	txBuffer
+}
+
+func (txw *txWriteBuffer) put(bucket, k, v []byte) {
+	b, ok := txw.buckets[string(bucket)]
+	if !ok {
+		b = newBucketBuffer()
+		txw.buckets[string(bucket)] = b
+	}
+	b.add(k, v)
+}
+
+// writeback merges the buffered puts into the read buffer.
+func (txw *txWriteBuffer) writeback(txr *txReadBuffer) {
+	for k, wb := range txw.buckets {
+		if wb.used == 0 {
+			continue
+		}
+		rb, ok := txr.buckets[k]
+		if !ok {
+			rb = newBucketBuffer()
+			txr.buckets[k] = rb
+		}
+		rb.merge(wb)
+	}
+	txw.reset()
+}
+
+// txReadBuffer holds the puts of the batch tx which are not committed yet.
+type txReadBuffer struct {
+	txBuffer
+}
+
+func (txr *txReadBuffer) Range(bucketName, key, endKey []byte, limit int64) ([][]byte, [][]byte) {
+	if b := txr.buckets[string(bucketName)]; b != nil {
+		return b.Range(key, endKey, limit)
+	}
+	return nil, nil
+}
+
+func (txr *txReadBuffer) ForEach(bucketName []byte, visitor func(k, v []byte) error) error {
+	if b := txr.buckets[string(bucketName)]; b != nil {
+		return b.ForEach(visitor)
+	}
+	return nil
+}
+
+type kv struct {
+	key []byte
+	val []byte
+}
+
+// bucketBuffer buffers the key-value pairs of a bucket.
+type bucketBuffer struct {
+	buf []kv
+	// used tracks number of elements in use so buf can be reused without reallocation.
+	used int
+}
+
+func newBucketBuffer() *bucketBuffer {
+	return &bucketBuffer{buf: make([]kv, 512)}
+}
+
+// Range returns the sorted keys in [key, endKey), or key alone if endKey
+// is empty, up to limit keys. The buffer must be sorted.
+func (bb *bucketBuffer) Range(key, endKey []byte, limit int64) (keys [][]byte, vals [][]byte) {
+	idx := sort.Search(bb.used, func(i int) bool { return bytes.Compare(bb.buf[i].key, key) >= 0 })
+	if len(endKey) == 0 {
+		if idx < bb.used && bytes.Equal(bb.buf[idx].key, key) {
+			return [][]byte{bb.buf[idx].key}, [][]byte{bb.buf[idx].val}
+		}
+		return nil, nil
+	}
+	for i := idx; i < bb.used && int64(len(keys)) < limit; i++ {
+		if bytes.Compare(bb.buf[i].key, endKey) >= 0 {
+			break
+		}
+		keys = append(keys, bb.buf[i].key)
+		vals = append(vals, bb.buf[i].val)
+	}
+	return keys, vals
+}
+
+func (bb *bucketBuffer) ForEach(visitor func(k, v []byte) error) error {
+	for i := 0; i < bb.used; i++ {
+		if err := visitor(bb.buf[i].key, bb.buf[i].val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bb *bucketBuffer) add(k, v []byte) {
+	bb.buf[bb.used].key, bb.buf[bb.used].val = k, v
+	bb.used++
+	if bb.used == len(bb.buf) {
+		buf := make([]kv, (3*len(bb.buf))/2)
+		copy(buf, bb.buf)
+		bb.buf = buf
+	}
+}
+
+// merge appends the pairs of bbsrc, which are newer than the pairs of bb,
+// and keeps bb sorted with only the newest pair of each key.
+func (bb *bucketBuffer) merge(bbsrc *bucketBuffer) {
+	if bbsrc.used == 0 {
+		return
+	}
+	old := bb.used
+	for i := 0; i < bbsrc.used; i++ {
+		bb.add(bbsrc.buf[i].key, bbsrc.buf[i].val)
+	}
+	// puts of increasing keys, like the revisions of the key bucket,
+	// keep the buffer sorted.
+	sorted := old == 0 || bytes.Compare(bb.buf[old-1].key, bb.buf[old].key) < 0
+	for i := old + 1; sorted && i < bb.used; i++ {
+		sorted = bytes.Compare(bb.buf[i-1].key, bb.buf[i].key) < 0
+	}
+	if sorted {
+		return
+	}
+
+	sort.Stable(bb)
+
+	// remove duplicates, using only newest update
+	widx := 0
+	for ridx := 1; ridx < bb.used; ridx++ {
+		if !bytes.Equal(bb.buf[ridx].key, bb.buf[widx].key) {
+			widx++
+		}
+		bb.buf[widx] = bb.buf[ridx]
+	}
+	bb.used = widx + 1
+}
+
+func (bb *bucketBuffer) Len() int { return bb.used }
+func (bb *bucketBuffer) Less(i, j int) bool {
+	return bytes.Compare(bb.buf[i].key, bb.buf[j].key) < 0
+}
+func (bb *bucketBuffer) Swap(i, j int) { bb.buf[i], bb.buf[j] = bb.buf[j], bb.buf[i] }
@@ -24,6 +24,10 @@ type RangeOptions struct {
 	Limit int64
 	Rev   int64
 	Count bool
+	// ValueIndex is the name of the value index to range over; only the
+	// keys holding Value are returned.
+	ValueIndex string
+	Value      []byte
 }
 
 type RangeResult struct {
@@ -32,6 +36,56 @@ type RangeResult struct {
 	Count int
 }
 
+type HistoryOptions struct {
+	MinRev int64
+	MaxRev int64
+	Limit  int64
+}
+
+type HistoryResult struct {
+	Events []mvccpb.Event
+	Rev    int64
+	More   bool
+}
+
+// ReadView is a view of the KV at a consistent revision.
+type ReadView interface {
+	// FirstRev returns the first KV revision at the time of opening the txn.
+	// After a compaction, the first revision increases to the compaction
+	// revision.
+	FirstRev() int64
+
+	// Rev returns the revision of the KV at the time of opening the txn.
+	Rev() int64
+
+	// Range gets the keys in the range at rangeRev.
+	// The returned rev is the revision of the view.
+	// If rangeRev <=0, range gets the keys at the revision of the view.
+	// If `end` is nil, the request returns the key.
+	// If `end` is not nil and not empty, it gets the keys in range [key, range_end).
+	// If `end` is not nil and empty, it gets the keys greater than or equal to key.
+	// Limit limits the number of keys returned.
+	// If the required rev is compacted, ErrCompacted will be returned.
+	Range(key, end []byte, ro RangeOptions) (r *RangeResult, err error)
+
+	// History gets the revisions of the keys in the range between MinRev and
+	// MaxRev, tombstones included, sorted by revision.
+	// If MinRev <= 0, history starts at the oldest retained revision.
+	// If MaxRev <= 0, history ends at the revision of the view.
+	// Limit limits the number of events returned.
+	// If MinRev is compacted, ErrCompacted will be returned.
+	History(key, end []byte, ho HistoryOptions) (r *HistoryResult, err error)
+}
+
+// TxnRead represents a read-only transaction. Many read transactions can
+// run concurrently with each other and with the write transaction; they do
+// not see the changes made after they are opened.
+type TxnRead interface {
+	ReadView
+	// End marks the transaction is complete and releases it.
+	End()
+}
+
 type KV interface {
 	// Rev returns the current revision of the KV.
 	Rev() int64
@@ -51,6 +105,14 @@ type KV interface {
 	// If the required rev is compacted, ErrCompacted will be returned.
 	Range(key, end []byte, ro RangeOptions) (r *RangeResult, err error)
 
+	// History gets the revisions of the keys in the range, tombstones
+	// included, as of the current revision of the KV.
+	History(key, end []byte, ho HistoryOptions) (r *HistoryResult, err error)
+
+	// Read creates a read transaction at the current revision of the KV.
+	// The transaction must be ended with End.
+	Read() TxnRead
+
 	// Put puts the given key, value into the store. Put also takes additional argument lease to
 	// attach a lease to a key-value pair as meta-data. KV implementation does not validate the lease
 	// id.
@@ -67,8 +129,9 @@ type KV interface {
 	// if the `end` is not nil, deleteRange deletes the keys in range [key, range_end).
 	DeleteRange(key, end []byte) (n, rev int64)
 
-	// TxnBegin begins a txn. Only Txn prefixed operation can be executed, others will be blocked
-	// until txn ends. Only one on-going txn is allowed.
+	// TxnBegin begins a txn. Only Txn prefixed operation can be executed, other writes will be blocked
+	// until txn ends. Only one on-going txn is allowed. Reads are not blocked and do not see the
+	// changes of the txn until it ends.
 	// TxnBegin returns an int64 txn ID.
 	// All txn prefixed operations with same txn ID will be done with the same rev.
 	TxnBegin() int64
@@ -409,7 +409,6 @@ func TestKVTxnBlockNonTxnOperations(t *testing.T) {
 	s := NewStore(b, &lease.FakeLessor{}, nil)
 
 	tests := []func(){
-		func() { s.Range([]byte("foo"), nil, RangeOptions{}) },
 		func() { s.Put([]byte("foo"), nil, lease.NoLease) },
 		func() { s.DeleteRange([]byte("foo"), nil) },
 	}
@@ -438,6 +437,84 @@ func TestKVTxnBlockNonTxnOperations(t *testing.T) {
 	cleanup(s, b, tmpPath)
 }
 
+func TestKVTxnNonBlockRange(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(b, &lease.FakeLessor{}, nil)
+	defer cleanup(s, b, tmpPath)
+
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+
+	id := s.TxnBegin()
+	if _, err := s.TxnPut(id, []byte("foo"), []byte("bar1"), lease.NoLease); err != nil {
+		t.Fatal(err)
+	}
+	donec := make(chan *RangeResult, 1)
+	go func() {
+		r, err := s.Range([]byte("foo"), nil, RangeOptions{})
+		if err != nil {
+			t.Error(err)
+		}
+		donec <- r
+	}()
+	select {
+	case r := <-donec:
+		// the range does not see the changes of the ongoing txn
+		if r.Rev != 2 || len(r.KVs) != 1 || string(r.KVs[0].Value) != "bar" {
+			t.Errorf("range = %+v, want value bar at rev 2", r)
+		}
+	case <-time.After(10 * time.Second):
+		testutil.FatalStack(t, "range is blocked by the txn")
+	}
+	s.TxnEnd(id)
+}
+
+func TestKVConcurrentReads(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(b, &lease.FakeLessor{}, nil)
+	defer cleanup(s, b, tmpPath)
+
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+
+	// many read txns can be open at once
+	txns := []TxnRead{s.Read(), s.Read()}
+
+	id := s.TxnBegin()
+	s.TxnPut(id, []byte("foo"), []byte("bar1"), lease.NoLease)
+	donec := make(chan struct{})
+	go func() {
+		s.TxnEnd(id)
+		close(donec)
+	}()
+
+	for i, txn := range txns {
+		if txn.Rev() != 2 {
+			t.Errorf("#%d: rev = %d, want 2", i, txn.Rev())
+		}
+		r, err := txn.Range([]byte("foo"), nil, RangeOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Rev != 2 || len(r.KVs) != 1 || string(r.KVs[0].Value) != "bar" {
+			t.Errorf("#%d: range = %+v, want value bar at rev 2", i, r)
+		}
+		if _, err = txn.Range([]byte("foo"), nil, RangeOptions{Rev: 3}); err != ErrFutureRev {
+			t.Errorf("#%d: err = %v, want %v", i, err, ErrFutureRev)
+		}
+		txn.End()
+	}
+	<-donec
+
+	txn := s.Read()
+	defer txn.End()
+	r, err := txn.Range([]byte("foo"), nil, RangeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Rev != 3 || len(r.KVs) != 1 || string(r.KVs[0].Value) != "bar1" {
+		t.Errorf("range = %+v, want value bar1 at rev 3", r)
+	}
+}
+
 func TestKVTxnWrongID(t *testing.T) {
 	b, tmpPath := backend.NewDefaultTmpBackend()
 	s := NewStore(b, &lease.FakeLessor{}, nil)
@@ -721,7 +798,7 @@ func TestKVSnapshot(t *testing.T) {
 
 func TestWatchableKVWatch(t *testing.T) {
 	b, tmpPath := backend.NewDefaultTmpBackend()
-	s := WatchableKV(newWatchableStore(b, &lease.FakeLessor{}, nil))
+	s := WatchableKV(newWatchableStore(b, &lease.FakeLessor{}, nil, StoreConfig{}))
 	defer cleanup(s, b, tmpPath)
 
 	w := s.NewWatchStream()
@@ -20,6 +20,7 @@ import (
 	"math"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"etcd/lease"
@@ -60,28 +61,56 @@ type ConsistentIndexGetter interface {
 	ConsistentIndex() uint64
 }
 
+// StoreConfig configures the background work of the store.
+// Zero values use the defaults.
+type StoreConfig struct {
+	// CompactionBatchLimit is the maximum number of revisions deleted
+	// from the backend under a single lock of the batch tx.
+	CompactionBatchLimit int
+	// CompactionSleepInterval is the pause between compaction batches.
+	CompactionSleepInterval time.Duration
+	// CompactionBatchMaxLatency is the maximum time a compaction batch
+	// holds the batch tx. 0 means no limit.
+	CompactionBatchMaxLatency time.Duration
+	// ValueIndexes are the secondary value indexes maintained by the store.
+	ValueIndexes []ValueIndex
+}
+
+const (
+	defaultCompactionBatchLimit    = 10000
+	defaultCompactionSleepInterval = 100 * time.Millisecond
+)
+
 type store struct {
-	mu sync.Mutex // guards the following
+	// mu read locks for txns and write locks for non-txn store changes.
+	mu sync.RWMutex
+
+	// pendingWrites counts the write txns waiting for the batch tx;
+	// compaction yields to them. Accessed atomically.
+	pendingWrites int32
 
 	ig ConsistentIndexGetter
 
+	cfg StoreConfig
+
 	b       backend.Backend
 	kvindex index
 
 	le lease.Lessor
 
+	// revMu protects currentRev and compactMainRev.
+	// Locked at end of write txn and released after write txn unlock lock.
+	// Locked before locking read txn and released after locking.
+	revMu      sync.RWMutex
 	currentRev revision
 	// the main revision of the last compaction
 	compactMainRev int64
 
+	// the write txn fields are guarded by the lock of the batch tx.
 	tx        backend.BatchTx
 	txnID     int64 // tracks the current txnID to verify txn operations
 	txnModify bool
 
-	// bytesBuf8 is a byte slice of length 8
-	// to avoid a repetitive allocation in saveIndex.
-	bytesBuf8 []byte
-
 	changes   []mvccpb.KeyValue
 	fifoSched schedule.Scheduler
 
@@ -91,9 +120,20 @@ type store struct {
 // NewStore returns a new store. It is useful to create a store inside
 // mvcc pkg. It should only be used for testing externally.
 func NewStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *store {
+	return newStore(b, le, ig, StoreConfig{})
+}
+
+func newStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter, cfg StoreConfig) *store {
+	if cfg.CompactionBatchLimit <= 0 {
+		cfg.CompactionBatchLimit = defaultCompactionBatchLimit
+	}
+	if cfg.CompactionSleepInterval <= 0 {
+		cfg.CompactionSleepInterval = defaultCompactionSleepInterval
+	}
 	s := &store{
 		b:       b,
 		ig:      ig,
+		cfg:     cfg,
 		kvindex: newTreeIndex(),
 
 		le: le,
@@ -101,7 +141,6 @@ func NewStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *sto
 		currentRev:     revision{main: 1},
 		compactMainRev: -1,
 
-		bytesBuf8: make([]byte, 8, 8),
 		fifoSched: schedule.NewFIFOScheduler(),
 
 		stopc: make(chan struct{}),
@@ -115,6 +154,7 @@ func NewStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *sto
 	tx.Lock()
 	tx.UnsafeCreateBucket(keyBucketName)
 	tx.UnsafeCreateBucket(metaBucketName)
+	tx.UnsafeCreateBucket(valueIndexBucketName)
 	tx.Unlock()
 	s.b.ForceCommit()
 
@@ -127,15 +167,15 @@ func NewStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *sto
 }
 
 func (s *store) Rev() int64 {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.revMu.RLock()
+	defer s.revMu.RUnlock()
 
 	return s.currentRev.main
 }
 
 func (s *store) FirstRev() int64 {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.revMu.RLock()
+	defer s.revMu.RUnlock()
 
 	return s.compactMainRev
 }
@@ -151,19 +191,9 @@ func (s *store) Put(key, value []byte, lease lease.LeaseID) int64 {
 }
 
 func (s *store) Range(key, end []byte, ro RangeOptions) (r *RangeResult, err error) {
-	id := s.TxnBegin()
-	kvs, count, rev, err := s.rangeKeys(key, end, ro.Limit, ro.Rev, ro.Count)
-	s.txnEnd(id)
-
-	rangeCounter.Inc()
-
-	r = &RangeResult{
-		KVs:   kvs,
-		Count: count,
-		Rev:   rev,
-	}
-
-	return r, err
+	txn := s.Read()
+	defer txn.End()
+	return txn.Range(key, end, ro)
 }
 
 func (s *store) DeleteRange(key, end []byte) (n, rev int64) {
@@ -177,10 +207,13 @@ func (s *store) DeleteRange(key, end []byte) (n, rev int64) {
 }
 
 func (s *store) TxnBegin() int64 {
-	s.mu.Lock()
+	s.mu.RLock()
+	tx := s.b.BatchTx()
+	atomic.AddInt32(&s.pendingWrites, 1)
+	tx.Lock()
+	atomic.AddInt32(&s.pendingWrites, -1)
+	s.tx = tx
 	s.currentRev.sub = 0
-	s.tx = s.b.BatchTx()
-	s.tx.Lock()
 
 	s.txnID = rand.Int63()
 	return s.txnID
@@ -211,14 +244,22 @@ func (s *store) txnEnd(txnID int64) error {
 	}
 	s.txnModify = false
 
-	s.tx.Unlock()
-	if s.currentRev.sub != 0 {
+	// hold revMu lock to prevent new read txns from opening until the
+	// changes are written back to the read buffer on unlock.
+	modified := s.currentRev.sub != 0
+	if modified {
+		s.revMu.Lock()
 		s.currentRev.main += 1
 	}
 	s.currentRev.sub = 0
+	s.tx.Unlock()
+	if modified {
+		s.revMu.Unlock()
+	}
 
 	dbTotalSize.Set(float64(s.b.Size()))
-	s.mu.Unlock()
+	dbTotalSizeInUse.Set(float64(s.b.SizeInUse()))
+	s.mu.RUnlock()
 	return nil
 }
 
@@ -227,7 +268,18 @@ func (s *store) TxnRange(txnID int64, key, end []byte, ro RangeOptions) (r *Rang
 		return nil, ErrTxnIDMismatch
 	}
 
-	kvs, count, rev, err := s.rangeKeys(key, end, ro.Limit, ro.Rev, ro.Count)
+	curRev := int64(s.currentRev.main)
+	if s.currentRev.sub > 0 {
+		curRev += 1
+	}
+	var kvs []mvccpb.KeyValue
+	var count int
+	var rev int64
+	if ro.ValueIndex != "" {
+		kvs, count, rev, err = s.rangeValueIndex(s.tx, ro.ValueIndex, ro.Value, key, end, ro.Limit, ro.Rev, curRev, ro.Count)
+	} else {
+		kvs, count, rev, err = s.rangeKeys(s.tx, key, end, ro.Limit, ro.Rev, curRev, s.compactMainRev, ro.Count)
+	}
 
 	r = &RangeResult{
 		KVs:   kvs,
@@ -290,7 +342,9 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 
 	start := time.Now()
 
+	s.revMu.Lock()
 	s.compactMainRev = rev
+	s.revMu.Unlock()
 
 	rbytes := newRevBytes()
 	revToBytes(revision{main: rev}, rbytes)
@@ -330,6 +384,8 @@ func init() {
 		// consistent index might be changed due to v2 internal sync, which
 		// is not controllable by the user.
 		{Bucket: string(metaBucketName), Key: string(consistentIndexKeyName)}: {},
+		// value indexes are configured per member.
+		{Bucket: string(valueIndexBucketName)}: {},
 	}
 }
 
@@ -363,8 +419,10 @@ func (s *store) Restore(b backend.Backend) error {
 
 	s.b = b
 	s.kvindex = newTreeIndex()
+	s.revMu.Lock()
 	s.currentRev = revision{main: 1}
 	s.compactMainRev = -1
+	s.revMu.Unlock()
 	s.tx = b.BatchTx()
 	s.txnID = -1
 	s.fifoSched = schedule.NewFIFOScheduler()
@@ -388,6 +446,7 @@ func (s *store) restore() error {
 	// restore index
 	tx := s.b.BatchTx()
 	tx.Lock()
+	s.revMu.Lock()
 	_, finishedCompactBytes := tx.UnsafeRange(metaBucketName, finishedCompactKeyName, nil, 0)
 	if len(finishedCompactBytes) != 0 {
 		s.compactMainRev = bytesToRev(finishedCompactBytes[0]).main
@@ -444,6 +503,7 @@ func (s *store) restore() error {
 	if s.currentRev.main < s.compactMainRev {
 		s.currentRev.main = s.compactMainRev
 	}
+	s.revMu.Unlock()
 
 	for key, lid := range keyToLease {
 		if s.le == nil {
@@ -455,6 +515,8 @@ func (s *store) restore() error {
 		}
 	}
 
+	s.rebuildValueIndexes(tx)
+
 	_, scheduledCompactBytes := tx.UnsafeRange(metaBucketName, scheduledCompactKeyName, nil, 0)
 	scheduledCompact := int64(0)
 	if len(scheduledCompactBytes) != 0 {
@@ -491,22 +553,18 @@ func (a *store) Equal(b *store) bool {
 }
 
 // range is a keyword in Go, add Keys suffix.
-func (s *store) rangeKeys(key, end []byte, limit, rangeRev int64, countOnly bool) (kvs []mvccpb.KeyValue, count int, curRev int64, err error) {
-	curRev = int64(s.currentRev.main)
-	if s.currentRev.sub > 0 {
-		curRev += 1
-	}
-
+// rangeKeys gets the keys at rangeRev from tx, which holds the changes up
+// to curRev; compactRev is the compacted revision.
+func (s *store) rangeKeys(tx backend.ReadTx, key, end []byte, limit, rangeRev, curRev, compactRev int64, countOnly bool) (kvs []mvccpb.KeyValue, count int, rev int64, err error) {
 	if rangeRev > curRev {
-		return nil, -1, s.currentRev.main, ErrFutureRev
+		return nil, -1, curRev, ErrFutureRev
 	}
-	var rev int64
 	if rangeRev <= 0 {
 		rev = curRev
 	} else {
 		rev = rangeRev
 	}
-	if rev < s.compactMainRev {
+	if rev < compactRev {
 		return nil, -1, 0, ErrCompacted
 	}
 
@@ -518,10 +576,12 @@ func (s *store) rangeKeys(key, end []byte, limit, rangeRev int64, countOnly bool
 		return nil, len(revpairs), curRev, nil
 	}
 
+	revBytes := newRevBytes()
 	for _, revpair := range revpairs {
-		start, end := revBytesRange(revpair)
-
-		_, vs := s.tx.UnsafeRange(keyBucketName, start, end, 0)
+		// the index only holds the revisions of puts, which are stored
+		// under the exact revision bytes.
+		revToBytes(revpair, revBytes)
+		_, vs := tx.UnsafeRange(keyBucketName, revBytes, nil, 0)
 		if len(vs) != 1 {
 			plog.Fatalf("range cannot find rev (%d,%d)", revpair.main, revpair.sub)
 		}
@@ -544,13 +604,15 @@ func (s *store) put(key, value []byte, leaseID lease.LeaseID) {
 	rev := s.currentRev.main + 1
 	c := rev
 	oldLease := lease.NoLease
+	var prev *mvccpb.KeyValue
 
 	// if the key exists before, use its previous created and
 	// get its previous leaseID
-	_, created, ver, err := s.kvindex.Get(key, rev)
+	modified, created, ver, err := s.kvindex.Get(key, rev)
 	if err == nil {
 		c = created.main
 		oldLease = s.le.GetLease(lease.LeaseItem{Key: string(key)})
+		prev = s.indexedKV(key, modified)
 	}
 
 	ibytes := newRevBytes()
@@ -573,6 +635,7 @@ func (s *store) put(key, value []byte, leaseID lease.LeaseID) {
 
 	s.tx.UnsafeSeqPut(keyBucketName, ibytes, d)
 	s.kvindex.Put(key, revision{main: rev, sub: s.currentRev.sub})
+	s.indexKV(prev, &kv)
 	s.changes = append(s.changes, kv)
 	s.currentRev.sub += 1
 
@@ -639,6 +702,9 @@ func (s *store) delete(key []byte, rev revision) {
 	if err != nil {
 		plog.Fatalf("cannot tombstone an existing key (%s): %v", string(key), err)
 	}
+	if prev := s.indexedKV(key, rev); prev != nil {
+		s.indexKV(prev, nil)
+	}
 	s.changes = append(s.changes, kv)
 	s.currentRev.sub += 1
 
@@ -664,7 +730,9 @@ func (s *store) saveIndex() {
 		return
 	}
 	tx := s.tx
-	bs := s.bytesBuf8
+	// the read tx keeps a reference to the value until it is committed,
+	// so it cannot be reused.
+	bs := make([]byte, 8)
 	binary.BigEndian.PutUint64(bs, s.ig.ConsistentIndex())
 	// put the index into the underlying backend
 	// tx has been locked in TxnBegin, so there is no need to lock it again
@@ -695,16 +763,3 @@ func appendMarkTombstone(b []byte) []byte {
 func isTombstone(b []byte) bool {
 	return len(b) == markedRevBytesLen && b[markBytePosition] == markTombstone
 }
-
-// revBytesRange returns the range of revision bytes at
-// the given revision.
-func revBytesRange(rev revision) (start, end []byte) {
-	start = newRevBytes()
-	revToBytes(rev, start)
-
-	end = newRevBytes()
-	endRev := revision{main: rev.main, sub: rev.sub + 1}
-	revToBytes(endRev, end)
-
-	return start, end
-}
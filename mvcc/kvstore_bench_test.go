@@ -122,3 +122,41 @@ func BenchmarkStoreRestoreRevs10(b *testing.B) {
 func BenchmarkStoreRestoreRevs20(b *testing.B) {
 	benchmarkStoreRestore(20, b)
 }
+
+// BenchmarkStoreMixedReadWrite benchmarks ranges over the store while a
+// writer keeps putting keys.
+func BenchmarkStoreMixedReadWrite(b *testing.B) {
+	var i fakeConsistentIndex
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(be, &lease.FakeLessor{}, &i)
+	defer cleanup(s, be, tmpPath)
+
+	keys := createBytesSlice(64, 1000)
+	vals := createBytesSlice(128, 1000)
+	for j := range keys {
+		s.Put(keys[j], vals[j], lease.NoLease)
+	}
+
+	stopc, donec := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(donec)
+		for j := 0; ; j++ {
+			select {
+			case <-stopc:
+				return
+			default:
+			}
+			s.Put(keys[j%len(keys)], vals[j%len(vals)], lease.NoLease)
+		}
+	}()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for j := 0; pb.Next(); j++ {
+			s.Range(keys[j%len(keys)], nil, RangeOptions{})
+		}
+	})
+	b.StopTimer()
+	close(stopc)
+	<-donec
+}
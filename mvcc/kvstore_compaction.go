@@ -16,18 +16,27 @@ package mvcc
 
 import (
 	"encoding/binary"
+	"sync/atomic"
 	"time"
 )
 
+// compactionYieldCheck is the number of revisions compacted between
+// checks whether the batch should yield the batch tx.
+const compactionYieldCheck = 100
+
 func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struct{}) bool {
 	totalStart := time.Now()
-	defer dbCompactionTotalDurations.Observe(float64(time.Since(totalStart) / time.Millisecond))
+	defer func() {
+		dbCompactionTotalDurations.Observe(float64(time.Since(totalStart) / time.Millisecond))
+	}()
 
 	end := make([]byte, 8)
 	binary.BigEndian.PutUint64(end, uint64(compactMainRev+1))
 
-	batchsize := int64(10000)
+	batchsize := s.cfg.CompactionBatchLimit
 	last := make([]byte, 8+1+8)
+	firstRev := int64(-1)
+	dbCompactionProgress.Set(0)
 	for {
 		var rev revision
 
@@ -35,19 +44,33 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 		tx := s.b.BatchTx()
 		tx.Lock()
 
-		keys, _ := tx.UnsafeRange(keyBucketName, last, end, batchsize)
-		for _, key := range keys {
+		keys, _ := tx.UnsafeRange(keyBucketName, last, end, int64(batchsize))
+		done := len(keys) < batchsize
+		deleted := 0
+		for i, key := range keys {
+			if i != 0 && i%compactionYieldCheck == 0 && s.shouldYieldCompaction(start) {
+				dbCompactionYieldCounter.Inc()
+				done = false
+				break
+			}
 			rev = bytesToRev(key)
+			if firstRev < 0 {
+				firstRev = rev.main
+			}
 			if _, ok := keep[rev]; !ok {
 				tx.UnsafeDelete(keyBucketName, key)
+				deleted++
 			}
 		}
+		dbCompactionKeysCounter.Add(float64(deleted))
 
-		if len(keys) < int(batchsize) {
+		if done {
 			rbytes := make([]byte, 8+1+8)
 			revToBytes(revision{main: compactMainRev}, rbytes)
 			tx.UnsafePut(metaBucketName, finishedCompactKeyName, rbytes)
 			tx.Unlock()
+			dbCompactionPauseDurations.Observe(float64(time.Since(start) / time.Millisecond))
+			dbCompactionProgress.Set(1)
 			plog.Printf("finished scheduled compaction at %d (took %v)", compactMainRev, time.Since(totalStart))
 			return true
 		}
@@ -56,11 +79,25 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 		revToBytes(revision{main: rev.main, sub: rev.sub + 1}, last)
 		tx.Unlock()
 		dbCompactionPauseDurations.Observe(float64(time.Since(start) / time.Millisecond))
+		if compactMainRev > firstRev {
+			dbCompactionProgress.Set(float64(rev.main-firstRev) / float64(compactMainRev-firstRev))
+		}
 
 		select {
-		case <-time.After(100 * time.Millisecond):
+		case <-time.After(s.cfg.CompactionSleepInterval):
 		case <-s.stopc:
 			return false
 		}
 	}
 }
+
+// shouldYieldCompaction returns true if the compaction batch started at
+// start should release the batch tx to the pending writes or because it
+// is over its latency budget.
+func (s *store) shouldYieldCompaction(start time.Time) bool {
+	if atomic.LoadInt32(&s.pendingWrites) > 0 {
+		return true
+	}
+	max := s.cfg.CompactionBatchMaxLatency
+	return max > 0 && time.Since(start) > max
+}
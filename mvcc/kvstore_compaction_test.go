@@ -96,6 +96,54 @@ func TestScheduleCompaction(t *testing.T) {
 	}
 }
 
+// TestScheduleCompactionPaced ensures compaction removes the compacted
+// revisions when it runs in small batches or yields to pending writes.
+func TestScheduleCompactionPaced(t *testing.T) {
+	tests := []struct {
+		cfg     StoreConfig
+		nrevs   int64
+		pending int32
+	}{
+		{StoreConfig{CompactionBatchLimit: 3, CompactionSleepInterval: time.Millisecond}, 10, 0},
+		{StoreConfig{CompactionSleepInterval: time.Millisecond}, 250, 1},
+		{StoreConfig{CompactionSleepInterval: time.Millisecond, CompactionBatchMaxLatency: time.Nanosecond}, 250, 0},
+	}
+	for i, tt := range tests {
+		b, tmpPath := backend.NewDefaultTmpBackend()
+		s := newStore(b, &lease.FakeLessor{}, nil, tt.cfg)
+		tx := s.b.BatchTx()
+
+		tx.Lock()
+		ibytes := newRevBytes()
+		for rev := int64(1); rev <= tt.nrevs; rev++ {
+			revToBytes(revision{main: rev}, ibytes)
+			tx.UnsafePut(keyBucketName, ibytes, []byte("bar"))
+		}
+		tx.Unlock()
+
+		s.pendingWrites = tt.pending
+		compactRev := tt.nrevs - 3
+		if !s.scheduleCompaction(compactRev, nil) {
+			t.Fatalf("#%d: compaction stopped", i)
+		}
+		s.pendingWrites = 0
+
+		tx.Lock()
+		keys, _ := tx.UnsafeRange(keyBucketName, newRevBytes(), []byte{0xff}, 0)
+		if len(keys) != 3 {
+			t.Errorf("#%d: len(keys) = %d, want 3", i, len(keys))
+		}
+		if len(keys) > 0 {
+			if rev := bytesToRev(keys[0]); rev.main != compactRev+1 {
+				t.Errorf("#%d: first rev = %d, want %d", i, rev.main, compactRev+1)
+			}
+		}
+		tx.Unlock()
+
+		cleanup(s, b, tmpPath)
+	}
+}
+
 func TestCompactAllAndRestore(t *testing.T) {
 	b, tmpPath := backend.NewDefaultTmpBackend()
 	s0 := NewStore(b, &lease.FakeLessor{}, nil)
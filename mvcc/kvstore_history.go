@@ -0,0 +1,87 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"etcd/mvcc/backend"
+	"etcd/mvcc/mvccpb"
+)
+
+func (s *store) History(key, end []byte, ho HistoryOptions) (r *HistoryResult, err error) {
+	txn := s.Read()
+	defer txn.End()
+	return txn.History(key, end, ho)
+}
+
+func (tr *storeTxnRead) History(key, end []byte, ho HistoryOptions) (r *HistoryResult, err error) {
+	return tr.s.history(tr.tx, key, end, ho, tr.rev, tr.firstRev)
+}
+
+// history gets the revisions of the keys in the range from the key index
+// and reads them from tx, which holds the changes up to curRev; compactRev
+// is the compacted revision.
+func (s *store) history(tx backend.ReadTx, key, end []byte, ho HistoryOptions, curRev, compactRev int64) (*HistoryResult, error) {
+	maxRev := ho.MaxRev
+	if maxRev > curRev {
+		return nil, ErrFutureRev
+	}
+	if maxRev <= 0 {
+		maxRev = curRev
+	}
+	if ho.MinRev > 0 && ho.MinRev < compactRev {
+		return nil, ErrCompacted
+	}
+
+	r := &HistoryResult{Rev: curRev}
+	// the key index keeps the latest revision before the compaction of
+	// every key, so the history starts at the oldest retained one.
+	for _, rev := range s.kvindex.RangeSince(key, end, ho.MinRev) {
+		if rev.main > maxRev {
+			// the revisions are sorted
+			break
+		}
+		if ho.Limit > 0 && len(r.Events) >= int(ho.Limit) {
+			r.More = true
+			break
+		}
+		r.Events = append(r.Events, readEvent(tx, rev))
+	}
+	return r, nil
+}
+
+// readEvent reads the put or the tombstone at rev.
+func readEvent(tx backend.ReadTx, rev revision) mvccpb.Event {
+	// a tombstone is stored under the revision bytes with the tombstone
+	// mark, which sorts before the next sub revision.
+	min, max := newRevBytes(), newRevBytes()
+	revToBytes(rev, min)
+	revToBytes(revision{main: rev.main, sub: rev.sub + 1}, max)
+	ks, vs := tx.UnsafeRange(keyBucketName, min, max, 0)
+	if len(vs) != 1 {
+		plog.Fatalf("history cannot find rev (%d,%d)", rev.main, rev.sub)
+	}
+
+	var kv mvccpb.KeyValue
+	if err := kv.Unmarshal(vs[0]); err != nil {
+		plog.Fatalf("cannot unmarshal event: %v", err)
+	}
+	ev := mvccpb.Event{Type: mvccpb.PUT, Kv: &kv}
+	if isTombstone(ks[0]) {
+		ev.Type = mvccpb.DELETE
+		// patch in mod revision so watchers won't skip
+		kv.ModRevision = rev.main
+	}
+	return ev
+}
@@ -0,0 +1,101 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"etcd/lease"
+	"etcd/mvcc/backend"
+)
+
+func TestStoreHistory(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(b, &lease.FakeLessor{}, nil)
+	defer cleanup(s, b, tmpPath)
+
+	s.Put([]byte("foo"), []byte("bar0"), lease.NoLease)  // 2
+	s.Put([]byte("foo"), []byte("bar1"), lease.NoLease)  // 3
+	s.Put([]byte("foo1"), []byte("bar2"), lease.NoLease) // 4
+	s.DeleteRange([]byte("foo"), nil)                    // 5
+	s.Put([]byte("foo"), []byte("bar3"), lease.NoLease)  // 6
+	s.Put([]byte("zoo"), []byte("bar4"), lease.NoLease)  // 7
+	s.Put([]byte("foo1"), []byte("bar5"), lease.NoLease) // 8
+
+	tests := []struct {
+		key, end []byte
+		ho       HistoryOptions
+
+		wevs  []string
+		wmore bool
+	}{
+		{
+			[]byte("foo"), nil, HistoryOptions{},
+			[]string{"PUT foo bar0 2", "PUT foo bar1 3", "DELETE foo  5", "PUT foo bar3 6"}, false,
+		},
+		{
+			[]byte("foo"), []byte("fop"), HistoryOptions{MinRev: 3, MaxRev: 6},
+			[]string{"PUT foo bar1 3", "PUT foo1 bar2 4", "DELETE foo  5", "PUT foo bar3 6"}, false,
+		},
+		{
+			[]byte("foo"), []byte{}, HistoryOptions{MinRev: 6},
+			[]string{"PUT foo bar3 6", "PUT zoo bar4 7", "PUT foo1 bar5 8"}, false,
+		},
+		{
+			[]byte("foo"), nil, HistoryOptions{Limit: 2},
+			[]string{"PUT foo bar0 2", "PUT foo bar1 3"}, true,
+		},
+		{
+			[]byte("bar"), nil, HistoryOptions{},
+			nil, false,
+		},
+	}
+	for i, tt := range tests {
+		r, err := s.History(tt.key, tt.end, tt.ho)
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		var evs []string
+		for _, ev := range r.Events {
+			evs = append(evs, fmt.Sprintf("%s %s %s %d", ev.Type, ev.Kv.Key, ev.Kv.Value, ev.Kv.ModRevision))
+		}
+		if !reflect.DeepEqual(evs, tt.wevs) {
+			t.Errorf("#%d: events = %v, want %v", i, evs, tt.wevs)
+		}
+		if r.More != tt.wmore || r.Rev != 8 {
+			t.Errorf("#%d: more, rev = %v, %d, want %v, 8", i, r.More, r.Rev, tt.wmore)
+		}
+	}
+
+	if _, err := s.History([]byte("foo"), nil, HistoryOptions{MaxRev: 9}); err != ErrFutureRev {
+		t.Errorf("err = %v, want %v", err, ErrFutureRev)
+	}
+	if _, err := s.Compact(4); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.History([]byte("foo"), nil, HistoryOptions{MinRev: 3}); err != ErrCompacted {
+		t.Errorf("err = %v, want %v", err, ErrCompacted)
+	}
+	// the compacted history keeps the latest revisions at the compaction
+	r, err := s.History([]byte("foo"), []byte("fop"), HistoryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Events) != 5 || r.Events[0].Kv.ModRevision != 3 {
+		t.Errorf("events = %v, want 5 events from revision 3", r.Events)
+	}
+}
@@ -205,7 +205,7 @@ func TestStoreRange(t *testing.T) {
 		b.tx.rangeRespc <- tt.r
 		fi.indexRangeRespc <- tt.idxr
 
-		kvs, _, rev, err := s.rangeKeys([]byte("foo"), []byte("goo"), 1, 0, false)
+		kvs, _, rev, err := s.rangeKeys(s.tx, []byte("foo"), []byte("goo"), 1, 0, wrev, s.compactMainRev, false)
 		if err != nil {
 			t.Errorf("#%d: err = %v, want nil", i, err)
 		}
@@ -216,9 +216,8 @@ func TestStoreRange(t *testing.T) {
 			t.Errorf("#%d: rev = %d, want %d", i, rev, wrev)
 		}
 
-		wstart, wend := revBytesRange(tt.idxr.revs[0])
 		wact := []testutil.Action{
-			{"range", []interface{}{keyBucketName, wstart, wend, int64(0)}},
+			{"range", []interface{}{keyBucketName, newTestRevBytes(tt.idxr.revs[0]), []byte(nil), int64(0)}},
 		}
 		if g := b.tx.Action(); !reflect.DeepEqual(g, wact) {
 			t.Errorf("#%d: tx action = %+v, want %+v", i, g, wact)
@@ -565,6 +564,7 @@ func newFakeStore() *store {
 	return &store{
 		b:              b,
 		le:             &lease.FakeLessor{},
+		cfg:            StoreConfig{CompactionBatchLimit: defaultCompactionBatchLimit, CompactionSleepInterval: defaultCompactionSleepInterval},
 		kvindex:        fi,
 		currentRev:     revision{},
 		compactMainRev: -1,
@@ -611,11 +611,14 @@ type fakeBackend struct {
 }
 
 func (b *fakeBackend) BatchTx() backend.BatchTx                                    { return b.tx }
+func (b *fakeBackend) ReadTx() backend.ReadTx                                      { return b.tx }
 func (b *fakeBackend) Hash(ignores map[backend.IgnoreKey]struct{}) (uint32, error) { return 0, nil }
 func (b *fakeBackend) Size() int64                                                 { return 0 }
+func (b *fakeBackend) SizeInUse() int64                                            { return 0 }
 func (b *fakeBackend) Snapshot() backend.Snapshot                                  { return nil }
 func (b *fakeBackend) ForceCommit()                                                {}
 func (b *fakeBackend) Defrag() error                                               { return nil }
+func (b *fakeBackend) DefragStatus() backend.DefragStatus                          { return backend.DefragStatus{} }
 func (b *fakeBackend) Close() error                                                { return nil }
 
 type indexGetResp struct {
@@ -0,0 +1,219 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"etcd/mvcc/backend"
+	"etcd/mvcc/mvccpb"
+	"etcd/pkg/keyutil"
+)
+
+var (
+	valueIndexBucketName = []byte("valueIndex")
+
+	ErrValueIndexNotFound = errors.New("mvcc: value index not found")
+	ErrValueIndexRev      = errors.New("mvcc: value index only serves the latest revision")
+)
+
+// ValueIndex is a secondary index over the values of the keys with
+// Prefix. It finds the keys holding a given value without scanning
+// the prefix.
+type ValueIndex struct {
+	Name   string
+	Prefix string
+}
+
+// ParseValueIndexes parses a comma separated list of "name=prefix"
+// value index declarations.
+func ParseValueIndexes(s string) ([]ValueIndex, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var vis []ValueIndex
+	names := make(map[string]struct{})
+	for _, d := range strings.Split(s, ",") {
+		kv := strings.SplitN(d, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid value index %q (expected name=prefix)", d)
+		}
+		if _, ok := names[kv[0]]; ok {
+			return nil, fmt.Errorf("duplicate value index %q", kv[0])
+		}
+		names[kv[0]] = struct{}{}
+		vis = append(vis, ValueIndex{Name: kv[0], Prefix: kv[1]})
+	}
+	return vis, nil
+}
+
+// valueIndexKey returns the key of the index entry for key holding value.
+// Entries are keyed by the index name, the digest of the value and the key,
+// so the keys holding a value are contiguous and sorted.
+func valueIndexKey(name string, value, key []byte) []byte {
+	h := sha256.Sum256(value)
+	b := make([]byte, 0, len(name)+1+len(h)+len(key))
+	b = append(b, name...)
+	b = append(b, 0)
+	b = append(b, h[:]...)
+	return append(b, key...)
+}
+
+func (s *store) valueIndex(name string) (ValueIndex, bool) {
+	for _, vi := range s.cfg.ValueIndexes {
+		if vi.Name == name {
+			return vi, true
+		}
+	}
+	return ValueIndex{}, false
+}
+
+// indexKV updates the value indexes covering the key of kv. prev is the
+// previous value of the key, or nil if the key did not exist; kv is nil
+// if the key is deleted. It must be called holding the lock on s.tx.
+func (s *store) indexKV(prev, kv *mvccpb.KeyValue) {
+	for _, vi := range s.cfg.ValueIndexes {
+		k := prev
+		if k == nil {
+			k = kv
+		}
+		if !bytes.HasPrefix(k.Key, []byte(vi.Prefix)) {
+			continue
+		}
+		if prev != nil && kv != nil && bytes.Equal(prev.Value, kv.Value) {
+			continue
+		}
+		if prev != nil {
+			s.tx.UnsafeDelete(valueIndexBucketName, valueIndexKey(vi.Name, prev.Value, prev.Key))
+		}
+		if kv != nil {
+			s.tx.UnsafePut(valueIndexBucketName, valueIndexKey(vi.Name, kv.Value, kv.Key), []byte{})
+		}
+	}
+}
+
+// indexedKV returns the latest value of key at rev for the value indexes,
+// or nil if the key is not covered by any of them.
+func (s *store) indexedKV(key []byte, rev revision) *mvccpb.KeyValue {
+	covered := false
+	for _, vi := range s.cfg.ValueIndexes {
+		if bytes.HasPrefix(key, []byte(vi.Prefix)) {
+			covered = true
+			break
+		}
+	}
+	if !covered {
+		return nil
+	}
+	kv := readKV(s.tx, rev)
+	return &kv
+}
+
+// rebuildValueIndexes drops all index entries and indexes the keys at
+// the current revision again, so the indexes follow the configuration
+// and any snapshot the store is restored from. It must be called holding
+// the lock on tx.
+func (s *store) rebuildValueIndexes(tx backend.BatchTx) {
+	var stale [][]byte
+	tx.UnsafeForEach(valueIndexBucketName, func(k, v []byte) error {
+		stale = append(stale, k)
+		return nil
+	})
+	for _, k := range stale {
+		tx.UnsafeDelete(valueIndexBucketName, k)
+	}
+
+	for _, vi := range s.cfg.ValueIndexes {
+		end := keyutil.PrefixEnd([]byte(vi.Prefix))
+		if keyutil.IsFromKey(end) {
+			// an empty end ranges over all keys
+			end = []byte{}
+		}
+		keys, revs := s.kvindex.Range([]byte(vi.Prefix), end, s.currentRev.main)
+		for i := range keys {
+			kv := readKV(tx, revs[i])
+			tx.UnsafePut(valueIndexBucketName, valueIndexKey(vi.Name, kv.Value, kv.Key), []byte{})
+		}
+		if len(keys) > 0 {
+			plog.Infof("rebuilt value index %q (%d keys)", vi.Name, len(keys))
+		}
+	}
+}
+
+// rangeValueIndex gets the keys in the range holding value through the
+// value index name. Index entries are checked against the keys, since
+// deleted entries may still be visible to read txs until the backend
+// commits.
+func (s *store) rangeValueIndex(tx backend.ReadTx, name string, value, key, end []byte, limit, rangeRev, curRev int64, countOnly bool) (kvs []mvccpb.KeyValue, count int, rev int64, err error) {
+	if _, ok := s.valueIndex(name); !ok {
+		return nil, -1, 0, ErrValueIndexNotFound
+	}
+	if rangeRev > 0 && rangeRev != curRev {
+		return nil, -1, 0, ErrValueIndexRev
+	}
+
+	pfx := valueIndexKey(name, value, nil)
+	ikeys, _ := tx.UnsafeRange(valueIndexBucketName, pfx, keyutil.PrefixEnd(pfx), 0)
+	for _, ik := range ikeys {
+		k := ik[len(pfx):]
+		if !inRange(k, key, end) {
+			continue
+		}
+		krev, _, _, err := s.kvindex.Get(k, curRev)
+		if err != nil {
+			continue
+		}
+		kv := readKV(tx, krev)
+		if !bytes.Equal(kv.Value, value) {
+			continue
+		}
+		count++
+		if !countOnly && (limit <= 0 || len(kvs) < int(limit)) {
+			kvs = append(kvs, kv)
+		}
+	}
+	return kvs, count, curRev, nil
+}
+
+// readKV reads the key-value put at rev.
+func readKV(tx backend.ReadTx, rev revision) mvccpb.KeyValue {
+	revBytes := newRevBytes()
+	revToBytes(rev, revBytes)
+	_, vs := tx.UnsafeRange(keyBucketName, revBytes, nil, 0)
+	if len(vs) != 1 {
+		plog.Fatalf("range cannot find rev (%d,%d)", rev.main, rev.sub)
+	}
+	var kv mvccpb.KeyValue
+	if err := kv.Unmarshal(vs[0]); err != nil {
+		plog.Fatalf("cannot unmarshal event: %v", err)
+	}
+	return kv
+}
+
+// inRange checks if k is in the range [key, end) of a range request.
+func inRange(k, key, end []byte) bool {
+	switch {
+	case end == nil:
+		return bytes.Equal(k, key)
+	case len(end) == 0:
+		return bytes.Compare(k, key) >= 0
+	default:
+		return bytes.Compare(k, key) >= 0 && bytes.Compare(k, end) < 0
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"reflect"
+	"testing"
+
+	"etcd/lease"
+	"etcd/mvcc/backend"
+)
+
+func TestParseValueIndexes(t *testing.T) {
+	tests := []struct {
+		s   string
+		vis []ValueIndex
+		err bool
+	}{
+		{"", nil, false},
+		{"node=/pods/", []ValueIndex{{"node", "/pods/"}}, false},
+		{"node=/pods/,all=", []ValueIndex{{"node", "/pods/"}, {"all", ""}}, false},
+		{"node", nil, true},
+		{"=/pods/", nil, true},
+		{"node=/pods/,node=/jobs/", nil, true},
+	}
+	for i, tt := range tests {
+		vis, err := ParseValueIndexes(tt.s)
+		if (err != nil) != tt.err {
+			t.Errorf("#%d: err = %v, want error %v", i, err, tt.err)
+		}
+		if !reflect.DeepEqual(vis, tt.vis) {
+			t.Errorf("#%d: indexes = %v, want %v", i, vis, tt.vis)
+		}
+	}
+}
+
+func TestValueIndexRange(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	cfg := StoreConfig{ValueIndexes: []ValueIndex{{Name: "node", Prefix: "/pods/"}}}
+	s := newStore(b, &lease.FakeLessor{}, nil, cfg)
+	defer cleanup(s, b, tmpPath)
+
+	s.Put([]byte("/pods/a"), []byte("n1"), lease.NoLease)
+	s.Put([]byte("/pods/b"), []byte("n2"), lease.NoLease)
+	s.Put([]byte("/pods/c"), []byte("n1"), lease.NoLease)
+	s.Put([]byte("/pods/d"), []byte("n1"), lease.NoLease)
+	s.Put([]byte("/other/e"), []byte("n1"), lease.NoLease)
+	// moves b to n1 and c away from n1
+	s.Put([]byte("/pods/b"), []byte("n1"), lease.NoLease)
+	s.Put([]byte("/pods/c"), []byte("n3"), lease.NoLease)
+	s.DeleteRange([]byte("/pods/d"), nil)
+
+	keys := func(ro RangeOptions, key, end string) []string {
+		var e []byte
+		if end != "" {
+			e = []byte(end)
+		}
+		r, err := s.Range([]byte(key), e, ro)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ks []string
+		for _, kv := range r.KVs {
+			ks = append(ks, string(kv.Key))
+		}
+		return ks
+	}
+
+	ro := RangeOptions{ValueIndex: "node", Value: []byte("n1")}
+	if ks := keys(ro, "/", "0"); !reflect.DeepEqual(ks, []string{"/pods/a", "/pods/b"}) {
+		t.Errorf("keys = %v, want [/pods/a /pods/b]", ks)
+	}
+	if ks := keys(ro, "/pods/b", ""); !reflect.DeepEqual(ks, []string{"/pods/b"}) {
+		t.Errorf("keys = %v, want [/pods/b]", ks)
+	}
+	r, err := s.Range([]byte("/"), []byte("0"), RangeOptions{ValueIndex: "node", Value: []byte("n1"), Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.KVs) != 1 || r.Count != 2 {
+		t.Errorf("limited range got %d keys of %d, want 1 of 2", len(r.KVs), r.Count)
+	}
+
+	if _, err = s.Range([]byte("/"), []byte("0"), RangeOptions{ValueIndex: "owner"}); err != ErrValueIndexNotFound {
+		t.Errorf("err = %v, want %v", err, ErrValueIndexNotFound)
+	}
+	if _, err = s.Range([]byte("/"), []byte("0"), RangeOptions{ValueIndex: "node", Rev: 2}); err != ErrValueIndexRev {
+		t.Errorf("err = %v, want %v", err, ErrValueIndexRev)
+	}
+
+	// restoring with another configuration rebuilds the indexes
+	s.cfg.ValueIndexes = []ValueIndex{{Name: "all", Prefix: ""}}
+	if err = s.Restore(b); err != nil {
+		t.Fatal(err)
+	}
+	ro = RangeOptions{ValueIndex: "all", Value: []byte("n1")}
+	if ks := keys(ro, "/", "0"); !reflect.DeepEqual(ks, []string{"/other/e", "/pods/a", "/pods/b"}) {
+		t.Errorf("keys = %v, want [/other/e /pods/a /pods/b]", ks)
+	}
+	if _, err = s.Range([]byte("/"), []byte("0"), RangeOptions{ValueIndex: "node"}); err != ErrValueIndexNotFound {
+		t.Errorf("err = %v, want %v", err, ErrValueIndexNotFound)
+	}
+	tx := b.BatchTx()
+	tx.Lock()
+	n := 0
+	tx.UnsafeForEach(valueIndexBucketName, func(k, v []byte) error {
+		n++
+		return nil
+	})
+	tx.Unlock()
+	if n != 4 {
+		t.Errorf("index entries = %d, want 4", n)
+	}
+}
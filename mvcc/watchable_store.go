@@ -65,12 +65,17 @@ type watchableStore struct {
 type cancelFunc func()
 
 func New(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) ConsistentWatchableKV {
-	return newWatchableStore(b, le, ig)
+	return newWatchableStore(b, le, ig, StoreConfig{})
 }
 
-func newWatchableStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *watchableStore {
+// NewWithConfig returns a new ConsistentWatchableKV configured by cfg.
+func NewWithConfig(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter, cfg StoreConfig) ConsistentWatchableKV {
+	return newWatchableStore(b, le, ig, cfg)
+}
+
+func newWatchableStore(b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter, cfg StoreConfig) *watchableStore {
 	s := &watchableStore{
-		store:    NewStore(b, le, ig),
+		store:    newStore(b, le, ig, cfg),
 		victimc:  make(chan struct{}, 1),
 		unsynced: newWatcherGroup(),
 		synced:   newWatcherGroup(),
@@ -198,7 +203,7 @@ func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch c
 		fcs:    fcs,
 	}
 
-	s.store.mu.Lock()
+	s.store.revMu.RLock()
 	synced := startRev > s.store.currentRev.main || startRev == 0
 	if synced {
 		wa.minRev = s.store.currentRev.main + 1
@@ -206,7 +211,7 @@ func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch c
 			wa.minRev = startRev
 		}
 	}
-	s.store.mu.Unlock()
+	s.store.revMu.RUnlock()
 	if synced {
 		s.synced.add(wa)
 	} else {
@@ -341,7 +346,7 @@ func (s *watchableStore) moveVictims() (moved int) {
 
 		// assign completed victim watchers to unsync/sync
 		s.mu.Lock()
-		s.store.mu.Lock()
+		s.store.revMu.RLock()
 		curRev := s.store.currentRev.main
 		for w, eb := range wb {
 			if newVictim != nil && newVictim[w] != nil {
@@ -359,7 +364,7 @@ func (s *watchableStore) moveVictims() (moved int) {
 				s.synced.add(w)
 			}
 		}
-		s.store.mu.Unlock()
+		s.store.revMu.RUnlock()
 		s.mu.Unlock()
 	}
 
@@ -382,8 +387,10 @@ func (s *watchableStore) syncWatchers() {
 		return
 	}
 
-	s.store.mu.Lock()
-	defer s.store.mu.Unlock()
+	s.store.mu.RLock()
+	defer s.store.mu.RUnlock()
+	s.store.revMu.RLock()
+	defer s.store.revMu.RUnlock()
 
 	// in order to find key-value pairs from unsynced watchers, we need to
 	// find min revision index, and these revisions can be used to
@@ -397,7 +404,7 @@ func (s *watchableStore) syncWatchers() {
 
 	// UnsafeRange returns keys and values. And in boltdb, keys are revisions.
 	// values are actual key-value pairs in backend.
-	tx := s.store.b.BatchTx()
+	tx := s.store.b.ReadTx()
 	tx.Lock()
 	revs, vs := tx.UnsafeRange(keyBucketName, minBytes, maxBytes, 0)
 	evs := kvsToEvents(wg, revs, vs)
@@ -0,0 +1,210 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package encryption implements AES-GCM encryption of data at rest.
+//
+// Encrypted data starts with a header holding the id of the key it is
+// encrypted with, so data written with older keys stays readable after a key
+// rotation as long as the key provider still provides the older keys.
+// Data without the header is plaintext written before encryption was enabled
+// and is returned unchanged by Decrypt; DecryptStrict rejects it for data
+// known to be encrypted.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+var (
+	ErrNoKeyProvider = errors.New("encryption: data is encrypted but no key provider is set")
+	ErrKeyNotFound   = errors.New("encryption: key not found")
+	ErrDecrypt       = errors.New("encryption: cannot decrypt data")
+	ErrNotEncrypted  = errors.New("encryption: data is not encrypted")
+
+	// magic starts the header of encrypted data. It cannot be confused
+	// with the start of a non-empty protocol buffer message, whose first
+	// byte is a non-zero field tag, nor with JSON text. This covers the
+	// wal records, the snapshot files and the values of the backend
+	// buckets holding keys, leases, members and auth data. Raw values,
+	// like the 8 bytes big endian counters of the meta bucket, carry no
+	// such guarantee; a backend whose values are all encrypted decrypts
+	// them with DecryptStrict.
+	magic = []byte{0x00, 'e', 'n', 'c'}
+)
+
+const headerSize = 4 + 4 // magic and key id
+
+// Key is a key used to encrypt data at rest.
+type Key struct {
+	// ID identifies the key in the data encrypted with it.
+	ID uint32
+	// Secret is the 16, 24 or 32 bytes AES key.
+	Secret []byte
+}
+
+// KeyProvider provides the keys used to encrypt and decrypt data at rest.
+type KeyProvider interface {
+	// ActiveKey returns the key new data is encrypted with.
+	ActiveKey() (Key, error)
+	// Key returns the key with the given id. It returns ErrKeyNotFound
+	// if the provider does not know the key.
+	Key(id uint32) (Key, error)
+}
+
+// Cipher encrypts and decrypts data with the keys of a KeyProvider.
+// A nil Cipher leaves new data in plaintext and fails to decrypt
+// encrypted data.
+type Cipher struct {
+	kp KeyProvider
+
+	mu    sync.Mutex
+	aeads map[uint32]cipher.AEAD
+}
+
+func NewCipher(kp KeyProvider) *Cipher {
+	return &Cipher{kp: kp, aeads: make(map[uint32]cipher.AEAD)}
+}
+
+func (c *Cipher) aead(k Key) (cipher.AEAD, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if a, ok := c.aeads[k.ID]; ok {
+		return a, nil
+	}
+	block, err := aes.NewCipher(k.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: bad key %d (%v)", k.ID, err)
+	}
+	a, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	c.aeads[k.ID] = a
+	return a, nil
+}
+
+// Enabled returns true if c encrypts new data.
+func (c *Cipher) Enabled() bool { return c != nil }
+
+// Encrypt encrypts b with the active key. A nil Cipher returns b unchanged.
+func (c *Cipher) Encrypt(b []byte) ([]byte, error) {
+	if c == nil {
+		return b, nil
+	}
+	k, err := c.kp.ActiveKey()
+	if err != nil {
+		return nil, err
+	}
+	return c.EncryptWithKey(k, b)
+}
+
+// EncryptWithKey encrypts b with the key k in place of the active key.
+// The key provider must provide k to decrypt the data.
+func (c *Cipher) EncryptWithKey(k Key, b []byte) ([]byte, error) {
+	a, err := c.aead(k)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, headerSize+a.NonceSize(), headerSize+a.NonceSize()+len(b)+a.Overhead())
+	copy(out, magic)
+	binary.BigEndian.PutUint32(out[len(magic):], k.ID)
+	nonce := out[headerSize:]
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return a.Seal(out, nonce, b, out[:headerSize]), nil
+}
+
+// Decrypt decrypts b with the key it was encrypted with.
+// Plaintext data is returned unchanged.
+func (c *Cipher) Decrypt(b []byte) ([]byte, error) {
+	if !bytes.HasPrefix(b, magic) {
+		return b, nil
+	}
+	return c.decrypt(b)
+}
+
+// DecryptStrict decrypts b like Decrypt, but fails with ErrNotEncrypted if
+// b is plaintext.
+func (c *Cipher) DecryptStrict(b []byte) ([]byte, error) {
+	if !bytes.HasPrefix(b, magic) {
+		return nil, ErrNotEncrypted
+	}
+	return c.decrypt(b)
+}
+
+func (c *Cipher) decrypt(b []byte) ([]byte, error) {
+	if c == nil {
+		return nil, ErrNoKeyProvider
+	}
+	// data starting with the magic is never taken for plaintext
+	id, ok := KeyID(b)
+	if !ok {
+		return nil, ErrDecrypt
+	}
+	k, err := c.kp.Key(id)
+	if err != nil {
+		return nil, err
+	}
+	a, err := c.aead(k)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < headerSize+a.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	nonce := b[headerSize : headerSize+a.NonceSize()]
+	out, err := a.Open(nil, nonce, b[headerSize+a.NonceSize():], b[:headerSize])
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return out, nil
+}
+
+// KeyID returns the id of the key b is encrypted with, or false if b is
+// plaintext.
+func KeyID(b []byte) (uint32, bool) {
+	if len(b) < headerSize || !bytes.Equal(b[:len(magic)], magic) {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(b[len(magic):headerSize]), true
+}
+
+// Status counts the plaintext and encrypted items of some data at rest.
+type Status struct {
+	Plaintext int
+	// Encrypted counts the encrypted items by key id.
+	Encrypted map[uint32]int
+}
+
+// Add counts b in the status.
+func (s *Status) Add(b []byte) {
+	id, ok := KeyID(b)
+	if !ok {
+		s.Plaintext++
+		return
+	}
+	if s.Encrypted == nil {
+		s.Encrypted = make(map[uint32]int)
+	}
+	s.Encrypted[id]++
+}
@@ -0,0 +1,161 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func writeKeyFile(t *testing.T, lines ...string) string {
+	f, err := ioutil.TempFile("", "etcd-keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	for _, l := range lines {
+		fmt.Fprintln(f, l)
+	}
+	return f.Name()
+}
+
+func keyLine(id int, c byte) string {
+	return fmt.Sprintf("%d:%s", id, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{c}, 32)))
+}
+
+func TestCipherRotation(t *testing.T) {
+	p1 := writeKeyFile(t, "# keys", keyLine(1, 'a'))
+	defer os.Remove(p1)
+	p2 := writeKeyFile(t, keyLine(1, 'a'), "", keyLine(2, 'b'))
+	defer os.Remove(p2)
+
+	kp1, err := NewFileKeyProvider(p1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kp2, err := NewFileKeyProvider(p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("secret value")
+	b1, err := NewCipher(kp1).Encrypt(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b1, data) {
+		t.Fatalf("encrypted data contains plaintext")
+	}
+	if id, ok := KeyID(b1); !ok || id != 1 {
+		t.Fatalf("KeyID = %d, %v, want 1, true", id, ok)
+	}
+
+	c2 := NewCipher(kp2)
+	// data encrypted with an older key stays readable
+	d, err := c2.Decrypt(b1)
+	if err != nil || !bytes.Equal(d, data) {
+		t.Fatalf("Decrypt = %q, %v, want %q, nil", d, err, data)
+	}
+	b2, err := c2.Encrypt(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := KeyID(b2); id != 2 {
+		t.Fatalf("KeyID = %d, want 2", id)
+	}
+	if _, err = NewCipher(kp1).Decrypt(b2); err != ErrKeyNotFound {
+		t.Fatalf("err = %v, want %v", err, ErrKeyNotFound)
+	}
+
+	// tampered data is rejected
+	b2[len(b2)-1] ^= 0xff
+	if _, err = c2.Decrypt(b2); err != ErrDecrypt {
+		t.Fatalf("err = %v, want %v", err, ErrDecrypt)
+	}
+
+	// plaintext passes through
+	if d, err = c2.Decrypt(data); err != nil || !bytes.Equal(d, data) {
+		t.Fatalf("Decrypt = %q, %v, want %q, nil", d, err, data)
+	}
+}
+
+func TestNilCipher(t *testing.T) {
+	p := writeKeyFile(t, keyLine(7, 'c'))
+	defer os.Remove(p)
+	kp, err := NewFileKeyProvider(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c *Cipher
+	data := []byte("value")
+	if b, _ := c.Encrypt(data); !bytes.Equal(b, data) {
+		t.Fatalf("Encrypt without key provider = %q, want %q", b, data)
+	}
+	b, err := NewCipher(kp).Encrypt(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Decrypt(b); err != ErrNoKeyProvider {
+		t.Fatalf("err = %v, want %v", err, ErrNoKeyProvider)
+	}
+	var st Status
+	st.Add(b)
+	st.Add(data)
+	if st.Plaintext != 1 || st.Encrypted[7] != 1 {
+		t.Fatalf("status = %+v, want 1 plaintext and 1 encrypted with key 7", st)
+	}
+}
+
+func TestDecryptStrict(t *testing.T) {
+	c := NewCipher(NewKeyProvider(Key{ID: 1, Secret: make([]byte, 16)}))
+	data := []byte("value")
+	b, err := c.Encrypt(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, derr := c.DecryptStrict(b); derr != nil || !bytes.Equal(d, data) {
+		t.Fatalf("DecryptStrict = %q, %v, want %q, nil", d, derr, data)
+	}
+	if _, err = c.DecryptStrict(data); err != ErrNotEncrypted {
+		t.Fatalf("err = %v, want %v", err, ErrNotEncrypted)
+	}
+	// a truncated header is not taken for plaintext
+	if _, err = c.Decrypt(b[:len(magic)+1]); err != ErrDecrypt {
+		t.Fatalf("err = %v, want %v", err, ErrDecrypt)
+	}
+}
+
+func TestFileKeyProviderBadKeys(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"1"},
+		{"0:" + base64.StdEncoding.EncodeToString(make([]byte, 32))},
+		{"1:" + base64.StdEncoding.EncodeToString(make([]byte, 10))},
+		{"1:not base64"},
+		{keyLine(1, 'a'), keyLine(1, 'b')},
+	}
+	for i, tt := range tests {
+		p := writeKeyFile(t, tt...)
+		if _, err := NewFileKeyProvider(p); err == nil {
+			t.Errorf("#%d: expected error", i)
+		}
+		os.Remove(p)
+	}
+}
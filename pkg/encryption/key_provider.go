@@ -0,0 +1,111 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+type keyProvider struct {
+	keys   map[uint32]Key
+	active Key
+}
+
+// NewKeyProvider returns a KeyProvider with the given keys. The last key is
+// the active key.
+func NewKeyProvider(keys ...Key) KeyProvider {
+	kp := &keyProvider{keys: make(map[uint32]Key)}
+	for _, k := range keys {
+		kp.keys[k.ID] = k
+		kp.active = k
+	}
+	return kp
+}
+
+// NewFileKeyProvider returns a KeyProvider with the keys read from the file at
+// the given path. Each line of the file holds a key as "<id>:<base64 secret>",
+// where the id is a positive integer and the secret a 16, 24 or 32 bytes AES
+// key. Empty lines and lines starting with '#' are ignored.
+// The last key of the file is the active key. To rotate keys, append a new key
+// to the file and restart the member; keep the older keys as long as data
+// encrypted with them may remain.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var keys []Key
+	ids := make(map[uint32]bool)
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		k, err := parseKey(line)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: bad key at %s:%d (%v)", path, n, err)
+		}
+		if ids[k.ID] {
+			return nil, fmt.Errorf("encryption: duplicate key id %d at %s:%d", k.ID, path, n)
+		}
+		ids[k.ID] = true
+		keys = append(keys, k)
+	}
+	if err = s.Err(); err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("encryption: no key in %s", path)
+	}
+	return NewKeyProvider(keys...), nil
+}
+
+func parseKey(line string) (Key, error) {
+	fields := strings.SplitN(line, ":", 2)
+	if len(fields) != 2 {
+		return Key{}, fmt.Errorf("expected <id>:<base64 secret>")
+	}
+	id, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 10, 32)
+	if err != nil || id == 0 {
+		return Key{}, fmt.Errorf("bad key id %q", fields[0])
+	}
+	secret, err := base64.StdEncoding.DecodeString(strings.TrimSpace(fields[1]))
+	if err != nil {
+		return Key{}, err
+	}
+	switch len(secret) {
+	case 16, 24, 32:
+	default:
+		return Key{}, fmt.Errorf("key must be 16, 24 or 32 bytes (got %d)", len(secret))
+	}
+	return Key{ID: uint32(id), Secret: secret}, nil
+}
+
+func (kp *keyProvider) ActiveKey() (Key, error) { return kp.active, nil }
+
+func (kp *keyProvider) Key(id uint32) (Key, error) {
+	k, ok := kp.keys[id]
+	if !ok {
+		return Key{}, ErrKeyNotFound
+	}
+	return k, nil
+}
@@ -26,8 +26,19 @@ func PurgeFile(dirname string, suffix string, max uint, interval time.Duration,
 	return purgeFile(dirname, suffix, max, interval, stop, nil)
 }
 
+// PurgeFileFunc is like PurgeFile but disposes of the files exceeding max with
+// the given purge function instead of removing them. purge is called while the
+// file is still locked and must remove it from dirname.
+func PurgeFileFunc(dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, purge func(path string) error) <-chan error {
+	return purgeFileFunc(dirname, suffix, max, interval, stop, purge, nil)
+}
+
 // purgeFile is the internal implementation for PurgeFile which can post purged files to purgec if non-nil.
 func purgeFile(dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, purgec chan<- string) <-chan error {
+	return purgeFileFunc(dirname, suffix, max, interval, stop, os.Remove, purgec)
+}
+
+func purgeFileFunc(dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, purge func(string) error, purgec chan<- string) <-chan error {
 	errC := make(chan error, 1)
 	go func() {
 		for {
@@ -50,7 +61,7 @@ func purgeFile(dirname string, suffix string, max uint, interval time.Duration,
 				if err != nil {
 					break
 				}
-				if err = os.Remove(f); err != nil {
+				if err = purge(f); err != nil {
 					errC <- err
 					return
 				}
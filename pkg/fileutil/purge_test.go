@@ -175,3 +175,53 @@ func TestPurgeFileHoldingLockFile(t *testing.T) {
 
 	close(stop)
 }
+
+func TestPurgeFileFunc(t *testing.T) {
+	dir, err := ioutil.TempDir("", "purgefile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	adir, err := ioutil.TempDir("", "purgefile-archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(adir)
+
+	for i := 0; i < 5; i++ {
+		f, ferr := os.Create(filepath.Join(dir, fmt.Sprintf("%d.test", i)))
+		if ferr != nil {
+			t.Fatal(ferr)
+		}
+		f.Close()
+	}
+
+	stop, purgec := make(chan struct{}), make(chan string, 10)
+	archive := func(p string) error { return os.Rename(p, filepath.Join(adir, filepath.Base(p))) }
+	errch := purgeFileFunc(dir, "test", 3, time.Millisecond, stop, archive, purgec)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-purgec:
+		case err = <-errch:
+			t.Fatalf("unexpected purge error %v", err)
+		case <-time.After(time.Second):
+			t.Fatalf("purge took too long")
+		}
+	}
+	close(stop)
+
+	fnames, rerr := ReadDir(dir)
+	if rerr != nil {
+		t.Fatal(rerr)
+	}
+	if wnames := []string{"2.test", "3.test", "4.test"}; !reflect.DeepEqual(fnames, wnames) {
+		t.Errorf("filenames = %v, want %v", fnames, wnames)
+	}
+	anames, rerr := ReadDir(adir)
+	if rerr != nil {
+		t.Fatal(rerr)
+	}
+	if wnames := []string{"0.test", "1.test"}; !reflect.DeepEqual(anames, wnames) {
+		t.Errorf("archived filenames = %v, want %v", anames, wnames)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package keyutil implements utility functions for the ranges of keys.
+package keyutil
+
+// PrefixEnd returns the end of the range of the keys with the given prefix.
+// The keys with the empty prefix or a prefix of 0xff bytes have no upper
+// bound; for them it returns "\x00", the range end of the v3 API for all
+// the keys from the range key. Callers ranging over mvcc or the backend
+// directly must check for it with IsFromKey.
+func PrefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return []byte{0}
+}
+
+// IsFromKey returns true if the range end is "\x00", the range end of all
+// the keys from the range key.
+func IsFromKey(end []byte) bool {
+	return len(end) == 1 && end[0] == 0
+}
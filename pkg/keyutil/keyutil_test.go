@@ -0,0 +1,47 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrefixEnd(t *testing.T) {
+	tests := []struct {
+		prefix []byte
+		wend   []byte
+	}{
+		{[]byte("foo"), []byte("fop")},
+		{[]byte("a\xff"), []byte("b")},
+		{[]byte("a\xff\xff"), []byte("b")},
+		{[]byte{}, []byte{0}},
+		{[]byte("\xff"), []byte{0}},
+		{[]byte("\xff\xff"), []byte{0}},
+	}
+	for i, tt := range tests {
+		p := append([]byte(nil), tt.prefix...)
+		end := PrefixEnd(p)
+		if !bytes.Equal(end, tt.wend) {
+			t.Errorf("#%d: end = %q, want %q", i, end, tt.wend)
+		}
+		if !bytes.Equal(p, tt.prefix) {
+			t.Errorf("#%d: prefix = %q, want unchanged %q", i, p, tt.prefix)
+		}
+		if IsFromKey(end) != (len(tt.wend) == 1 && tt.wend[0] == 0) {
+			t.Errorf("#%d: IsFromKey(%q) = %v", i, end, IsFromKey(end))
+		}
+	}
+}
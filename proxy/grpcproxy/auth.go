@@ -64,6 +64,36 @@ func (ap *AuthProxy) RoleList(ctx context.Context, r *pb.AuthRoleListRequest) (*
 	return pb.NewAuthClient(conn).RoleList(ctx, r)
 }
 
+func (ap *AuthProxy) RateLimitSet(ctx context.Context, r *pb.AuthRateLimitSetRequest) (*pb.AuthRateLimitSetResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).RateLimitSet(ctx, r)
+}
+
+func (ap *AuthProxy) RateLimitList(ctx context.Context, r *pb.AuthRateLimitListRequest) (*pb.AuthRateLimitListResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).RateLimitList(ctx, r)
+}
+
+func (ap *AuthProxy) TokenList(ctx context.Context, r *pb.AuthTokenListRequest) (*pb.AuthTokenListResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).TokenList(ctx, r)
+}
+
+func (ap *AuthProxy) TokenRevoke(ctx context.Context, r *pb.AuthTokenRevokeRequest) (*pb.AuthTokenRevokeResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).TokenRevoke(ctx, r)
+}
+
+func (ap *AuthProxy) AuthExport(ctx context.Context, r *pb.AuthExportRequest) (*pb.AuthExportResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).AuthExport(ctx, r)
+}
+
+func (ap *AuthProxy) AuthImport(ctx context.Context, r *pb.AuthImportRequest) (*pb.AuthImportResponse, error) {
+	conn := ap.client.ActiveConnection()
+	return pb.NewAuthClient(conn).AuthImport(ctx, r)
+}
+
 func (ap *AuthProxy) RoleRevokePermission(ctx context.Context, r *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error) {
 	conn := ap.client.ActiveConnection()
 	return pb.NewAuthClient(conn).RoleRevokePermission(ctx, r)
@@ -42,7 +42,8 @@ func NewKvProxy(c *clientv3.Client) (pb.KVServer, <-chan struct{}) {
 }
 
 func (p *kvProxy) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
-	if r.Serializable {
+	// lease TTLs change over time, so they are never served from the cache
+	if r.Serializable && !r.LeaseTtl {
 		resp, err := p.cache.Get(r)
 		switch err {
 		case nil:
@@ -60,15 +61,33 @@ func (p *kvProxy) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRespo
 		return nil, err
 	}
 
-	// cache linearizable as serializable
-	req := *r
-	req.Serializable = true
 	gresp := (*pb.RangeResponse)(resp.Get())
-	p.cache.Add(&req, gresp)
+	if !r.LeaseTtl {
+		// cache linearizable as serializable
+		req := *r
+		req.Serializable = true
+		p.cache.Add(&req, gresp)
+	}
 
 	return gresp, nil
 }
 
+func (p *kvProxy) History(ctx context.Context, r *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	opts := []clientv3.OpOption{}
+	if len(r.RangeEnd) != 0 {
+		opts = append(opts, clientv3.WithRange(string(r.RangeEnd)))
+	}
+	opts = append(opts, clientv3.WithMinModRev(r.MinRevision))
+	opts = append(opts, clientv3.WithMaxModRev(r.MaxRevision))
+	opts = append(opts, clientv3.WithLimit(r.Limit))
+	if r.Serializable {
+		opts = append(opts, clientv3.WithSerializable())
+	}
+
+	resp, err := p.kv.History(ctx, string(r.Key), opts...)
+	return (*pb.HistoryResponse)(resp), err
+}
+
 func (p *kvProxy) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	p.cache.Invalidate(r.Key, nil)
 
@@ -187,6 +206,12 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	if r.Serializable {
 		opts = append(opts, clientv3.WithSerializable())
 	}
+	if r.LeaseTtl {
+		opts = append(opts, clientv3.WithLeaseTTL())
+	}
+	if r.Index != "" {
+		opts = append(opts, clientv3.WithValueIndex(r.Index, string(r.IndexValue)))
+	}
 
 	return clientv3.OpGet(string(r.Key), opts...)
 }
@@ -30,6 +30,10 @@ func (s *kvs2kvc) Range(ctx context.Context, in *pb.RangeRequest, opts ...grpc.C
 	return s.kvs.Range(ctx, in)
 }
 
+func (s *kvs2kvc) History(ctx context.Context, in *pb.HistoryRequest, opts ...grpc.CallOption) (*pb.HistoryResponse, error) {
+	return s.kvs.History(ctx, in)
+}
+
 func (s *kvs2kvc) Put(ctx context.Context, in *pb.PutRequest, opts ...grpc.CallOption) (*pb.PutResponse, error) {
 	return s.kvs.Put(ctx, in)
 }
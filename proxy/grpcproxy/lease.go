@@ -46,6 +46,11 @@ func (lp *leaseProxy) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLiv
 	return pb.NewLeaseClient(conn).LeaseTimeToLive(ctx, rr)
 }
 
+func (lp *leaseProxy) LeaseTimeToLives(ctx context.Context, rr *pb.LeaseTimeToLivesRequest) (*pb.LeaseTimeToLivesResponse, error) {
+	conn := lp.client.ActiveConnection()
+	return pb.NewLeaseClient(conn).LeaseTimeToLives(ctx, rr)
+}
+
 func (lp *leaseProxy) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error {
 	conn := lp.client.ActiveConnection()
 	ctx, cancel := context.WithCancel(stream.Context())
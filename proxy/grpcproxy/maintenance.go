@@ -72,3 +72,8 @@ func (mp *maintenanceProxy) Status(ctx context.Context, r *pb.StatusRequest) (*p
 	conn := mp.client.ActiveConnection()
 	return pb.NewMaintenanceClient(conn).Status(ctx, r)
 }
+
+func (mp *maintenanceProxy) ValueKeyRotate(ctx context.Context, r *pb.ValueKeyRotateRequest) (*pb.ValueKeyRotateResponse, error) {
+	conn := mp.client.ActiveConnection()
+	return pb.NewMaintenanceClient(conn).ValueKeyRotate(ctx, r)
+}
@@ -0,0 +1,310 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package quota manages the storage quotas of key prefixes in etcd.
+package quota
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc"
+	"etcd/mvcc/backend"
+	"etcd/pkg/keyutil"
+	"github.com/coreos/pkg/capnslog"
+)
+
+var (
+	quotaBucketName = []byte("quota")
+	plog            = capnslog.NewPackageLogger("github.com/coreos/etcd", "quota")
+
+	ErrQuotaExceeded = errors.New("quota: prefix quota exceeded")
+	ErrQuotaNotFound = errors.New("quota: quota not found")
+	ErrEmptyPrefix   = errors.New("quota: empty prefix")
+)
+
+type BackendGetter interface {
+	Backend() backend.Backend
+}
+
+// KV is the view of the key space the usage of the prefixes is computed from.
+type KV interface {
+	Rev() int64
+	Range(key, end []byte, ro mvcc.RangeOptions) (*mvcc.RangeResult, error)
+}
+
+// Change is a change to the size of a key. The size of a key is the length
+// of the key and of its value, and is -1 if the key does not exist.
+type Change struct {
+	Key              []byte
+	OldSize, NewSize int64
+}
+
+// Usages is the usage of the prefixes affected by a list of changes,
+// keyed by prefix.
+type Usages map[string]pb.QuotaUsage
+
+type usage struct {
+	pb.QuotaUsage
+	// rev is the revision of the key space the usage was computed at.
+	rev int64
+}
+
+// QuotaStore persists the quotas of the prefixes to the backend. It caches
+// the usage of the prefixes: a usage is computed by ranging over its prefix,
+// then kept up to date by the changes applied to the key space, as long as
+// they are all recorded by Update.
+type QuotaStore struct {
+	mu     sync.Mutex
+	quotas map[string]*pb.PrefixQuota
+	usages map[string]*usage
+
+	bg BackendGetter
+}
+
+func NewQuotaStore(bg BackendGetter) (*QuotaStore, error) {
+	ret := &QuotaStore{
+		quotas: make(map[string]*pb.PrefixQuota),
+		usages: make(map[string]*usage),
+		bg:     bg,
+	}
+	err := ret.restore()
+	return ret, err
+}
+
+// Set sets the quota of a prefix, or removes it if it has no limits.
+func (qs *QuotaStore) Set(q *pb.PrefixQuota) error {
+	if len(q.Prefix) == 0 {
+		return ErrEmptyPrefix
+	}
+
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	b := qs.bg.Backend()
+	tx := b.BatchTx()
+	if q.MaxBytes <= 0 && q.MaxKeys <= 0 {
+		delete(qs.quotas, string(q.Prefix))
+		delete(qs.usages, string(q.Prefix))
+		tx.Lock()
+		tx.UnsafeDelete(quotaBucketName, q.Prefix)
+		tx.Unlock()
+		return nil
+	}
+
+	v, err := q.Marshal()
+	if err != nil {
+		plog.Panicf("failed to marshal quota")
+	}
+	qs.quotas[string(q.Prefix)] = q
+	tx.Lock()
+	tx.UnsafePut(quotaBucketName, q.Prefix, v)
+	tx.Unlock()
+	return nil
+}
+
+// Get returns the quota of a prefix, or nil if it has none.
+func (qs *QuotaStore) Get(prefix []byte) *pb.PrefixQuota {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	return qs.quotas[string(prefix)]
+}
+
+// List returns the quotas sorted by prefix.
+func (qs *QuotaStore) List() []*pb.PrefixQuota {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	ret := make([]*pb.PrefixQuota, 0, len(qs.quotas))
+	for _, q := range qs.quotas {
+		ret = append(ret, q)
+	}
+	sort.Sort(quotasByPrefix(ret))
+	return ret
+}
+
+// Len returns the number of quotas.
+func (qs *QuotaStore) Len() int {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	return len(qs.quotas)
+}
+
+// Covers returns true if a quota prefix intersects the range [key, end).
+// A nil end is the single key, and an empty end all the keys from key.
+func (qs *QuotaStore) Covers(key, end []byte) bool {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	for p := range qs.quotas {
+		if intersects([]byte(p), key, end) {
+			return true
+		}
+	}
+	return false
+}
+
+// Usage returns the usage of a prefix in kv.
+func (qs *QuotaStore) Usage(kv KV, prefix []byte) (pb.QuotaUsage, error) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	u, err := qs.usage(kv, string(prefix))
+	if err != nil {
+		return pb.QuotaUsage{}, err
+	}
+	return u.QuotaUsage, nil
+}
+
+// Check returns ErrQuotaExceeded if the changes to kv take the usage of
+// a prefix over its quota. Changes freeing space are always allowed.
+// Otherwise it returns the usage of the prefixes after the changes, which
+// must be recorded with Update once the changes are applied.
+func (qs *QuotaStore) Check(kv KV, changes []Change) (Usages, error) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	deltas := make(map[string]*pb.QuotaUsage)
+	for _, c := range changes {
+		for p := range qs.quotas {
+			if !bytes.HasPrefix(c.Key, []byte(p)) {
+				continue
+			}
+			d := deltas[p]
+			if d == nil {
+				d = &pb.QuotaUsage{}
+				deltas[p] = d
+			}
+			d.Keys += keys(c.NewSize) - keys(c.OldSize)
+			d.Bytes += size(c.NewSize) - size(c.OldSize)
+		}
+	}
+
+	ret := make(Usages, len(deltas))
+	for p, d := range deltas {
+		u, err := qs.usage(kv, p)
+		if err != nil {
+			return nil, err
+		}
+		q := qs.quotas[p]
+		nu := pb.QuotaUsage{Bytes: u.Bytes + d.Bytes, Keys: u.Keys + d.Keys}
+		if d.Bytes > 0 && q.MaxBytes > 0 && nu.Bytes > q.MaxBytes {
+			return nil, ErrQuotaExceeded
+		}
+		if d.Keys > 0 && q.MaxKeys > 0 && nu.Keys > q.MaxKeys {
+			return nil, ErrQuotaExceeded
+		}
+		ret[p] = nu
+	}
+	return ret, nil
+}
+
+// Update records the usage of the prefixes after changes checked at
+// revision rev were applied, moving the key space to revision newRev.
+func (qs *QuotaStore) Update(u Usages, rev, newRev int64) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	for p, cu := range qs.usages {
+		if cu.rev == rev {
+			cu.rev = newRev
+		}
+		if nu, ok := u[p]; ok {
+			if cu.rev != newRev {
+				// the cached usage missed changes
+				delete(qs.usages, p)
+				continue
+			}
+			cu.QuotaUsage = nu
+		}
+	}
+}
+
+// usage returns the usage of a prefix, computing it if the cached usage
+// is not at the current revision of kv.
+func (qs *QuotaStore) usage(kv KV, p string) (*usage, error) {
+	if u, ok := qs.usages[p]; ok && u.rev == kv.Rev() {
+		return u, nil
+	}
+	end := keyutil.PrefixEnd([]byte(p))
+	if keyutil.IsFromKey(end) {
+		// mvcc ranges over all the keys from key with an empty end
+		end = []byte{}
+	}
+	rr, err := kv.Range([]byte(p), end, mvcc.RangeOptions{})
+	if err != nil {
+		return nil, err
+	}
+	u := &usage{rev: rr.Rev}
+	for i := range rr.KVs {
+		u.Keys++
+		u.Bytes += int64(len(rr.KVs[i].Key) + len(rr.KVs[i].Value))
+	}
+	if _, ok := qs.quotas[p]; ok {
+		qs.usages[p] = u
+	}
+	return u, nil
+}
+
+func (qs *QuotaStore) restore() error {
+	b := qs.bg.Backend()
+	tx := b.BatchTx()
+
+	tx.Lock()
+	tx.UnsafeCreateBucket(quotaBucketName)
+	err := tx.UnsafeForEach(quotaBucketName, func(k, v []byte) error {
+		var q pb.PrefixQuota
+		if err := q.Unmarshal(v); err != nil {
+			return err
+		}
+		qs.quotas[string(q.Prefix)] = &q
+		return nil
+	})
+	tx.Unlock()
+
+	b.ForceCommit()
+	return err
+}
+
+func keys(size int64) int64 {
+	if size < 0 {
+		return 0
+	}
+	return 1
+}
+
+func size(size int64) int64 {
+	if size < 0 {
+		return 0
+	}
+	return size
+}
+
+// intersects returns true if the keys with the given prefix intersect
+// the range [key, end).
+func intersects(prefix, key, end []byte) bool {
+	if end == nil {
+		return bytes.HasPrefix(key, prefix)
+	}
+	pend := keyutil.PrefixEnd(prefix)
+	if !keyutil.IsFromKey(pend) && bytes.Compare(key, pend) >= 0 {
+		return false
+	}
+	return len(end) == 0 || bytes.Compare(prefix, end) < 0
+}
+
+type quotasByPrefix []*pb.PrefixQuota
+
+func (q quotasByPrefix) Len() int           { return len(q) }
+func (q quotasByPrefix) Less(i, j int) bool { return bytes.Compare(q[i].Prefix, q[j].Prefix) < 0 }
+func (q quotasByPrefix) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
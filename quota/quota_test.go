@@ -0,0 +1,125 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	pb "etcd/etcdserver/etcdserverpb"
+	"etcd/mvcc"
+	"etcd/mvcc/backend"
+	"etcd/mvcc/mvccpb"
+)
+
+func TestIntersects(t *testing.T) {
+	tests := []struct {
+		prefix, key, end string
+		nilEnd           bool
+		want             bool
+	}{
+		{"/a/", "/a/1", "", true, true},
+		{"/a/", "/b/1", "", true, false},
+		{"/a/", "/a", "/a0", false, true},
+		{"/a/", "/", "/a/", false, false},
+		{"/a/", "/a0", "/b", false, false},
+		{"/a/", "/", "", false, true},
+		{"/a/", "/b", "", false, false},
+		{"\xff", "\xff\xff", "", false, true},
+	}
+	for i, tt := range tests {
+		var end []byte
+		if !tt.nilEnd {
+			end = []byte(tt.end)
+		}
+		if got := intersects([]byte(tt.prefix), []byte(tt.key), end); got != tt.want {
+			t.Errorf("#%d: intersects(%q, %q, %q) = %v, want %v", i, tt.prefix, tt.key, end, got, tt.want)
+		}
+	}
+}
+
+func TestQuotaStoreCheck(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	defer b.Close()
+
+	qs, err := NewQuotaStore(&fakeBackendGetter{b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = qs.Set(&pb.PrefixQuota{Prefix: []byte("/a/"), MaxBytes: 10, MaxKeys: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	kv := &fakeKV{rev: 1, kvs: []mvccpb.KeyValue{{Key: []byte("/a/1"), Value: []byte("v")}}}
+	// usage is computed once then kept up to date
+	u, err := qs.Check(kv, []Change{{Key: []byte("/a/2"), OldSize: -1, NewSize: 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Usages{"/a/": {Bytes: 10, Keys: 2}}); !reflect.DeepEqual(u, want) {
+		t.Fatalf("usages = %v, want %v", u, want)
+	}
+	qs.Update(u, 1, 2)
+	kv.rev = 2
+	if _, err = qs.Check(kv, []Change{{Key: []byte("/a/3"), OldSize: -1, NewSize: 1}}); err != ErrQuotaExceeded {
+		t.Fatalf("err = %v, want %v", err, ErrQuotaExceeded)
+	}
+	// freeing space is allowed
+	if _, err = qs.Check(kv, []Change{{Key: []byte("/a/2"), OldSize: 5, NewSize: -1}}); err != nil {
+		t.Fatal(err)
+	}
+	if kv.ranges != 1 {
+		t.Fatalf("ranges = %d, want 1", kv.ranges)
+	}
+
+	// a revision the store did not see invalidates the usage
+	kv.rev = 3
+	if _, err = qs.Usage(kv, []byte("/a/")); err != nil {
+		t.Fatal(err)
+	}
+	if kv.ranges != 2 {
+		t.Fatalf("ranges = %d, want 2", kv.ranges)
+	}
+
+	// the quotas survive a restart
+	qs2, err := NewQuotaStore(&fakeBackendGetter{b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(qs2.List(), qs.List()) {
+		t.Fatalf("quotas = %v, want %v", qs2.List(), qs.List())
+	}
+}
+
+type fakeBackendGetter struct {
+	be backend.Backend
+}
+
+func (bg *fakeBackendGetter) Backend() backend.Backend { return bg.be }
+
+type fakeKV struct {
+	rev    int64
+	kvs    []mvccpb.KeyValue
+	ranges int
+}
+
+func (kv *fakeKV) Rev() int64 { return kv.rev }
+
+func (kv *fakeKV) Range(key, end []byte, ro mvcc.RangeOptions) (*mvcc.RangeResult, error) {
+	kv.ranges++
+	return &mvcc.RangeResult{KVs: kv.kvs, Rev: kv.rev}, nil
+}
@@ -0,0 +1,262 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafthttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	pioutil "etcd/pkg/ioutil"
+	"etcd/pkg/types"
+	"github.com/golang/snappy"
+)
+
+const (
+	// CompressionNone sends messages to peers uncompressed.
+	CompressionNone = ""
+	// CompressionSnappy compresses messages sent to peers with snappy.
+	CompressionSnappy = "snappy"
+	// CompressionGzip compresses messages sent to peers with gzip.
+	CompressionGzip = "gzip"
+)
+
+// supportedCompressions are the compressions the local member is able to
+// decompress. They are advertised to peers in the X-Raft-Accept-Compression
+// header, alongside the version headers.
+var supportedCompressions = []string{CompressionSnappy, CompressionGzip}
+
+// ValidateCompression returns an error if the given compression is not
+// supported.
+func ValidateCompression(c string) error {
+	if c == CompressionNone {
+		return nil
+	}
+	for _, s := range supportedCompressions {
+		if s == c {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported peer compression %q (supported %q)", c, supportedCompressions)
+}
+
+// setAcceptCompressionHeader reports the compressions the local member
+// is able to decompress.
+func setAcceptCompressionHeader(h http.Header) {
+	h.Set("X-Raft-Accept-Compression", strings.Join(supportedCompressions, ","))
+}
+
+// acceptsCompression checks whether the header from a peer reports
+// the given compression as accepted.
+func acceptsCompression(h http.Header, c string) bool {
+	for _, s := range strings.Split(h.Get("X-Raft-Accept-Compression"), ",") {
+		if s == c {
+			return true
+		}
+	}
+	return false
+}
+
+// observeCompression records whether the peer with the given id accepts the
+// configured compression, as reported by a header received from it.
+// Peers that do not report anything are running an older version and only
+// receive uncompressed messages.
+func (t *Transport) observeCompression(id types.ID, h http.Header) {
+	if t.Compression == CompressionNone {
+		return
+	}
+	accepted := acceptsCompression(h, t.Compression)
+	t.compressionMu.Lock()
+	defer t.compressionMu.Unlock()
+	if t.compressionAccepted == nil {
+		t.compressionAccepted = make(map[types.ID]bool)
+	}
+	t.compressionAccepted[id] = accepted
+}
+
+// compressionFor returns the compression used to send messages to the peer
+// with the given id.
+func (t *Transport) compressionFor(id types.ID) string {
+	if t.Compression == CompressionNone {
+		return CompressionNone
+	}
+	t.compressionMu.Lock()
+	defer t.compressionMu.Unlock()
+	if !t.compressionAccepted[id] {
+		return CompressionNone
+	}
+	return t.Compression
+}
+
+// compressWriter compresses the data written to it.
+type compressWriter interface {
+	io.WriteCloser
+	// Flush writes any pending compressed data to the underlying writer.
+	Flush() error
+}
+
+func newCompressWriter(c string, w io.Writer) compressWriter {
+	switch c {
+	case CompressionSnappy:
+		return snappy.NewBufferedWriter(w)
+	case CompressionGzip:
+		return gzip.NewWriter(w)
+	default:
+		plog.Panicf("unhandled compression %q", c)
+		return nil
+	}
+}
+
+// newDecompressReader returns a reader that decompresses data read
+// from r with the given compression.
+func newDecompressReader(c string, r io.Reader) (io.Reader, error) {
+	switch c {
+	case CompressionNone:
+		return r, nil
+	case CompressionSnappy:
+		return snappy.NewReader(r), nil
+	case CompressionGzip:
+		return gzip.NewReader(r)
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", c)
+	}
+}
+
+// compress compresses b with the given compression.
+func compress(c string, b []byte, to types.ID) []byte {
+	buf := new(bytes.Buffer)
+	cw := newCompressWriter(c, buf)
+	if _, err := cw.Write(b); err != nil {
+		plog.Panicf("compress message error (%v)", err)
+	}
+	if err := cw.Close(); err != nil {
+		plog.Panicf("compress message error (%v)", err)
+	}
+	reportCompression(to, len(b), buf.Len())
+	return buf.Bytes()
+}
+
+// decompress decompresses b with the given compression. It fails with
+// ErrExceedSizeLimit if the decompressed data exceeds limit bytes.
+func decompress(c string, b []byte, limit uint64) ([]byte, error) {
+	r, err := newDecompressReader(c, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	d, err := ioutil.ReadAll(io.LimitReader(r, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(d)) > limit {
+		return nil, ErrExceedSizeLimit
+	}
+	return d, nil
+}
+
+// compressReader returns a reader of the data read from r compressed
+// with the given compression.
+func compressReader(c string, r io.Reader, to types.ID) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		cnt := &countWriter{w: pw}
+		cw := newCompressWriter(c, cnt)
+		n, err := io.Copy(cw, r)
+		if err == nil {
+			err = cw.Close()
+		}
+		reportCompression(to, int(n), int(cnt.n))
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+// compressedConn is an outgoingConn that compresses the messages before
+// writing them to the underlying connection.
+func compressedConn(c string, conn *outgoingConn, to types.ID) *outgoingConn {
+	cnt := &countWriter{w: conn.Writer}
+	cf := &compressFlusher{
+		compressWriter: newCompressWriter(c, cnt),
+		cnt:            cnt,
+		f:              conn.Flusher,
+		to:             to,
+	}
+	// write out the compression header, if any, so the remote
+	// peer does not wait for the first message to set up decompression.
+	cf.Flush()
+	return &outgoingConn{
+		t:       conn.t,
+		Writer:  cf,
+		Flusher: cf,
+		Closer:  conn.Closer,
+	}
+}
+
+// compressFlusher flushes the compressed data written to it all the way
+// down to the underlying connection.
+type compressFlusher struct {
+	compressWriter
+	cnt *countWriter
+	f   http.Flusher
+	to  types.ID
+
+	n int // uncompressed bytes written since last flush
+}
+
+func (cf *compressFlusher) Write(p []byte) (int, error) {
+	n, err := cf.compressWriter.Write(p)
+	cf.n += n
+	return n, err
+}
+
+func (cf *compressFlusher) Flush() {
+	if err := cf.compressWriter.Flush(); err != nil {
+		// the error is returned by the next write on the broken connection.
+		plog.Debugf("failed to flush compressed stream to %s (%v)", cf.to, err)
+	}
+	cf.f.Flush()
+	reportCompression(cf.to, cf.n, int(cf.cnt.n))
+	cf.n, cf.cnt.n = 0, 0
+}
+
+// countWriter counts the bytes written to the underlying writer.
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+func reportCompression(to types.ID, in, out int) {
+	compressionInBytes.WithLabelValues(to.String()).Add(float64(in))
+	compressionOutBytes.WithLabelValues(to.String()).Add(float64(out))
+}
+
+// decompressReadCloser returns a ReadCloser that decompresses the data
+// read from rc with the given compression and closes rc on Close.
+func decompressReadCloser(c string, rc io.ReadCloser) (io.ReadCloser, error) {
+	r, err := newDecompressReader(c, rc)
+	if err != nil {
+		return nil, err
+	}
+	return &pioutil.ReaderAndCloser{Reader: r, Closer: rc}, nil
+}
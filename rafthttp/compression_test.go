@@ -0,0 +1,43 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafthttp
+
+import (
+	"bytes"
+	"testing"
+
+	"etcd/pkg/types"
+)
+
+func TestDecompressLimit(t *testing.T) {
+	// highly compressible data expands far beyond its compressed size
+	data := bytes.Repeat([]byte{0}, 1<<20)
+	for _, c := range supportedCompressions {
+		b := compress(c, data, types.ID(1))
+		if len(b) >= 1<<16 {
+			t.Fatalf("%s: compressed size = %d, want < %d", c, len(b), 1<<16)
+		}
+		d, err := decompress(c, b, 1<<20)
+		if err != nil {
+			t.Fatalf("%s: %v", c, err)
+		}
+		if !bytes.Equal(d, data) {
+			t.Fatalf("%s: decompressed data differs", c)
+		}
+		if _, err = decompress(c, b, 1<<20-1); err != ErrExceedSizeLimit {
+			t.Fatalf("%s: err = %v, want %v", c, err, ErrExceedSizeLimit)
+		}
+	}
+}
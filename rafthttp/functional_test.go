@@ -27,7 +27,17 @@ import (
 	"golang.org/x/net/context"
 )
 
-func TestSendMessage(t *testing.T) {
+func TestSendMessage(t *testing.T) { testSendMessage(t, CompressionNone) }
+
+// TestSendMessageCompressed tests that messages are sent compressed
+// once the peers negotiated the compression.
+func TestSendMessageCompressed(t *testing.T) {
+	for _, c := range supportedCompressions {
+		testSendMessage(t, c)
+	}
+}
+
+func testSendMessage(t *testing.T, compression string) {
 	// member 1
 	tr := &Transport{
 		ID:          types.ID(1),
@@ -35,6 +45,7 @@ func TestSendMessage(t *testing.T) {
 		Raft:        &fakeRaft{},
 		ServerStats: newServerStats(),
 		LeaderStats: stats.NewLeaderStats("1"),
+		Compression: compression,
 	}
 	tr.Start()
 	srv := httptest.NewServer(tr.Handler())
@@ -49,6 +60,7 @@ func TestSendMessage(t *testing.T) {
 		Raft:        p,
 		ServerStats: newServerStats(),
 		LeaderStats: stats.NewLeaderStats("2"),
+		Compression: compression,
 	}
 	tr2.Start()
 	srv2 := httptest.NewServer(tr2.Handler())
@@ -61,6 +73,9 @@ func TestSendMessage(t *testing.T) {
 	if !waitStreamWorking(tr.Get(types.ID(2)).(*peer)) {
 		t.Fatalf("stream from 1 to 2 is not in work as expected")
 	}
+	if c := tr2.compressionFor(types.ID(1)); c != compression {
+		t.Fatalf("compression = %q, want %q", c, compression)
+	}
 
 	data := []byte("some data")
 	tests := []raftpb.Message{
@@ -78,7 +93,7 @@ func TestSendMessage(t *testing.T) {
 		tr.Send([]raftpb.Message{tt})
 		msg := <-recvc
 		if !reflect.DeepEqual(msg, tt) {
-			t.Errorf("#%d: msg = %+v, want %+v", i, msg, tt)
+			t.Errorf("#%d (%q): msg = %+v, want %+v", i, compression, msg, tt)
 		}
 	}
 }
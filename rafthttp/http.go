@@ -20,6 +20,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 
 	pioutil "etcd/pkg/ioutil"
@@ -45,6 +46,9 @@ var (
 	ProbingPrefix      = path.Join(RaftPrefix, "probing")
 	RaftStreamPrefix   = path.Join(RaftPrefix, "stream")
 	RaftSnapshotPrefix = path.Join(RaftPrefix, "snapshot")
+	// RaftSnapshotChunkPrefix is the endpoint that receives database
+	// snapshots in chunks.
+	RaftSnapshotChunkPrefix = path.Join(RaftSnapshotPrefix, "chunk")
 
 	errIncompatibleVersion = errors.New("incompatible version")
 	errClusterIDMismatch   = errors.New("cluster ID mismatch")
@@ -85,6 +89,7 @@ func (h *pipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("X-Etcd-Cluster-ID", h.cid.String())
+	setAcceptCompressionHeader(w.Header())
 
 	if err := checkClusterCompatibilityFromHeader(r.Header, h.cid); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
@@ -101,6 +106,12 @@ func (h *pipelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// connection will not time out accidentally due to possible blocking in underlying implementation.
 	limitedr := pioutil.NewLimitedBufferReader(r.Body, connReadLimitByte)
 	b, err := ioutil.ReadAll(limitedr)
+	if err == nil {
+		if c := r.Header.Get("X-Raft-Compression"); c != CompressionNone {
+			// bound the decompressed message like the messages of streams
+			b, err = decompress(c, b, readBytesLimit)
+		}
+	}
 	if err != nil {
 		plog.Errorf("failed to read raft message (%v)", err)
 		http.Error(w, "error reading raft message", http.StatusBadRequest)
@@ -141,6 +152,7 @@ type snapshotHandler struct {
 	tr          Transporter
 	r           Raft
 	snapshotter *snap.Snapshotter
+	recv        *snapshotReceiver
 	cid         types.ID
 }
 
@@ -149,6 +161,7 @@ func newSnapshotHandler(tr Transporter, r Raft, snapshotter *snap.Snapshotter, c
 		tr:          tr,
 		r:           r,
 		snapshotter: snapshotter,
+		recv:        newSnapshotReceiver(snapshotter),
 		cid:         cid,
 	}
 }
@@ -162,7 +175,15 @@ func newSnapshotHandler(tr Transporter, r Raft, snapshotter *snap.Snapshotter, c
 // 1. snapshot messages sent through other TCP connections could still be
 // received and processed.
 // 2. this case should happen rarely, so no further optimization is done.
+//
+// Large database snapshots are received in chunks through
+// RaftSnapshotChunkPrefix first. The request to RaftSnapshotPrefix then
+// only carries the raft message and commits the chunked transfer.
 func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == RaftSnapshotChunkPrefix {
+		h.serveChunk(w, r)
+		return
+	}
 	if r.Method != "POST" {
 		w.Header().Set("Allow", "POST")
 		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
@@ -170,6 +191,7 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("X-Etcd-Cluster-ID", h.cid.String())
+	setAcceptCompressionHeader(w.Header())
 
 	if err := checkClusterCompatibilityFromHeader(r.Header, h.cid); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
@@ -182,7 +204,13 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	dec := &messageDecoder{r: r.Body}
+	body, err := newDecompressReader(r.Header.Get("X-Raft-Compression"), r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		recvFailures.WithLabelValues(r.RemoteAddr).Inc()
+		return
+	}
+	dec := &messageDecoder{r: body}
 	// let snapshots be very large since they can exceed 512MB for large installations
 	m, err := dec.decodeLimit(uint64(1 << 63))
 	if err != nil {
@@ -201,9 +229,25 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	plog.Infof("receiving database snapshot [index:%d, from %s] ...", m.Snapshot.Metadata.Index, types.ID(m.From))
-	// save incoming database snapshot.
-	n, err := h.snapshotter.SaveDBFrom(r.Body, m.Snapshot.Metadata.Index)
+	var n int64
+	if transfer := r.Header.Get("X-Etcd-Snapshot-Transfer"); transfer != "" {
+		// commit the database snapshot received in chunks. The received
+		// bytes were already counted when the chunks arrived.
+		size, perr := strconv.ParseInt(r.Header.Get("X-Etcd-Snapshot-Size"), 10, 64)
+		if perr != nil {
+			http.Error(w, "invalid snapshot size", http.StatusBadRequest)
+			return
+		}
+		err = h.recv.commit(types.ID(m.From), transfer, size, m.Snapshot.Metadata.Index)
+		if err == errSnapshotUnknownTransfer {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else {
+		plog.Infof("receiving database snapshot [index:%d, from %s] ...", m.Snapshot.Metadata.Index, types.ID(m.From))
+		// save incoming database snapshot.
+		n, err = h.snapshotter.SaveDBFrom(body, m.Snapshot.Metadata.Index)
+	}
 	if err != nil {
 		msg := fmt.Sprintf("failed to save KV snapshot (%v)", err)
 		plog.Error(msg)
@@ -231,6 +275,87 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// serveChunk receives a chunk of database snapshot, or discards the
+// partially received database snapshot on DELETE.
+func (h *snapshotHandler) serveChunk(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" && r.Method != "DELETE" {
+		w.Header().Set("Allow", "POST, DELETE")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("X-Etcd-Cluster-ID", h.cid.String())
+	setAcceptCompressionHeader(w.Header())
+
+	if gcid := r.Header.Get("X-Etcd-Cluster-ID"); gcid != h.cid.String() {
+		http.Error(w, errClusterIDMismatch.Error(), http.StatusPreconditionFailed)
+		return
+	}
+	from, err := types.IDFromString(r.Header.Get("X-Server-From"))
+	if err != nil {
+		http.Error(w, "invalid from", http.StatusBadRequest)
+		return
+	}
+	transfer := r.Header.Get("X-Etcd-Snapshot-Transfer")
+	if transfer == "" {
+		http.Error(w, "missing snapshot transfer", http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == "DELETE" {
+		h.recv.abort(from, transfer)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if err := checkClusterCompatibilityFromHeader(r.Header, h.cid); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	offset, err := strconv.ParseInt(r.Header.Get("X-Etcd-Snapshot-Offset"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid snapshot offset", http.StatusBadRequest)
+		return
+	}
+	size, err := strconv.ParseInt(r.Header.Get("X-Etcd-Snapshot-Size"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid snapshot size", http.StatusBadRequest)
+		return
+	}
+	checksum, err := strconv.ParseUint(r.Header.Get("X-Etcd-Snapshot-Checksum"), 10, 32)
+	if err != nil {
+		http.Error(w, "invalid snapshot checksum", http.StatusBadRequest)
+		return
+	}
+
+	body, err := newDecompressReader(r.Header.Get("X-Raft-Compression"), r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	b, err := readAllLimit(body, snapshotChunkSize)
+	if err != nil {
+		plog.Errorf("failed to read snapshot chunk from %s (%v)", from, err)
+		http.Error(w, "error reading snapshot chunk", http.StatusBadRequest)
+		recvFailures.WithLabelValues(r.RemoteAddr).Inc()
+		return
+	}
+	receivedBytes.WithLabelValues(from.String()).Add(float64(len(b)))
+
+	next, err := h.recv.receive(from, transfer, offset, size, uint32(checksum), b)
+	w.Header().Set("X-Etcd-Snapshot-Offset", strconv.FormatInt(next, 10))
+	switch err.(type) {
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	case *errSnapshotOffsetMismatch:
+		http.Error(w, err.Error(), http.StatusRequestedRangeNotSatisfiable)
+	default:
+		plog.Errorf("failed to receive snapshot chunk [offset: %d, from %s] (%v)", offset, from, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 type streamHandler struct {
 	tr         *Transport
 	peerGetter peerGetter
@@ -258,6 +383,7 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("X-Server-Version", version.Version)
 	w.Header().Set("X-Etcd-Cluster-ID", h.cid.String())
+	setAcceptCompressionHeader(w.Header())
 
 	if err := checkClusterCompatibilityFromHeader(r.Header, h.cid); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
@@ -310,6 +436,12 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.tr.observeCompression(from, r.Header)
+	comp := h.tr.compressionFor(from)
+	if comp != CompressionNone {
+		w.Header().Set("X-Raft-Compression", comp)
+	}
+
 	w.WriteHeader(http.StatusOK)
 	w.(http.Flusher).Flush()
 
@@ -320,6 +452,9 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Flusher: w.(http.Flusher),
 		Closer:  c,
 	}
+	if comp != CompressionNone {
+		conn = compressedConn(comp, conn, from)
+	}
 	p.attachOutgoingConn(conn)
 	<-c.closeNotify()
 }
@@ -53,6 +53,69 @@ var (
 		[]string{"From"},
 	)
 
+	snapshotSendSizeBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "snapshot_send_size_bytes",
+		Help:      "The size of the database snapshot being sent to peers.",
+	},
+		[]string{"To"},
+	)
+
+	snapshotSendProgressBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "snapshot_send_progress_bytes",
+		Help:      "The number of bytes of the database snapshot being sent to peers that were acknowledged by peers.",
+	},
+		[]string{"To"},
+	)
+
+	snapshotChunkRetriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "snapshot_send_chunk_retries_total",
+		Help:      "The total number of database snapshot chunks resent to peers.",
+	},
+		[]string{"To"},
+	)
+
+	snapshotReceiveProgressBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "snapshot_receive_progress_bytes",
+		Help:      "The number of bytes of the database snapshot being received from peers that were verified and saved.",
+	},
+		[]string{"From"},
+	)
+
+	snapshotChunkChecksumFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "snapshot_receive_checksum_failures_total",
+		Help:      "The total number of database snapshot chunks received from peers that failed checksum verification.",
+	},
+		[]string{"From"},
+	)
+
+	compressionInBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "peer_compression_in_bytes_total",
+		Help:      "The total number of bytes compressed before being sent to peers.",
+	},
+		[]string{"To"},
+	)
+
+	compressionOutBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "peer_compression_out_bytes_total",
+		Help:      "The total number of compressed bytes sent to peers.",
+	},
+		[]string{"To"},
+	)
+
 	rtts = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: "etcd",
 		Subsystem: "network",
@@ -70,4 +133,11 @@ func init() {
 	prometheus.MustRegister(sentFailures)
 	prometheus.MustRegister(recvFailures)
 	prometheus.MustRegister(rtts)
+	prometheus.MustRegister(compressionInBytes)
+	prometheus.MustRegister(compressionOutBytes)
+	prometheus.MustRegister(snapshotSendSizeBytes)
+	prometheus.MustRegister(snapshotSendProgressBytes)
+	prometheus.MustRegister(snapshotChunkRetriesTotal)
+	prometheus.MustRegister(snapshotReceiveProgressBytes)
+	prometheus.MustRegister(snapshotChunkChecksumFailures)
 }
@@ -115,7 +115,14 @@ func (p *pipeline) handle() {
 // error on any failure.
 func (p *pipeline) post(data []byte) (err error) {
 	u := p.picker.pick()
+	c := p.tr.compressionFor(p.peerID)
+	if c != CompressionNone {
+		data = compress(c, data, p.peerID)
+	}
 	req := createPostRequest(u, RaftPrefix, bytes.NewBuffer(data), "application/protobuf", p.tr.URLs, p.tr.ID, p.tr.ClusterID)
+	if c != CompressionNone {
+		req.Header.Set("X-Raft-Compression", c)
+	}
 
 	done := make(chan struct{}, 1)
 	cancel := httputil.RequestCanceler(req)
@@ -140,6 +147,7 @@ func (p *pipeline) post(data []byte) (err error) {
 		return err
 	}
 	resp.Body.Close()
+	p.tr.observeCompression(p.peerID, resp.Header)
 
 	err = checkPostResponse(resp, b, req, p.peerID)
 	if err != nil {
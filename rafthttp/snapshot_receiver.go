@@ -0,0 +1,190 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafthttp
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"etcd/pkg/types"
+	"etcd/snap"
+)
+
+var (
+	// snapshotTransferTimeout is the time after which an idle partially
+	// received database snapshot is discarded.
+	snapshotTransferTimeout = 10 * time.Minute
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errSnapshotChecksumMismatch = errors.New("snapshot chunk checksum mismatch")
+	errSnapshotUnknownTransfer  = errors.New("unknown snapshot transfer")
+)
+
+// errSnapshotOffsetMismatch is returned when a chunk does not start at the
+// offset the receiver expects. The sender resumes from offset.
+type errSnapshotOffsetMismatch struct {
+	offset int64
+}
+
+func (e *errSnapshotOffsetMismatch) Error() string {
+	return fmt.Sprintf("snapshot chunk offset mismatch (expected %d)", e.offset)
+}
+
+// snapshotTransfer is a database snapshot that is being received in chunks.
+type snapshotTransfer struct {
+	id      string
+	size    int64
+	offset  int64 // number of bytes verified and written to f
+	f       *os.File
+	updated time.Time
+}
+
+// snapshotReceiver keeps track of the database snapshots that are being
+// received in chunks, at most one per remote peer.
+type snapshotReceiver struct {
+	snapshotter *snap.Snapshotter
+
+	mu        sync.Mutex
+	transfers map[types.ID]*snapshotTransfer
+}
+
+func newSnapshotReceiver(snapshotter *snap.Snapshotter) *snapshotReceiver {
+	return &snapshotReceiver{
+		snapshotter: snapshotter,
+		transfers:   make(map[types.ID]*snapshotTransfer),
+	}
+}
+
+// receive verifies the chunk b against the given checksum and
+// appends it to the transfer. A chunk starting at offset 0 starts a new
+// transfer and discards any previous one from the same peer. A chunk that
+// was already received is ignored. It returns the offset of the next
+// expected chunk.
+func (sr *snapshotReceiver) receive(from types.ID, id string, offset, size int64, checksum uint32, b []byte) (int64, error) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	sr.expire(time.Now())
+
+	t := sr.transfers[from]
+	if offset == 0 && (t == nil || t.id != id) {
+		if t != nil {
+			sr.remove(from)
+		}
+		f, err := sr.snapshotter.CreatePartialDB()
+		if err != nil {
+			return 0, err
+		}
+		t = &snapshotTransfer{id: id, size: size, f: f}
+		sr.transfers[from] = t
+		plog.Infof("receiving database snapshot in chunks [size: %d, from %s] ...", size, from)
+	}
+	if t == nil || t.id != id {
+		return 0, &errSnapshotOffsetMismatch{offset: 0}
+	}
+	t.updated = time.Now()
+
+	if offset+int64(len(b)) == t.offset {
+		// duplicated chunk whose acknowledgement was lost
+		return t.offset, nil
+	}
+	if offset != t.offset {
+		return t.offset, &errSnapshotOffsetMismatch{offset: t.offset}
+	}
+	if t.offset+int64(len(b)) > t.size {
+		return t.offset, fmt.Errorf("snapshot chunk exceeds snapshot size %d", t.size)
+	}
+	if crc32.Checksum(b, crcTable) != checksum {
+		snapshotChunkChecksumFailures.WithLabelValues(from.String()).Inc()
+		return t.offset, errSnapshotChecksumMismatch
+	}
+	if _, err := t.f.Write(b); err != nil {
+		// the file may now contain part of the chunk; start over.
+		sr.remove(from)
+		return 0, err
+	}
+	t.offset += int64(len(b))
+	snapshotReceiveProgressBytes.WithLabelValues(from.String()).Set(float64(t.offset))
+	return t.offset, nil
+}
+
+// commit saves the fully received database snapshot of the given transfer
+// as the snapshot of the database with the given index.
+func (sr *snapshotReceiver) commit(from types.ID, id string, size int64, index uint64) error {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	t := sr.transfers[from]
+	if t == nil || t.id != id {
+		return errSnapshotUnknownTransfer
+	}
+	if t.offset != size || t.size != size {
+		sr.remove(from)
+		return fmt.Errorf("incomplete snapshot transfer (received %d of %d bytes)", t.offset, size)
+	}
+	delete(sr.transfers, from)
+	snapshotReceiveProgressBytes.WithLabelValues(from.String()).Set(0)
+	return sr.snapshotter.SaveDBFromPartial(t.f, index)
+}
+
+// abort discards the partially received database snapshot of the given transfer.
+func (sr *snapshotReceiver) abort(from types.ID, id string) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	if t := sr.transfers[from]; t != nil && t.id == id {
+		plog.Infof("discarded partially received database snapshot [received: %d of %d, from %s]", t.offset, t.size, from)
+		sr.remove(from)
+	}
+}
+
+// expire discards the transfers that have been idle for longer than
+// snapshotTransferTimeout. sr.mu must be held.
+func (sr *snapshotReceiver) expire(now time.Time) {
+	for from, t := range sr.transfers {
+		if now.Sub(t.updated) > snapshotTransferTimeout {
+			plog.Warningf("discarded idle partially received database snapshot [received: %d of %d, from %s]", t.offset, t.size, from)
+			sr.remove(from)
+		}
+	}
+}
+
+// remove discards the transfer from the given peer. sr.mu must be held.
+func (sr *snapshotReceiver) remove(from types.ID) {
+	t := sr.transfers[from]
+	delete(sr.transfers, from)
+	t.f.Close()
+	os.Remove(t.f.Name())
+	snapshotReceiveProgressBytes.WithLabelValues(from.String()).Set(0)
+}
+
+// readAllLimit reads r until EOF and returns an error if more than
+// limit bytes can be read.
+func readAllLimit(r io.Reader, limit int64) ([]byte, error) {
+	b, err := ioutil.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > limit {
+		return nil, fmt.Errorf("snapshot chunk exceeds %d bytes", limit)
+	}
+	return b, nil
+}
@@ -16,21 +16,44 @@ package rafthttp
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 	"time"
 
 	"etcd/pkg/httputil"
 	pioutil "etcd/pkg/ioutil"
 	"etcd/pkg/types"
 	"etcd/raft"
+	"etcd/raft/raftpb"
 	"etcd/snap"
 )
 
 var (
 	// timeout for reading snapshot response body
 	snapResponseReadTimeout = 5 * time.Second
+
+	// snapshotChunkSize is the maximum number of database bytes sent in
+	// a single chunk request. Snapshots that fit into one chunk are sent
+	// in a single request.
+	snapshotChunkSize int64 = 4 * 1024 * 1024
+	// snapshotChunkRetries is the number of times a failed chunk is
+	// resent before the whole snapshot transfer is aborted.
+	snapshotChunkRetries = 5
+	// snapshotChunkRetryInterval is the base interval to wait before
+	// resending a failed chunk.
+	snapshotChunkRetryInterval = 500 * time.Millisecond
+
+	errSnapshotChunkUnsupported = errors.New("remote peer does not support chunked snapshot")
+	errSnapshotTransferLost     = errors.New("remote peer discarded the partial snapshot")
+	errSnapshotTransferExpired  = errors.New("snapshot transfer expired")
 )
 
 type snapshotSender struct {
@@ -43,9 +66,31 @@ type snapshotSender struct {
 	r      Raft
 	errorc chan error
 
+	mu sync.Mutex
+	// pending is the last chunked transfer that failed part way. It is
+	// resumed by the next snapshot of the same raft index and term.
+	pending *chunkTransfer
+
 	stopc chan struct{}
 }
 
+// chunkTransfer is a database snapshot sent in chunks.
+type chunkTransfer struct {
+	id     string
+	merged snap.Message
+	dbSize int64
+
+	buf []byte
+	// chunk is the last chunk read out of the database snapshot, which
+	// starts at offset and was not accepted by the remote peer yet, or
+	// nil if all the chunks read were accepted.
+	chunk  []byte
+	offset int64
+
+	// timer discards the transfer while it is pending.
+	timer *time.Timer
+}
+
 func newSnapshotSender(tr *Transport, picker *urlPicker, to types.ID, status *peerStatus) *snapshotSender {
 	return &snapshotSender{
 		from:   tr.ID,
@@ -60,21 +105,71 @@ func newSnapshotSender(tr *Transport, picker *urlPicker, to types.ID, status *pe
 	}
 }
 
-func (s *snapshotSender) stop() { close(s.stopc) }
+func (s *snapshotSender) stop() {
+	close(s.stopc)
+
+	s.mu.Lock()
+	t := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+	if t != nil {
+		t.timer.Stop()
+		t.merged.CloseWithError(errStopped)
+	}
+}
 
 func (s *snapshotSender) send(merged snap.Message) {
 	m := merged.Message
 
-	body := createSnapBody(merged)
-	defer body.Close()
-
 	u := s.picker.pick()
-	req := createPostRequest(u, RaftSnapshotPrefix, body, "application/octet-stream", s.tr.URLs, s.from, s.cid)
 
 	plog.Infof("start to send database snapshot [index: %d, to %s]...", m.Snapshot.Metadata.Index, types.ID(m.To))
 
-	err := s.post(req)
-	defer merged.CloseWithError(err)
+	to := types.ID(m.To).String()
+	defer func() {
+		snapshotSendSizeBytes.WithLabelValues(to).Set(0)
+		snapshotSendProgressBytes.WithLabelValues(to).Set(0)
+	}()
+
+	var err error
+	if t := s.takePending(m.Snapshot.Metadata); t != nil {
+		// the database snapshot of the interrupted transfer is at least as
+		// recent as the raft snapshot, so it is sent in place of the new one.
+		plog.Infof("resuming database snapshot transfer [index: %d, offset: %d, to %s]", m.Snapshot.Metadata.Index, t.offset, types.ID(m.To))
+		snapshotSendSizeBytes.WithLabelValues(to).Set(float64(t.dbSize))
+		u, err = s.sendChunks(u, m, t)
+		if err != errSnapshotTransferLost {
+			merged.CloseWithError(nil)
+			s.report(u, m, t.merged.TotalSize, err)
+			return
+		}
+		plog.Infof("peer %s discarded the database snapshot transfer, sending it again", types.ID(m.To))
+		u = s.picker.pick()
+	}
+
+	dbSize := merged.TotalSize - int64(m.Size())
+	snapshotSendSizeBytes.WithLabelValues(to).Set(float64(dbSize))
+	if dbSize > snapshotChunkSize {
+		t := &chunkTransfer{
+			// the random part tells apart the database snapshots of
+			// the same raft snapshot, which differ.
+			id:     fmt.Sprintf("%x-%x-%x", m.Snapshot.Metadata.Term, m.Snapshot.Metadata.Index, rand.Int63()),
+			merged: merged,
+			dbSize: dbSize,
+			buf:    make([]byte, snapshotChunkSize),
+		}
+		u, err = s.sendChunks(u, m, t)
+	} else {
+		rc := newRateLimitedReader(merged.ReadCloser, s.tr.SnapshotRateLimit, s.stopc)
+		err = s.sendAll(u, merged, rc)
+		merged.CloseWithError(err)
+	}
+	s.report(u, m, merged.TotalSize, err)
+}
+
+// report reports the result of sending the snapshot message m of the
+// given total size through url u.
+func (s *snapshotSender) report(u url.URL, m raftpb.Message, size int64, err error) {
 	if err != nil {
 		plog.Warningf("database snapshot [index: %d, to: %s] failed to be sent out (%v)", m.Snapshot.Metadata.Index, types.ID(m.To), err)
 
@@ -98,12 +193,238 @@ func (s *snapshotSender) send(merged snap.Message) {
 	s.r.ReportSnapshot(m.To, raft.SnapshotFinish)
 	plog.Infof("database snapshot [index: %d, to: %s] sent out successfully", m.Snapshot.Metadata.Index, types.ID(m.To))
 
-	sentBytes.WithLabelValues(types.ID(m.To).String()).Add(float64(merged.TotalSize))
+	sentBytes.WithLabelValues(types.ID(m.To).String()).Add(float64(size))
+}
+
+// sendAll sends the raft message and the whole database snapshot read
+// from rc in a single request.
+func (s *snapshotSender) sendAll(u url.URL, merged snap.Message, rc io.Reader) error {
+	body := createSnapBody(merged, rc)
+	defer body.Close()
+
+	var r io.Reader = body
+	c := s.tr.compressionFor(s.to)
+	if c != CompressionNone {
+		cr := compressReader(c, body, s.to)
+		defer cr.Close()
+		r = cr
+	}
+	req := createPostRequest(u, RaftSnapshotPrefix, r, "application/octet-stream", s.tr.URLs, s.from, s.cid)
+	if c != CompressionNone {
+		req.Header.Set("X-Raft-Compression", c)
+	}
+	return s.post(req)
+}
+
+// sendChunks sends the database snapshot of t in chunks of at most
+// snapshotChunkSize bytes, from the offset of t. Each chunk carries its own
+// checksum and offset, so a chunk that fails to be sent or verified is
+// resent from the last offset the remote peer acknowledged instead of
+// restarting the transfer. After all chunks are accepted, the raft message
+// m is posted to commit the transfer. If the remote peer does not support
+// chunked snapshots, it falls back to sending the whole snapshot in a
+// single request.
+//
+// If a chunk still fails after snapshotChunkRetries, t is kept pending
+// until snapshotTransferTimeout, like the partial snapshot on the remote
+// peer, so that the next snapshot of the same raft index and term resumes
+// it. Raft log compaction is held back meanwhile. If the remote peer lost
+// the partial snapshot, sendChunks returns errSnapshotTransferLost.
+// It returns the url last used to reach the remote peer.
+func (s *snapshotSender) sendChunks(u url.URL, m raftpb.Message, t *chunkTransfer) (url.URL, error) {
+	to := types.ID(m.To).String()
+	rc := newRateLimitedReader(t.merged.ReadCloser, s.tr.SnapshotRateLimit, s.stopc)
+
+	for t.offset < t.dbSize {
+		if t.chunk == nil {
+			n := snapshotChunkSize
+			if t.dbSize-t.offset < n {
+				n = t.dbSize - t.offset
+			}
+			if _, err := io.ReadFull(rc, t.buf[:n]); err != nil {
+				return u, s.closeChunks(u, t, err)
+			}
+			t.chunk = t.buf[:n]
+		}
+
+		var err error
+		for i := 0; ; i++ {
+			err = s.postChunk(u, t.id, t.offset, t.dbSize, t.chunk)
+			if err == nil || i >= snapshotChunkRetries || !isRetryableChunkError(err) {
+				break
+			}
+			plog.Warningf("failed to send database snapshot chunk [offset: %d, to: %s] (%v), retrying", t.offset, types.ID(m.To), err)
+			snapshotChunkRetriesTotal.WithLabelValues(to).Inc()
+			s.picker.unreachable(u)
+			select {
+			case <-time.After(time.Duration(i+1) * snapshotChunkRetryInterval):
+			case <-s.stopc:
+				return u, s.closeChunks(u, t, errStopped)
+			}
+			u = s.picker.pick()
+		}
+		if err == errSnapshotChunkUnsupported && t.offset == 0 {
+			plog.Infof("peer %s does not support chunked snapshot, sending database snapshot in a single request", types.ID(m.To))
+			err = s.sendAll(u, t.merged, io.MultiReader(bytes.NewReader(t.chunk), rc))
+			t.merged.CloseWithError(err)
+			return u, err
+		}
+		if e, ok := err.(*errSnapshotOffsetMismatch); ok && e.offset == 0 && t.offset > 0 {
+			t.merged.CloseWithError(errSnapshotTransferLost)
+			return u, errSnapshotTransferLost
+		}
+		if err != nil {
+			if isRetryableChunkError(err) {
+				s.setPending(t)
+				return u, err
+			}
+			return u, s.closeChunks(u, t, err)
+		}
+
+		t.offset += int64(len(t.chunk))
+		t.chunk = nil
+		snapshotSendProgressBytes.WithLabelValues(to).Set(float64(t.offset))
+	}
+	if err := checkSnapshotEOF(rc); err != nil {
+		return u, s.closeChunks(u, t, err)
+	}
+
+	body := bytes.NewBuffer(t.buf[:0])
+	enc := &messageEncoder{w: body}
+	if err := enc.encode(&m); err != nil {
+		plog.Panicf("encode message error (%v)", err)
+	}
+	req := createPostRequest(u, RaftSnapshotPrefix, body, "application/octet-stream", s.tr.URLs, s.from, s.cid)
+	req.Header.Set("X-Etcd-Snapshot-Transfer", t.id)
+	req.Header.Set("X-Etcd-Snapshot-Size", strconv.FormatInt(t.dbSize, 10))
+	err := s.post(req)
+	t.merged.CloseWithError(err)
+	return u, err
+}
+
+// closeChunks tells the remote peer to discard the partial snapshot of t
+// and closes the database snapshot of t. It returns err.
+func (s *snapshotSender) closeChunks(u url.URL, t *chunkTransfer, err error) error {
+	s.abortChunks(u, t.id)
+	t.merged.CloseWithError(err)
+	return err
+}
+
+// setPending keeps t pending until snapshotTransferTimeout, discarding
+// the transfer pending before.
+func (s *snapshotSender) setPending(t *chunkTransfer) {
+	t.timer = time.AfterFunc(snapshotTransferTimeout, func() {
+		s.mu.Lock()
+		expired := s.pending == t
+		if expired {
+			s.pending = nil
+		}
+		s.mu.Unlock()
+		if expired {
+			plog.Infof("discarded the interrupted database snapshot transfer [offset: %d, to %s]", t.offset, s.to)
+			s.closeChunks(s.picker.pick(), t, errSnapshotTransferExpired)
+		}
+	})
+
+	s.mu.Lock()
+	old := s.pending
+	s.pending = t
+	s.mu.Unlock()
+	if old != nil {
+		old.timer.Stop()
+		s.closeChunks(s.picker.pick(), old, errSnapshotTransferExpired)
+	}
+}
+
+// takePending returns the pending transfer if it sends the database
+// snapshot of the raft snapshot of the given metadata. A pending transfer
+// of another raft snapshot is discarded.
+func (s *snapshotSender) takePending(md raftpb.SnapshotMetadata) *chunkTransfer {
+	s.mu.Lock()
+	t := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+	if t == nil {
+		return nil
+	}
+	t.timer.Stop()
+
+	pmd := t.merged.Message.Snapshot.Metadata
+	if pmd.Index != md.Index || pmd.Term != md.Term {
+		s.closeChunks(s.picker.pick(), t, errSnapshotTransferExpired)
+		return nil
+	}
+	return t
+}
+
+// postChunk posts a chunk of database snapshot starting at the given offset.
+func (s *snapshotSender) postChunk(u url.URL, transfer string, offset, dbSize int64, chunk []byte) error {
+	body := chunk
+	c := s.tr.compressionFor(s.to)
+	if c != CompressionNone {
+		body = compress(c, chunk, s.to)
+	}
+	req := createPostRequest(u, RaftSnapshotChunkPrefix, bytes.NewReader(body), "application/octet-stream", s.tr.URLs, s.from, s.cid)
+	if c != CompressionNone {
+		req.Header.Set("X-Raft-Compression", c)
+	}
+	req.Header.Set("X-Etcd-Snapshot-Transfer", transfer)
+	req.Header.Set("X-Etcd-Snapshot-Offset", strconv.FormatInt(offset, 10))
+	req.Header.Set("X-Etcd-Snapshot-Size", strconv.FormatInt(dbSize, 10))
+	req.Header.Set("X-Etcd-Snapshot-Checksum", strconv.FormatUint(uint64(crc32.Checksum(chunk, crcTable)), 10))
+
+	resp, rbody, err := s.roundTrip(req)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return errSnapshotChunkUnsupported
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the remote peer may have accepted the chunk while the response
+		// was lost; it is safe to move on if it is exactly at the end of
+		// the chunk.
+		got, perr := strconv.ParseInt(resp.Header.Get("X-Etcd-Snapshot-Offset"), 10, 64)
+		if perr != nil {
+			return fmt.Errorf("snapshot chunk offset mismatch (sent %d, remote expects %s)", offset, resp.Header.Get("X-Etcd-Snapshot-Offset"))
+		}
+		if got == offset+int64(len(chunk)) {
+			return nil
+		}
+		return &errSnapshotOffsetMismatch{offset: got}
+	}
+	return checkPostResponse(resp, rbody, req, s.to)
+}
+
+// abortChunks tells the remote peer to discard the partially received
+// database snapshot of the given transfer. It is best effort.
+func (s *snapshotSender) abortChunks(u url.URL, transfer string) {
+	uu := u
+	uu.Path = RaftSnapshotChunkPrefix
+	req, err := http.NewRequest("DELETE", uu.String(), nil)
+	if err != nil {
+		plog.Panicf("unexpected new request error (%v)", err)
+	}
+	req.Header.Set("X-Server-From", s.from.String())
+	req.Header.Set("X-Etcd-Cluster-ID", s.cid.String())
+	req.Header.Set("X-Etcd-Snapshot-Transfer", transfer)
+	if _, _, err := s.roundTrip(req); err != nil {
+		plog.Debugf("failed to abort database snapshot transfer to %s (%v)", s.to, err)
+	}
 }
 
 // post posts the given request.
 // It returns nil when request is sent out and processed successfully.
 func (s *snapshotSender) post(req *http.Request) (err error) {
+	resp, body, err := s.roundTrip(req)
+	if err != nil {
+		return err
+	}
+	return checkPostResponse(resp, body, req, s.to)
+}
+
+// roundTrip sends the given request and reads out the response body.
+func (s *snapshotSender) roundTrip(req *http.Request) (*http.Response, []byte, error) {
 	cancel := httputil.RequestCanceler(req)
 
 	type responseAndError struct {
@@ -131,16 +452,43 @@ func (s *snapshotSender) post(req *http.Request) (err error) {
 	select {
 	case <-s.stopc:
 		cancel()
-		return errStopped
+		return nil, nil, errStopped
 	case r := <-result:
-		if r.err != nil {
-			return r.err
+		if r.err == nil {
+			s.tr.observeCompression(s.to, r.resp.Header)
 		}
-		return checkPostResponse(r.resp, r.body, req, s.to)
+		return r.resp, r.body, r.err
 	}
 }
 
-func createSnapBody(merged snap.Message) io.ReadCloser {
+// isRetryableChunkError returns true if a chunk that failed with the given
+// error may succeed when sent again.
+func isRetryableChunkError(err error) bool {
+	switch err {
+	case errSnapshotChunkUnsupported, errStopped, errMemberRemoved, errIncompatibleVersion, errClusterIDMismatch:
+		return false
+	}
+	if _, ok := err.(*errSnapshotOffsetMismatch); ok {
+		return false
+	}
+	return true
+}
+
+// checkSnapshotEOF checks that nothing is left in the snapshot reader
+// after all the expected database bytes were read.
+func checkSnapshotEOF(r io.Reader) error {
+	var b [1]byte
+	n, err := r.Read(b[:])
+	if n == 0 && err == io.EOF {
+		return nil
+	}
+	if err != nil && err != io.EOF {
+		return err
+	}
+	return pioutil.ErrExpectEOF
+}
+
+func createSnapBody(merged snap.Message, rc io.Reader) io.ReadCloser {
 	buf := new(bytes.Buffer)
 	enc := &messageEncoder{w: buf}
 	// encode raft message
@@ -149,7 +497,44 @@ func createSnapBody(merged snap.Message) io.ReadCloser {
 	}
 
 	return &pioutil.ReaderAndCloser{
-		Reader: io.MultiReader(buf, merged.ReadCloser),
+		Reader: io.MultiReader(buf, rc),
 		Closer: merged.ReadCloser,
 	}
 }
+
+// rateLimitedReader limits the rate at which bytes are read out of the
+// underlying reader. A non-positive rate means no limit.
+type rateLimitedReader struct {
+	r     io.Reader
+	rate  int64 // bytes per second
+	stopc <-chan struct{}
+
+	start time.Time
+	n     int64
+}
+
+func newRateLimitedReader(r io.Reader, rate int64, stopc <-chan struct{}) io.Reader {
+	if rate <= 0 {
+		return r
+	}
+	return &rateLimitedReader{r: r, rate: rate, stopc: stopc, start: time.Now()}
+}
+
+func (l *rateLimitedReader) Read(p []byte) (int, error) {
+	// never read more than one second worth of bytes at once to
+	// avoid bursts.
+	if int64(len(p)) > l.rate {
+		p = p[:l.rate]
+	}
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	want := time.Duration(float64(l.n) / float64(l.rate) * float64(time.Second))
+	if d := want - time.Since(l.start); d > 0 {
+		select {
+		case <-time.After(d):
+		case <-l.stopc:
+			return n, errStopped
+		}
+	}
+	return n, err
+}
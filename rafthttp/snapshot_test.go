@@ -16,12 +16,16 @@ package rafthttp
 
 import (
 	"fmt"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -143,3 +147,336 @@ func (sh *syncHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	sh.h.ServeHTTP(w, r)
 	sh.ch <- struct{}{}
 }
+
+func TestSnapshotSendChunked(t *testing.T) {
+	defer func(n int64) { snapshotChunkSize = n }(snapshotChunkSize)
+	snapshotChunkSize = 2
+
+	tests := []struct {
+		rc   io.ReadCloser
+		size int64
+		// dropResp drops the response of the given chunk number once,
+		// after the chunk was received. -1 means none.
+		dropResp int
+
+		wsent  bool
+		wfiles int
+	}{
+		// sent and receive with no errors
+		{strReaderCloser{strings.NewReader("hello")}, 5, -1, true, 1},
+		// chunk is resent after the response was lost
+		{strReaderCloser{strings.NewReader("hello")}, 5, 1, true, 1},
+		// sends less than the given snapshot length
+		{strReaderCloser{strings.NewReader("hello")}, 10000, -1, false, 0},
+		// sends less than actual snapshot length
+		{strReaderCloser{strings.NewReader("hello")}, 3, -1, false, 0},
+	}
+
+	for i, tt := range tests {
+		d, err := ioutil.TempDir(os.TempDir(), "snapdir")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r := &fakeRaft{}
+		tr := &Transport{pipelineRt: &http.Transport{}, ClusterID: types.ID(1), Raft: r}
+		h := newSnapshotHandler(tr, r, snap.New(d), types.ID(1))
+		mux := http.NewServeMux()
+		mux.Handle(RaftSnapshotPrefix, h)
+		mux.Handle(RaftSnapshotChunkPrefix, &dropRespHandler{h: h, drop: tt.dropResp})
+		srv := httptest.NewServer(mux)
+
+		picker := mustNewURLPicker(t, []string{srv.URL})
+		snapsend := newSnapshotSender(tr, picker, types.ID(1), newPeerStatus(types.ID(1)))
+
+		sm := snap.NewMessage(raftpb.Message{Type: raftpb.MsgSnap, To: 1}, tt.rc, tt.size)
+		snapsend.send(*sm)
+
+		sent := false
+		select {
+		case <-time.After(5 * time.Second):
+			t.Fatalf("#%d: timed out sending snapshot", i)
+		case sent = <-sm.CloseNotify():
+		}
+		snapsend.stop()
+		srv.Close()
+
+		if tt.wsent != sent {
+			t.Errorf("#%d: snapshot expected %v, got %v", i, tt.wsent, sent)
+		}
+		files, err := ioutil.ReadDir(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.wfiles != len(files) {
+			t.Errorf("#%d: expected %d files, got %d files", i, tt.wfiles, len(files))
+		}
+		if tt.wsent {
+			b, err := ioutil.ReadFile(filepath.Join(d, files[0].Name()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != "hello" {
+				t.Errorf("#%d: snapshot = %q, want %q", i, b, "hello")
+			}
+		}
+		os.RemoveAll(d)
+	}
+}
+
+// TestSnapshotSendChunkedFallback ensures that snapshot is sent in a single
+// request to peers that do not support chunked snapshot.
+func TestSnapshotSendChunkedFallback(t *testing.T) {
+	defer func(n int64) { snapshotChunkSize = n }(snapshotChunkSize)
+	snapshotChunkSize = 2
+
+	d, err := ioutil.TempDir(os.TempDir(), "snapdir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+
+	r := &fakeRaft{}
+	tr := &Transport{pipelineRt: &http.Transport{}, ClusterID: types.ID(1), Raft: r}
+	mux := http.NewServeMux()
+	mux.Handle(RaftSnapshotPrefix, newSnapshotHandler(tr, r, snap.New(d), types.ID(1)))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	picker := mustNewURLPicker(t, []string{srv.URL})
+	snapsend := newSnapshotSender(tr, picker, types.ID(1), newPeerStatus(types.ID(1)))
+	defer snapsend.stop()
+
+	sm := snap.NewMessage(raftpb.Message{Type: raftpb.MsgSnap, To: 1}, strReaderCloser{strings.NewReader("hello")}, 5)
+	snapsend.send(*sm)
+
+	select {
+	case <-time.After(time.Second):
+		t.Fatalf("timed out sending snapshot")
+	case sent := <-sm.CloseNotify():
+		if !sent {
+			t.Fatalf("snapshot expected to be sent")
+		}
+	}
+	files, err := ioutil.ReadDir(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected 1 file, got %d files", len(files))
+	}
+}
+
+func TestSnapshotReceiverChecksumMismatch(t *testing.T) {
+	d, err := ioutil.TempDir(os.TempDir(), "snapdir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(d)
+
+	sr := newSnapshotReceiver(snap.New(d))
+	b := []byte("he")
+	if _, err = sr.receive(types.ID(1), "a", 0, 5, crc32.Checksum(b, crcTable)+1, b); err != errSnapshotChecksumMismatch {
+		t.Fatalf("err = %v, want %v", err, errSnapshotChecksumMismatch)
+	}
+	off, err := sr.receive(types.ID(1), "a", 0, 5, crc32.Checksum(b, crcTable), b)
+	if err != nil || off != 2 {
+		t.Fatalf("offset, err = %d, %v, want 2, nil", off, err)
+	}
+	b = []byte("llo")
+	if _, err = sr.receive(types.ID(1), "a", 3, 5, crc32.Checksum(b, crcTable), b); err == nil {
+		t.Fatalf("expected offset mismatch error")
+	}
+	if err = sr.commit(types.ID(1), "a", 5, 1); err == nil {
+		t.Fatalf("expected incomplete transfer error")
+	}
+}
+
+// dropRespHandler fails the request of the given chunk number once
+// after the chunk was handled.
+type dropRespHandler struct {
+	h    http.Handler
+	drop int
+	n    int
+}
+
+func (dh *dropRespHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" && dh.n == dh.drop {
+		dh.n++
+		dh.h.ServeHTTP(httptest.NewRecorder(), r)
+		http.Error(w, "dropped", http.StatusServiceUnavailable)
+		return
+	}
+	dh.n++
+	dh.h.ServeHTTP(w, r)
+}
+
+// TestSnapshotSendChunkedResume ensures that a chunked transfer that failed
+// part way is resumed by the next snapshot of the same raft index and term,
+// or sent again if the remote peer discarded it.
+func TestSnapshotSendChunkedResume(t *testing.T) {
+	defer func(n int64, r int) { snapshotChunkSize, snapshotChunkRetries = n, r }(snapshotChunkSize, snapshotChunkRetries)
+	snapshotChunkSize, snapshotChunkRetries = 2, 0
+
+	tests := []struct {
+		// restart restarts the remote peer, which loses the partial snapshot
+		restart bool
+
+		wdb    string
+		wposts int // posts of the first chunk
+	}{
+		{false, "hello", 1},
+		{true, "HELLO", 2},
+	}
+	for i, tt := range tests {
+		d, err := ioutil.TempDir(os.TempDir(), "snapdir")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r := &fakeRaft{}
+		tr := &Transport{pipelineRt: &http.Transport{}, ClusterID: types.ID(1), Raft: r}
+		fh := &failChunkHandler{h: newSnapshotHandler(tr, r, snap.New(d), types.ID(1)), failFrom: 2, posts: make(map[string]int)}
+		srv := httptest.NewServer(fh)
+
+		picker := mustNewURLPicker(t, []string{srv.URL})
+		snapsend := newSnapshotSender(tr, picker, types.ID(1), newPeerStatus(types.ID(1)))
+
+		m := raftpb.Message{Type: raftpb.MsgSnap, To: 1, Snapshot: raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{Index: 5, Term: 2}}}
+		sm1 := snap.NewMessage(m, strReaderCloser{strings.NewReader("hello")}, 5)
+		snapsend.send(*sm1)
+		select {
+		case <-sm1.CloseNotify():
+			t.Fatalf("#%d: interrupted transfer closed, want pending", i)
+		default:
+		}
+
+		fh.setFailFrom(-1)
+		if tt.restart {
+			fh.setHandler(newSnapshotHandler(tr, r, snap.New(d), types.ID(1)))
+		}
+		// the database snapshot of the same raft snapshot differs
+		sm2 := snap.NewMessage(m, strReaderCloser{strings.NewReader("HELLO")}, 5)
+		snapsend.send(*sm2)
+		for _, sm := range []*snap.Message{sm1, sm2} {
+			select {
+			case <-time.After(5 * time.Second):
+				t.Fatalf("#%d: timed out sending snapshot", i)
+			case <-sm.CloseNotify():
+			}
+		}
+		snapsend.stop()
+		srv.Close()
+
+		b, err := ioutil.ReadFile(filepath.Join(d, fmt.Sprintf("%016x.snap.db", 5)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.wdb {
+			t.Errorf("#%d: snapshot = %q, want %q", i, b, tt.wdb)
+		}
+		if n := fh.postsAt("0"); n != tt.wposts {
+			t.Errorf("#%d: first chunk posted %d times, want %d", i, n, tt.wposts)
+		}
+		os.RemoveAll(d)
+	}
+}
+
+// failChunkHandler fails the chunks from offset failFrom on, if it is not
+// negative, and counts the chunks posted at each offset.
+type failChunkHandler struct {
+	mu       sync.Mutex
+	h        http.Handler
+	failFrom int64
+	posts    map[string]int
+}
+
+func (fh *failChunkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fh.mu.Lock()
+	h := fh.h
+	offset := r.Header.Get("X-Etcd-Snapshot-Offset")
+	fail := false
+	if r.Method == "POST" && r.URL.Path == RaftSnapshotChunkPrefix {
+		fh.posts[offset]++
+		off, _ := strconv.ParseInt(offset, 10, 64)
+		fail = fh.failFrom >= 0 && off >= fh.failFrom
+	}
+	fh.mu.Unlock()
+
+	if fail {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	h.ServeHTTP(w, r)
+}
+
+func (fh *failChunkHandler) setFailFrom(off int64) {
+	fh.mu.Lock()
+	fh.failFrom = off
+	fh.mu.Unlock()
+}
+
+func (fh *failChunkHandler) setHandler(h http.Handler) {
+	fh.mu.Lock()
+	fh.h = h
+	fh.mu.Unlock()
+}
+
+func (fh *failChunkHandler) postsAt(offset string) int {
+	fh.mu.Lock()
+	defer fh.mu.Unlock()
+	return fh.posts[offset]
+}
+
+func TestSnapshotSendCompressed(t *testing.T) {
+	defer func(n int64) { snapshotChunkSize = n }(snapshotChunkSize)
+
+	for _, chunkSize := range []int64{2, 4 * 1024 * 1024} {
+		snapshotChunkSize = chunkSize
+		d, err := ioutil.TempDir(os.TempDir(), "snapdir")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r := &fakeRaft{}
+		tr := &Transport{pipelineRt: &http.Transport{}, ClusterID: types.ID(1), Raft: r, Compression: CompressionSnappy}
+		tr.compressionAccepted = map[types.ID]bool{types.ID(1): true}
+		mux := http.NewServeMux()
+		h := newSnapshotHandler(tr, r, snap.New(d), types.ID(1))
+		mux.Handle(RaftSnapshotPrefix, h)
+		mux.Handle(RaftSnapshotChunkPrefix, h)
+		srv := httptest.NewServer(mux)
+
+		picker := mustNewURLPicker(t, []string{srv.URL})
+		snapsend := newSnapshotSender(tr, picker, types.ID(1), newPeerStatus(types.ID(1)))
+
+		sm := snap.NewMessage(raftpb.Message{Type: raftpb.MsgSnap, To: 1}, strReaderCloser{strings.NewReader("hello")}, 5)
+		snapsend.send(*sm)
+		select {
+		case <-time.After(time.Second):
+			t.Fatalf("timed out sending snapshot")
+		case sent := <-sm.CloseNotify():
+			if !sent {
+				t.Fatalf("chunk size %d: snapshot expected to be sent", chunkSize)
+			}
+		}
+		snapsend.stop()
+		srv.Close()
+
+		files, err := ioutil.ReadDir(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 1 {
+			t.Fatalf("chunk size %d: expected 1 file, got %d files", chunkSize, len(files))
+		}
+		b, err := ioutil.ReadFile(filepath.Join(d, files[0].Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "hello" {
+			t.Errorf("chunk size %d: snapshot = %q, want %q", chunkSize, b, "hello")
+		}
+		os.RemoveAll(d)
+	}
+}
@@ -423,6 +423,7 @@ func (cr *streamReader) dial(t streamType) (io.ReadCloser, error) {
 	req.Header.Set("X-Min-Cluster-Version", version.MinClusterVersion)
 	req.Header.Set("X-Etcd-Cluster-ID", cr.tr.ClusterID.String())
 	req.Header.Set("X-Raft-To", cr.peerID.String())
+	setAcceptCompressionHeader(req.Header)
 
 	setPeerURLsHeader(req, cr.tr.URLs)
 
@@ -457,7 +458,18 @@ func (cr *streamReader) dial(t streamType) (io.ReadCloser, error) {
 		reportCriticalError(errMemberRemoved, cr.errorc)
 		return nil, errMemberRemoved
 	case http.StatusOK:
-		return resp.Body, nil
+		cr.tr.observeCompression(cr.peerID, resp.Header)
+		c := resp.Header.Get("X-Raft-Compression")
+		if c == CompressionNone {
+			return resp.Body, nil
+		}
+		rc, err := decompressReadCloser(c, resp.Body)
+		if err != nil {
+			httputil.GracefulClose(resp)
+			cr.picker.unreachable(u)
+			return nil, err
+		}
+		return rc, nil
 	case http.StatusNotFound:
 		httputil.GracefulClose(resp)
 		cr.picker.unreachable(u)
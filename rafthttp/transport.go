@@ -111,6 +111,12 @@ type Transport struct {
 	// When an error is received from ErrorC, user should stop raft state
 	// machine and thus stop the Transport.
 	ErrorC chan error
+	// SnapshotRateLimit is the maximum number of bytes per second sent to
+	// each peer when sending database snapshots. 0 means no limit.
+	SnapshotRateLimit int64
+	// Compression is the compression used to send messages and snapshots
+	// to the peers that support it. CompressionNone disables compression.
+	Compression string
 
 	streamRt   http.RoundTripper // roundTripper used by streams
 	pipelineRt http.RoundTripper // roundTripper used by pipelines
@@ -120,6 +126,9 @@ type Transport struct {
 	peers   map[types.ID]Peer    // peers map
 
 	prober probing.Prober
+
+	compressionMu       sync.Mutex
+	compressionAccepted map[types.ID]bool // whether peers accept Compression
 }
 
 func (t *Transport) Start() error {
@@ -146,6 +155,7 @@ func (t *Transport) Handler() http.Handler {
 	mux.Handle(RaftPrefix, pipelineHandler)
 	mux.Handle(RaftStreamPrefix+"/", streamHandler)
 	mux.Handle(RaftSnapshotPrefix, snapHandler)
+	mux.Handle(RaftSnapshotChunkPrefix, snapHandler)
 	mux.Handle(ProbingPrefix, probing.NewHandler())
 	return mux
 }
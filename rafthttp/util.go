@@ -99,6 +99,7 @@ func createPostRequest(u url.URL, path string, body io.Reader, ct string, urls t
 	req.Header.Set("X-Server-Version", version.Version)
 	req.Header.Set("X-Min-Cluster-Version", version.MinClusterVersion)
 	req.Header.Set("X-Etcd-Cluster-ID", cid.String())
+	setAcceptCompressionHeader(req.Header)
 	setPeerURLsHeader(req, urls)
 
 	return req
@@ -27,34 +27,55 @@ import (
 // SaveDBFrom saves snapshot of the database from the given reader. It
 // guarantees the save operation is atomic.
 func (s *Snapshotter) SaveDBFrom(r io.Reader, id uint64) (int64, error) {
-	f, err := ioutil.TempFile(s.dir, "tmp")
+	f, err := s.CreatePartialDB()
 	if err != nil {
 		return 0, err
 	}
 	var n int64
 	n, err = io.Copy(f, r)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return n, err
+	}
+	return n, s.SaveDBFromPartial(f, id)
+}
+
+// CreatePartialDB creates a temporary file in the snapshot directory to
+// receive a database snapshot that is written in several steps.
+// The caller should move it into place with SaveDBFromPartial, or close and
+// remove it when the snapshot is abandoned.
+func (s *Snapshotter) CreatePartialDB() (*os.File, error) {
+	return ioutil.TempFile(s.dir, "tmp")
+}
+
+// SaveDBFromPartial saves the fully written database snapshot file created
+// by CreatePartialDB as the snapshot of the database with given id. It
+// guarantees the save operation is atomic. The file is closed.
+func (s *Snapshotter) SaveDBFromPartial(f *os.File, id uint64) error {
+	fi, err := f.Stat()
 	if err == nil {
 		err = fileutil.Fsync(f)
 	}
 	f.Close()
 	if err != nil {
 		os.Remove(f.Name())
-		return n, err
+		return err
 	}
 	fn := filepath.Join(s.dir, fmt.Sprintf("%016x.snap.db", id))
 	if fileutil.Exist(fn) {
 		os.Remove(f.Name())
-		return n, nil
+		return nil
 	}
 	err = os.Rename(f.Name(), fn)
 	if err != nil {
 		os.Remove(f.Name())
-		return n, err
+		return err
 	}
 
-	plog.Infof("saved database snapshot to disk [total bytes: %d]", n)
+	plog.Infof("saved database snapshot to disk [total bytes: %d]", fi.Size())
 
-	return n, nil
+	return nil
 }
 
 // DBFilePath returns the file path for the snapshot of the database with
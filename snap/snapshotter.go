@@ -26,6 +26,8 @@ import (
 	"strings"
 	"time"
 
+	"etcd/pkg/encryption"
+	"etcd/pkg/fileutil"
 	pioutil "etcd/pkg/ioutil"
 	"etcd/pkg/pbutil"
 	"etcd/raft"
@@ -54,12 +56,19 @@ var (
 )
 
 type Snapshotter struct {
-	dir string
+	dir    string
+	cipher *encryption.Cipher
 }
 
 func New(dir string) *Snapshotter {
+	return NewWithCipher(dir, nil)
+}
+
+// NewWithCipher creates a Snapshotter whose snapshots are encrypted with c.
+func NewWithCipher(dir string, c *encryption.Cipher) *Snapshotter {
 	return &Snapshotter{
-		dir: dir,
+		dir:    dir,
+		cipher: c,
 	}
 }
 
@@ -76,6 +85,11 @@ func (s *Snapshotter) save(snapshot *raftpb.Snapshot) error {
 	fname := fmt.Sprintf("%016x-%016x%s", snapshot.Metadata.Term, snapshot.Metadata.Index, snapSuffix)
 	b := pbutil.MustMarshal(snapshot)
 	crc := crc32.Update(0, crcTable, b)
+	// the crc covers the plaintext, so it is checked after decryption.
+	b, err := s.cipher.Encrypt(b)
+	if err != nil {
+		return err
+	}
 	snap := snappb.Snapshot{Crc: crc, Data: b}
 	d, err := snap.Marshal()
 	if err != nil {
@@ -103,7 +117,7 @@ func (s *Snapshotter) Load() (*raftpb.Snapshot, error) {
 	}
 	var snap *raftpb.Snapshot
 	for _, name := range names {
-		if snap, err = loadSnap(s.dir, name); err == nil {
+		if snap, err = loadSnap(s.dir, name, s.cipher); err == nil {
 			break
 		}
 	}
@@ -113,9 +127,9 @@ func (s *Snapshotter) Load() (*raftpb.Snapshot, error) {
 	return snap, nil
 }
 
-func loadSnap(dir, name string) (*raftpb.Snapshot, error) {
+func loadSnap(dir, name string, c *encryption.Cipher) (*raftpb.Snapshot, error) {
 	fpath := filepath.Join(dir, name)
-	snap, err := Read(fpath)
+	snap, err := ReadWithCipher(fpath, c)
 	if err != nil {
 		renameBroken(fpath)
 	}
@@ -124,6 +138,12 @@ func loadSnap(dir, name string) (*raftpb.Snapshot, error) {
 
 // Read reads the snapshot named by snapname and returns the snapshot.
 func Read(snapname string) (*raftpb.Snapshot, error) {
+	return ReadWithCipher(snapname, nil)
+}
+
+// ReadWithCipher reads the snapshot named by snapname like Read, decrypting
+// it with c.
+func ReadWithCipher(snapname string, c *encryption.Cipher) (*raftpb.Snapshot, error) {
 	b, err := ioutil.ReadFile(snapname)
 	if err != nil {
 		plog.Errorf("cannot read file %v: %v", snapname, err)
@@ -146,6 +166,11 @@ func Read(snapname string) (*raftpb.Snapshot, error) {
 		return nil, ErrEmptySnapshot
 	}
 
+	if serializedSnap.Data, err = c.Decrypt(serializedSnap.Data); err != nil {
+		plog.Errorf("cannot decrypt snapshot file %v: %v", snapname, err)
+		return nil, err
+	}
+
 	crc := crc32.Update(0, crcTable, serializedSnap.Data)
 	if crc != serializedSnap.Crc {
 		plog.Errorf("corrupted snapshot file %v: crc mismatch", snapname)
@@ -202,3 +227,28 @@ func renameBroken(path string) {
 		plog.Warningf("cannot rename broken snapshot file %v to %v: %v", path, brokenPath, err)
 	}
 }
+
+// EncryptionStatus counts the plaintext and encrypted snapshot files in dir,
+// without decrypting them.
+func EncryptionStatus(dir string) (encryption.Status, error) {
+	var st encryption.Status
+	names, err := fileutil.ReadDir(dir)
+	if err != nil {
+		return st, err
+	}
+	for _, name := range names {
+		if !strings.HasSuffix(name, snapSuffix) {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return st, err
+		}
+		var serializedSnap snappb.Snapshot
+		if err = serializedSnap.Unmarshal(b); err != nil {
+			return st, fmt.Errorf("corrupted snapshot file %v: %v", name, err)
+		}
+		st.Add(serializedSnap.Data)
+	}
+	return st, nil
+}
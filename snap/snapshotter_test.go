@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"testing"
 
+	"etcd/pkg/encryption"
 	"etcd/raft/raftpb"
 )
 
@@ -59,6 +60,36 @@ func TestSaveAndLoad(t *testing.T) {
 	}
 }
 
+func TestSaveAndLoadEncrypted(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "snapshot")
+	err := os.Mkdir(dir, 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ss := NewWithCipher(dir, encryption.NewCipher(encryption.NewKeyProvider(encryption.Key{ID: 3, Secret: make([]byte, 16)})))
+	if err = ss.save(testSnap); err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := EncryptionStatus(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Plaintext != 0 || st.Encrypted[3] != 1 {
+		t.Errorf("status = %+v, want 1 snapshot encrypted with key 3", st)
+	}
+
+	g, err := ss.Load()
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(g, testSnap) {
+		t.Errorf("snap = %#v, want %#v", g, testSnap)
+	}
+}
+
 func TestBadCRC(t *testing.T) {
 	dir := filepath.Join(os.TempDir(), "snapshot")
 	err := os.Mkdir(dir, 0700)
@@ -34,6 +34,7 @@ func main() {
 	from := flag.String("data-dir", "", "")
 	snapfile := flag.String("start-snap", "", "The base name of snapshot file to start dumping")
 	index := flag.Uint64("start-index", 0, "The index to start dumping")
+	archiveDir := flag.String("archive-dir", "", "The wal archive directory to replay archived wal files from")
 	flag.Parse()
 	if *from == "" {
 		log.Fatal("Must provide -data-dir flag.")
@@ -75,7 +76,12 @@ func main() {
 		fmt.Println("Start dupmping log entries from snapshot.")
 	}
 
-	w, err := wal.OpenForRead(walDir(*from), walsnap)
+	var w *wal.WAL
+	if *archiveDir != "" {
+		w, err = wal.OpenArchive(*archiveDir, walDir(*from), walsnap)
+	} else {
+		w, err = wal.OpenForRead(walDir(*from), walsnap)
+	}
 	if err != nil {
 		log.Fatalf("Failed opening WAL: %v", err)
 	}
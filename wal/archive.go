@@ -0,0 +1,277 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"etcd/pkg/encryption"
+	"etcd/pkg/fileutil"
+	"etcd/wal/walpb"
+)
+
+const (
+	// archiveSuffix is appended to the name of an archived segment.
+	archiveSuffix = ".gz"
+	// archiveManifest is the name of the manifest file in the archive directory.
+	archiveManifest = "MANIFEST"
+)
+
+var (
+	ErrArchiveCRCMismatch = errors.New("wal: archived segment crc mismatch")
+)
+
+// archiveEntry describes an archived segment in the archive manifest.
+type archiveEntry struct {
+	// Name is the name of the segment in the wal directory.
+	Name string `json:"name"`
+	// Archive is the name of the compressed segment in the archive directory.
+	Archive string `json:"archive"`
+	// Size is the size of the uncompressed segment.
+	Size int64 `json:"size"`
+	// CRC is the crc32 (Castagnoli) of the uncompressed segment.
+	CRC uint32 `json:"crc"`
+}
+
+// ArchiveSegment compresses the released wal segment at path into archiveDir,
+// records it in the archive manifest and removes it from the wal directory.
+// It is meant to be used with fileutil.PurgeFileFunc in place of deleting
+// purged segments.
+func ArchiveSegment(archiveDir, path string) error {
+	name := filepath.Base(path)
+	if _, _, err := parseWalName(name); err != nil {
+		return err
+	}
+	if err := fileutil.TouchDirAll(archiveDir); err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	aname := name + archiveSuffix
+	tmp := filepath.Join(archiveDir, aname+".tmp")
+	af, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	h := crc32.New(crcTable)
+	gw := gzip.NewWriter(af)
+	n, err := io.Copy(io.MultiWriter(gw, h), f)
+	if err == nil {
+		err = gw.Close()
+	}
+	if err == nil {
+		err = fileutil.Fsync(af)
+	}
+	if cerr := af.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err = os.Rename(tmp, filepath.Join(archiveDir, aname)); err != nil {
+		return err
+	}
+
+	e := archiveEntry{Name: name, Archive: aname, Size: n, CRC: h.Sum32()}
+	if err = appendArchiveEntry(archiveDir, e); err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil {
+		return err
+	}
+	plog.Infof("archived wal segment %s to %s (size %d, crc %08x)", name, archiveDir, n, e.CRC)
+	return nil
+}
+
+func appendArchiveEntry(archiveDir string, e archiveEntry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	mf, err := os.OpenFile(filepath.Join(archiveDir, archiveManifest), os.O_WRONLY|os.O_CREATE|os.O_APPEND, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	defer mf.Close()
+	if _, err = mf.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	if err = fileutil.Fsync(mf); err != nil {
+		return err
+	}
+	// make the archived segment and the manifest durable before the
+	// segment is removed from the wal directory.
+	df, err := fileutil.OpenDir(archiveDir)
+	if err != nil {
+		return err
+	}
+	defer df.Close()
+	return fileutil.Fsync(df)
+}
+
+// readArchiveManifest returns the archived segments keyed by segment name.
+// A segment archived more than once is described by its latest entry.
+func readArchiveManifest(archiveDir string) (map[string]archiveEntry, error) {
+	mf, err := os.Open(filepath.Join(archiveDir, archiveManifest))
+	if err != nil {
+		return nil, err
+	}
+	defer mf.Close()
+
+	es := make(map[string]archiveEntry)
+	s := bufio.NewScanner(mf)
+	for s.Scan() {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+		var e archiveEntry
+		if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+			// the last line may be a torn write
+			plog.Warningf("ignored bad entry %q in wal archive manifest (%v)", s.Text(), err)
+			continue
+		}
+		if _, _, err := parseWalName(e.Name); err != nil {
+			plog.Warningf("ignored entry for %v in wal archive manifest", e.Name)
+			continue
+		}
+		es[e.Name] = e
+	}
+	return es, s.Err()
+}
+
+// OpenArchive opens the wal at the given snap for reading across the
+// segments archived in archiveDir and the live segments in dirpath.
+// Live segments take precedence over archived ones with the same name.
+// dirpath may be empty to read only the archived segments.
+// The returned WAL is read-only, like one returned by OpenForRead.
+// Reading an archived segment whose contents do not match the crc
+// recorded in the manifest fails with ErrArchiveCRCMismatch.
+func OpenArchive(archiveDir, dirpath string, snap walpb.Snapshot) (*WAL, error) {
+	return OpenArchiveWithCipher(archiveDir, dirpath, snap, nil)
+}
+
+// OpenArchiveWithCipher opens the archived and live segments like
+// OpenArchive, decrypting the records with c.
+func OpenArchiveWithCipher(archiveDir, dirpath string, snap walpb.Snapshot, c *encryption.Cipher) (*WAL, error) {
+	archived, err := readArchiveManifest(archiveDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	live := make(map[string]bool)
+	if dirpath != "" {
+		lnames, lerr := readWalNames(dirpath)
+		if lerr != nil && lerr != ErrFileNotFound {
+			return nil, lerr
+		}
+		for _, name := range lnames {
+			live[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(archived)+len(live))
+	for name := range archived {
+		if !live[name] {
+			names = append(names, name)
+		}
+	}
+	for name := range live {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, ErrFileNotFound
+	}
+	sort.Strings(names)
+
+	nameIndex, ok := searchIndex(names, snap.Index)
+	if !ok || !isValidSeq(names[nameIndex:]) {
+		return nil, ErrFileNotFound
+	}
+
+	rcs := make([]io.ReadCloser, 0)
+	rs := make([]io.Reader, 0)
+	for _, name := range names[nameIndex:] {
+		if live[name] {
+			rf, err := os.OpenFile(filepath.Join(dirpath, name), os.O_RDONLY, fileutil.PrivateFileMode)
+			if err != nil {
+				closeAll(rcs...)
+				return nil, err
+			}
+			rcs = append(rcs, rf)
+			rs = append(rs, rf)
+			continue
+		}
+		e := archived[name]
+		af, err := os.Open(filepath.Join(archiveDir, e.Archive))
+		if err != nil {
+			closeAll(rcs...)
+			return nil, err
+		}
+		rcs = append(rcs, af)
+		gr, err := gzip.NewReader(af)
+		if err != nil {
+			closeAll(rcs...)
+			return nil, fmt.Errorf("wal: bad archived segment %s (%v)", e.Archive, err)
+		}
+		rs = append(rs, &archiveReader{r: gr, h: crc32.New(crcTable), e: e})
+	}
+
+	w := &WAL{
+		dir:       dirpath,
+		start:     snap,
+		decoder:   newDecoder(rs...),
+		readClose: func() error { return closeAll(rcs...) },
+		cipher:    c,
+	}
+	w.decoder.cipher = c
+	return w, nil
+}
+
+// archiveReader checks the size and crc of an archived segment
+// against its manifest entry once the segment is read out.
+type archiveReader struct {
+	r io.Reader
+	h hash.Hash32
+	n int64
+	e archiveEntry
+}
+
+func (ar *archiveReader) Read(p []byte) (int, error) {
+	n, err := ar.r.Read(p)
+	ar.h.Write(p[:n])
+	ar.n += int64(n)
+	if err == io.EOF && (ar.n != ar.e.Size || ar.h.Sum32() != ar.e.CRC) {
+		plog.Errorf("archived segment %s has size %d crc %08x, want size %d crc %08x",
+			ar.e.Archive, ar.n, ar.h.Sum32(), ar.e.Size, ar.e.CRC)
+		return n, ErrArchiveCRCMismatch
+	}
+	return n, err
+}
@@ -0,0 +1,155 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"etcd/pkg/fileutil"
+	"etcd/raft/raftpb"
+	"etcd/wal/walpb"
+)
+
+// createArchivedWAL creates a wal with 10 segments in p and archives
+// the first 5 of them into ap.
+func createArchivedWAL(t *testing.T, p, ap string) {
+	w, err := Create(p, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		es := []raftpb.Entry{{Index: uint64(i)}}
+		if err = w.Save(raftpb.HardState{}, es); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.cut(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Close()
+
+	names, err := readWalNames(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range names[:5] {
+		if err = ArchiveSegment(ap, filepath.Join(p, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if names, err = readWalNames(p); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 6 {
+		t.Fatalf("len(names) = %d, want 6", len(names))
+	}
+}
+
+func TestOpenArchive(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(p)
+	ap := filepath.Join(p, "archive")
+	createArchivedWAL(t, p, ap)
+
+	// the live segments alone no longer hold the beginning of the log
+	if _, err = OpenForRead(p, walpb.Snapshot{}); err != ErrFileNotFound {
+		t.Fatalf("err = %v, want %v", err, ErrFileNotFound)
+	}
+
+	tests := []struct {
+		dir string
+
+		wlast uint64
+	}{
+		{p, 9},
+		{"", 4},
+	}
+	for i, tt := range tests {
+		w, err := OpenArchive(ap, tt.dir, walpb.Snapshot{})
+		if err != nil {
+			t.Fatalf("#%d: err = %v", i, err)
+		}
+		_, _, ents, err := w.ReadAll()
+		w.Close()
+		if err != nil {
+			t.Fatalf("#%d: err = %v, want nil", i, err)
+		}
+		for j, e := range ents {
+			if e.Index != uint64(j+1) {
+				t.Fatalf("#%d: ents[%d].Index = %d, want %d", i, j, e.Index, j+1)
+			}
+		}
+		if g := ents[len(ents)-1].Index; g != tt.wlast {
+			t.Errorf("#%d: last index read = %d, want %d", i, g, tt.wlast)
+		}
+	}
+}
+
+func TestOpenArchiveCRCMismatch(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(p)
+	ap := filepath.Join(p, "archive")
+	createArchivedWAL(t, p, ap)
+
+	es, err := readArchiveManifest(ap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := es[walName(1, 1)]
+
+	// rewrite the archived segment with a truncated copy of itself
+	f, err := os.Open(filepath.Join(ap, e.Archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(gr)
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	af, err := os.OpenFile(filepath.Join(ap, e.Archive), os.O_WRONLY|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gw := gzip.NewWriter(af)
+	if _, err = gw.Write(b[:len(b)-8]); err != nil {
+		t.Fatal(err)
+	}
+	gw.Close()
+	af.Close()
+
+	w, err := OpenArchive(ap, p, walpb.Snapshot{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if _, _, _, err = w.ReadAll(); err != ErrArchiveCRCMismatch {
+		t.Errorf("err = %v, want %v", err, ErrArchiveCRCMismatch)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"etcd/pkg/crc"
+	"etcd/pkg/encryption"
 	"etcd/pkg/pbutil"
 	"etcd/raft/raftpb"
 	"etcd/wal/walpb"
@@ -36,6 +37,9 @@ type decoder struct {
 	// lastValidOff file offset following the last valid decoded record
 	lastValidOff int64
 	crc          hash.Hash32
+
+	// cipher decrypts the data of the records.
+	cipher *encryption.Cipher
 }
 
 func newDecoder(r ...io.Reader) *decoder {
@@ -95,6 +99,12 @@ func (d *decoder) decodeRecord(rec *walpb.Record) error {
 
 	// skip crc checking if the record type is crcType
 	if rec.Type != crcType {
+		if rec.Data, err = d.cipher.Decrypt(rec.Data); err != nil {
+			if d.isTornEntry(data) {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
 		d.crc.Write(rec.Data)
 		if err := rec.Validate(d.crc.Sum32()); err != nil {
 			if d.isTornEntry(data) {
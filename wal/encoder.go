@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"etcd/pkg/crc"
+	"etcd/pkg/encryption"
 	"etcd/pkg/ioutil"
 	"etcd/wal/walpb"
 )
@@ -38,6 +39,9 @@ type encoder struct {
 	crc       hash.Hash32
 	buf       []byte
 	uint64buf []byte
+
+	// cipher encrypts the data of the records.
+	cipher *encryption.Cipher
 }
 
 func newEncoder(w io.Writer, prevCrc uint32, pageOffset int) *encoder {
@@ -51,12 +55,14 @@ func newEncoder(w io.Writer, prevCrc uint32, pageOffset int) *encoder {
 }
 
 // newFileEncoder creates a new encoder with current file offset for the page writer.
-func newFileEncoder(f *os.File, prevCrc uint32) (*encoder, error) {
+func newFileEncoder(f *os.File, prevCrc uint32, c *encryption.Cipher) (*encoder, error) {
 	offset, err := f.Seek(0, os.SEEK_CUR)
 	if err != nil {
 		return nil, err
 	}
-	return newEncoder(f, prevCrc, int(offset)), nil
+	e := newEncoder(f, prevCrc, int(offset))
+	e.cipher = c
+	return e, nil
 }
 
 func (e *encoder) encode(rec *walpb.Record) error {
@@ -71,6 +77,15 @@ func (e *encoder) encode(rec *walpb.Record) error {
 		n    int
 	)
 
+	// the crc covers the plaintext, so it is checked after decryption.
+	if len(rec.Data) != 0 && e.cipher.Enabled() {
+		erec := *rec
+		if erec.Data, err = e.cipher.Encrypt(rec.Data); err != nil {
+			return err
+		}
+		rec = &erec
+	}
+
 	if rec.Size() > len(e.buf) {
 		data, err = rec.Marshal()
 		if err != nil {
@@ -19,6 +19,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"etcd/pkg/encryption"
 	"etcd/pkg/fileutil"
 	"etcd/wal/walpb"
 )
@@ -26,6 +27,12 @@ import (
 // Repair tries to repair ErrUnexpectedEOF in the
 // last wal file by truncating.
 func Repair(dirpath string) bool {
+	return RepairWithCipher(dirpath, nil)
+}
+
+// RepairWithCipher repairs the last wal file like Repair, decrypting its
+// records with c.
+func RepairWithCipher(dirpath string, c *encryption.Cipher) bool {
 	f, err := openLast(dirpath)
 	if err != nil {
 		return false
@@ -34,6 +41,7 @@ func Repair(dirpath string) bool {
 
 	rec := &walpb.Record{}
 	decoder := newDecoder(f)
+	decoder.cipher = c
 	for {
 		lastOffset := decoder.lastOffset()
 		err := decoder.decode(rec)
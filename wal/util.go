@@ -15,11 +15,17 @@
 package wal
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"strings"
 
+	"etcd/pkg/encryption"
 	"etcd/pkg/fileutil"
+	"etcd/wal/walpb"
 )
 
 /**
@@ -108,3 +114,51 @@ func parseWalName(str string) (seq, index uint64, err error) {
 func walName(seq, index uint64) string {
 	return fmt.Sprintf("%016x-%016x.wal", seq, index)
 }
+
+// EncryptionStatus counts the plaintext and encrypted records of the wal
+// files in dirpath, without decrypting them.
+func EncryptionStatus(dirpath string) (encryption.Status, error) {
+	var st encryption.Status
+	names, err := readWalNames(dirpath)
+	if err != nil {
+		return st, err
+	}
+	for _, name := range names {
+		if err = segmentEncryptionStatus(filepath.Join(dirpath, name), &st); err != nil {
+			return st, err
+		}
+	}
+	return st, nil
+}
+
+func segmentEncryptionStatus(p string, st *encryption.Status) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	for {
+		l, err := readInt64(br)
+		if err == io.EOF || (err == nil && l == 0) {
+			// hit end of file or preallocated space
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		recBytes, padBytes := decodeFrameSize(l)
+		data := make([]byte, recBytes+padBytes)
+		if _, err = io.ReadFull(br, data); err != nil {
+			// ignore a torn write at the tail
+			return nil
+		}
+		var rec walpb.Record
+		if err = rec.Unmarshal(data[:recBytes]); err != nil {
+			return nil
+		}
+		if rec.Type != crcType && len(rec.Data) != 0 {
+			st.Add(rec.Data)
+		}
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"sync"
 	"time"
 
+	"etcd/pkg/encryption"
 	"etcd/pkg/fileutil"
 	"etcd/pkg/pbutil"
 	"etcd/raft"
@@ -88,11 +89,19 @@ type WAL struct {
 
 	locks []*fileutil.LockedFile // the locked files the WAL holds (the name is increasing)
 	fp    *filePipeline
+
+	cipher *encryption.Cipher // encrypts the data of the records
 }
 
 // Create creates a WAL ready for appending records. The given metadata is
 // recorded at the head of each WAL file, and can be retrieved with ReadAll.
 func Create(dirpath string, metadata []byte) (*WAL, error) {
+	return CreateWithCipher(dirpath, metadata, nil)
+}
+
+// CreateWithCipher creates a WAL like Create, whose records are encrypted
+// with c.
+func CreateWithCipher(dirpath string, metadata []byte, c *encryption.Cipher) (*WAL, error) {
 	if Exist(dirpath) {
 		return nil, os.ErrExist
 	}
@@ -125,8 +134,9 @@ func Create(dirpath string, metadata []byte) (*WAL, error) {
 	w := &WAL{
 		dir:      dirpath,
 		metadata: metadata,
+		cipher:   c,
 	}
-	w.encoder, err = newFileEncoder(f.File, 0)
+	w.encoder, err = newFileEncoder(f.File, 0, c)
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +178,13 @@ func Create(dirpath string, metadata []byte) (*WAL, error) {
 // the given snap. The WAL cannot be appended to before reading out all of its
 // previous records.
 func Open(dirpath string, snap walpb.Snapshot) (*WAL, error) {
-	w, err := openAtIndex(dirpath, snap, true)
+	return OpenWithCipher(dirpath, snap, nil)
+}
+
+// OpenWithCipher opens the WAL at the given snap like Open, decrypting and
+// encrypting its records with c.
+func OpenWithCipher(dirpath string, snap walpb.Snapshot, c *encryption.Cipher) (*WAL, error) {
+	w, err := openAtIndex(dirpath, snap, true, c)
 	if err != nil {
 		return nil, err
 	}
@@ -181,10 +197,16 @@ func Open(dirpath string, snap walpb.Snapshot) (*WAL, error) {
 // OpenForRead only opens the wal files for read.
 // Write on a read only wal panics.
 func OpenForRead(dirpath string, snap walpb.Snapshot) (*WAL, error) {
-	return openAtIndex(dirpath, snap, false)
+	return openAtIndex(dirpath, snap, false, nil)
+}
+
+// OpenForReadWithCipher opens the wal files for read like OpenForRead,
+// decrypting the records with c.
+func OpenForReadWithCipher(dirpath string, snap walpb.Snapshot, c *encryption.Cipher) (*WAL, error) {
+	return openAtIndex(dirpath, snap, false, c)
 }
 
-func openAtIndex(dirpath string, snap walpb.Snapshot, write bool) (*WAL, error) {
+func openAtIndex(dirpath string, snap walpb.Snapshot, write bool, c *encryption.Cipher) (*WAL, error) {
 	names, err := readWalNames(dirpath)
 	if err != nil {
 		return nil, err
@@ -230,7 +252,9 @@ func openAtIndex(dirpath string, snap walpb.Snapshot, write bool) (*WAL, error)
 		decoder:   newDecoder(rs...),
 		readClose: closer,
 		locks:     ls,
+		cipher:    c,
 	}
+	w.decoder.cipher = c
 
 	if write {
 		// write reuses the file descriptors from read; don't close so
@@ -350,7 +374,7 @@ func (w *WAL) ReadAll() (metadata []byte, state raftpb.HardState, ents []raftpb.
 
 	if w.tail() != nil {
 		// create encoder (chain crc with the decoder), enable appending
-		w.encoder, err = newFileEncoder(w.tail().File, w.decoder.lastCRC())
+		w.encoder, err = newFileEncoder(w.tail().File, w.decoder.lastCRC(), w.cipher)
 		if err != nil {
 			return
 		}
@@ -387,7 +411,7 @@ func (w *WAL) cut() error {
 	// update writer and save the previous crc
 	w.locks = append(w.locks, newTail)
 	prevCrc := w.encoder.crc.Sum32()
-	w.encoder, err = newFileEncoder(w.tail().File, prevCrc)
+	w.encoder, err = newFileEncoder(w.tail().File, prevCrc, w.cipher)
 	if err != nil {
 		return err
 	}
@@ -429,7 +453,7 @@ func (w *WAL) cut() error {
 	w.locks[len(w.locks)-1] = newTail
 
 	prevCrc = w.encoder.crc.Sum32()
-	w.encoder, err = newFileEncoder(w.tail().File, prevCrc)
+	w.encoder, err = newFileEncoder(w.tail().File, prevCrc, w.cipher)
 	if err != nil {
 		return err
 	}
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"testing"
 
+	"etcd/pkg/encryption"
 	"etcd/pkg/fileutil"
 	"etcd/pkg/pbutil"
 	"etcd/raft/raftpb"
@@ -785,3 +786,61 @@ func TestOpenOnTornWrite(t *testing.T) {
 		t.Fatalf("expected len(ents) = %d, got %d", wEntries, len(ents))
 	}
 }
+
+func TestEncryptedWAL(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(p)
+
+	c := encryption.NewCipher(encryption.NewKeyProvider(encryption.Key{ID: 1, Secret: make([]byte, 32)}))
+	w, err := CreateWithCipher(p, []byte("metadata"), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ents := []raftpb.Entry{{Index: 1, Term: 1, Data: []byte("secret value")}}
+	if err = w.Save(raftpb.HardState{Term: 1, Commit: 1}, ents); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	b, err := ioutil.ReadFile(filepath.Join(p, walName(0, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b, []byte("secret value")) || bytes.Contains(b, []byte("metadata")) {
+		t.Fatalf("wal file contains plaintext")
+	}
+	st, err := EncryptionStatus(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// metadata, snapshot, entry and state records
+	if st.Plaintext != 0 || st.Encrypted[1] != 4 {
+		t.Fatalf("status = %+v, want 4 records encrypted with key 1", st)
+	}
+
+	w, err = OpenForReadWithCipher(p, walpb.Snapshot{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, _, rents, err := w.ReadAll()
+	w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(md, []byte("metadata")) || !reflect.DeepEqual(rents, ents) {
+		t.Fatalf("read metadata %q entries %+v, want %q %+v", md, rents, "metadata", ents)
+	}
+
+	// the records cannot be read without the key
+	w, err = OpenForRead(p, walpb.Snapshot{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if _, _, _, err = w.ReadAll(); err != encryption.ErrNoKeyProvider {
+		t.Fatalf("err = %v, want %v", err, encryption.ErrNoKeyProvider)
+	}
+}